@@ -0,0 +1,231 @@
+package internal
+
+import (
+	_ "embed"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// settingsGroup is the client group every Setting* resource joins implicitly,
+// with the "Setting" prefix as the replaced resource name (GetSettingMgmt ->
+// Settings().GetMgmt, GetSetting -> Settings().Get), so a newly generated
+// setting needs no customizations entry.
+const settingsGroup = "Settings"
+
+// clientGroupMember places a resource in a client group. Stem replaces the
+// resource name inside the group's method names ("" for the primary resource:
+// ListNetwork -> Networks().List, ListFirewallRule -> Firewall().ListRule).
+type clientGroupMember struct {
+	Group string
+	Stem  string
+}
+
+// ClientGroup is one fluent sub-client of the InternalClient (Networks(),
+// Firewall(), ...). Every method forwards to a flat InternalClient method.
+type ClientGroup struct {
+	Name    string
+	Methods []*GroupMethod
+}
+
+// InterfaceName returns the name of the group's interface, e.g. NetworksClient.
+func (g *ClientGroup) InterfaceName() string {
+	return g.Name + "Client"
+}
+
+// ImplName returns the name of the unexported type implementing the group.
+func (g *ClientGroup) ImplName() string {
+	return unexportedName(g.Name) + "Client"
+}
+
+// GroupMethod is one method of a ClientGroup and the flat function it forwards to.
+// A group-only Flat is implemented on *client but absent from InternalClient.
+type GroupMethod struct {
+	Name string
+	Flat *CustomClientFunction
+}
+
+// Comment returns the flat function's comment re-targeted at the group method
+// (ListNetwork lists ... -> List lists ...).
+func (m *GroupMethod) Comment() string {
+	if rest, ok := strings.CutPrefix(m.Flat.Comment(), m.Flat.Name()); ok {
+		return m.Name + rest
+	}
+	return m.Flat.Comment()
+}
+
+// Signature returns the method signature, i.e. the flat signature under the group
+// method name.
+func (m *GroupMethod) Signature() string {
+	f := *m.Flat
+	f.FunctionName = m.Name
+	return f.Signature()
+}
+
+// Args returns the comma-separated parameter names, for forwarding calls; a
+// variadic parameter is spread.
+func (m *GroupMethod) Args() string {
+	names := make([]string, 0, len(m.Flat.Parameters))
+	for _, p := range m.Flat.Parameters {
+		if strings.HasPrefix(p.Type, "...") {
+			names = append(names, p.Name+"...")
+			continue
+		}
+		names = append(names, p.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// FuncType returns the func type of the method, used by the mock's func fields.
+func (m *GroupMethod) FuncType() string {
+	types := make([]string, 0, len(m.Flat.Parameters))
+	for _, p := range m.Flat.Parameters {
+		types = append(types, p.Type)
+	}
+	fn := "func(" + strings.Join(types, ", ") + ")"
+	switch returns := m.Flat.ReturnParameters; len(returns) {
+	case 0:
+		return fn
+	case 1:
+		return fn + " " + returns[0]
+	default:
+		return fn + " (" + strings.Join(returns, ", ") + ")"
+	}
+}
+
+// HasReturns reports whether the method returns anything.
+func (m *GroupMethod) HasReturns() bool {
+	return len(m.Flat.ReturnParameters) > 0
+}
+
+// Groups returns the resolved client groups, sorted by name.
+func (c *ClientInfo) Groups() []*ClientGroup {
+	return c.groups
+}
+
+// Deprecation returns the group path replacing f (e.g. "Networks().List"), or ""
+// when f is not grouped (transport functions and marker comments).
+func (c *ClientInfo) Deprecation(f ClientFunction) string {
+	return c.groupPaths[f.Name()]
+}
+
+// memberOf returns the group placement of resource: the configured one, else the
+// implicit Settings placement of Setting* resources.
+func (c *ClientInfo) memberOf(resource string) (clientGroupMember, bool) {
+	if m, ok := c.members[resource]; ok {
+		return m, true
+	}
+	if stem, ok := strings.CutPrefix(resource, "Setting"); ok {
+		return clientGroupMember{Group: settingsGroup, Stem: stem}, true
+	}
+	return clientGroupMember{}, false
+}
+
+// resolveGroups assigns every resource function to its client group and method
+// name. Functions of a resource without a group stay flat-only (and are not
+// deprecated); an underivable method name, two functions landing on the same
+// group method and a group-only function without a group are errors.
+func (c *ClientInfo) resolveGroups() error {
+	byName := make(map[string]*ClientGroup)
+	c.groupPaths = make(map[string]string)
+	for _, fn := range c.ResourceFunctions() {
+		f, ok := fn.(*CustomClientFunction)
+		if !ok || f.Name() == "" {
+			continue
+		}
+		member, ok := c.memberOf(f.ResourceName())
+		if !ok {
+			if f.GroupOnly {
+				return fmt.Errorf("client function %s is groupOnly, but resource %s has no client group", f.Name(), f.ResourceName())
+			}
+			continue
+		}
+		name, err := groupMethodName(f, member.Stem)
+		if err != nil {
+			return err
+		}
+		g, ok := byName[member.Group]
+		if !ok {
+			g = &ClientGroup{Name: member.Group}
+			byName[member.Group] = g
+		}
+		path := fmt.Sprintf("%s().%s", member.Group, name)
+		for flat, other := range c.groupPaths {
+			if other == path {
+				return fmt.Errorf("client functions %s and %s both map to %s", flat, f.Name(), path)
+			}
+		}
+		g.Methods = append(g.Methods, &GroupMethod{Name: name, Flat: f})
+		c.groupPaths[f.Name()] = path
+	}
+
+	c.groups = make([]*ClientGroup, 0, len(byName))
+	for _, g := range byName {
+		sort.Slice(g.Methods, func(i, j int) bool { return g.Methods[i].Name < g.Methods[j].Name })
+		c.groups = append(c.groups, g)
+	}
+	sort.Slice(c.groups, func(i, j int) bool { return c.groups[i].Name < c.groups[j].Name })
+	return nil
+}
+
+// groupMethodName returns f's method name under its group: the explicit
+// groupMethod, else f's name with the resource name replaced by stem
+// (ListFirewallRule -> ListRule, AdoptDevice -> Adopt). The replacement only
+// applies when the resource name ends a word; names like ListSites or
+// GetSystemInfo must declare groupMethod.
+func groupMethodName(f *CustomClientFunction, stem string) (string, error) {
+	if f.GroupMethod != "" {
+		return f.GroupMethod, nil
+	}
+	resource := f.ResourceName()
+	idx := strings.Index(f.Name(), resource)
+	if idx > 0 {
+		end := idx + len(resource)
+		if end == len(f.Name()) || unicode.IsUpper(rune(f.Name()[end])) {
+			return f.Name()[:idx] + stem + f.Name()[end:], nil
+		}
+	}
+	return "", fmt.Errorf("client function %s: cannot derive its %s group method name; set groupMethod in customizations", f.Name(), resource)
+}
+
+// unexportedName lower-cases the leading initialism or word of name (Networks ->
+// networks, WLANs -> wlans, DNS -> dns, TrafficFlows -> trafficFlows).
+func unexportedName(name string) string {
+	upper := 0
+	for upper < len(name) && unicode.IsUpper(rune(name[upper])) {
+		upper++
+	}
+	switch {
+	case upper == len(name), name[upper:] == "s":
+		return strings.ToLower(name)
+	case upper > 1:
+		upper--
+	}
+	return strings.ToLower(name[:upper]) + name[upper:]
+}
+
+//go:embed client_groups.go.tmpl
+var clientGroupsGoTemplate string
+
+// clientGroupsFile generates client_groups.generated.go: the group interfaces,
+// their forwarding implementations on *client and func-field mocks.
+type clientGroupsFile struct {
+	*ClientInfo
+}
+
+// GroupsFile returns the generation source of the client groups file.
+func (c *ClientInfo) GroupsFile() Generatable {
+	return clientGroupsFile{c}
+}
+
+func (f clientGroupsFile) Name() string {
+	return "ClientGroups"
+}
+
+func (f clientGroupsFile) GenerateCode() (string, error) {
+	if err := f.resolveGroups(); err != nil {
+		return "", err
+	}
+	return generateCodeFromTemplate("client_groups.go.tmpl", clientGroupsGoTemplate, f.ClientInfo)
+}
@@ -0,0 +1,219 @@
+package internal //nolint:testpackage // tests access unexported symbols
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupMethodName(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		fn      CustomClientFunction
+		stem    string
+		want    string
+		wantErr bool
+	}{
+		"primary resource drops its name": {
+			fn:   CustomClientFunction{FunctionName: "ListNetwork", Resource: "Network"},
+			want: "List",
+		},
+		"stem replaces the resource name": {
+			fn:   CustomClientFunction{FunctionName: "ListFirewallRule", Resource: "FirewallRule"},
+			stem: "Rule",
+			want: "ListRule",
+		},
+		"suffix after the resource name is kept": {
+			fn:   CustomClientFunction{FunctionName: "GetDeviceByMAC", Resource: "Device"},
+			want: "GetByMAC",
+		},
+		"setting prefix is stripped": {
+			fn:   CustomClientFunction{FunctionName: "GetSettingMgmt", Resource: "SettingMgmt"},
+			stem: "Mgmt",
+			want: "GetMgmt",
+		},
+		"explicit groupMethod wins": {
+			fn:   CustomClientFunction{FunctionName: "ListSites", Resource: "Site", GroupMethod: "List"},
+			want: "List",
+		},
+		"resource name inside a word is not replaced": {
+			fn:      CustomClientFunction{FunctionName: "ListSites", Resource: "Site"},
+			wantErr: true,
+		},
+		"resource name absent from the function name": {
+			fn:      CustomClientFunction{FunctionName: "GetSystemInfo", Resource: "SysInfo"},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got, err := groupMethodName(&tt.fn, tt.stem)
+			if tt.wantErr {
+				require.ErrorContains(t, err, "groupMethod")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUnexportedName(t *testing.T) {
+	t.Parallel()
+	for in, want := range map[string]string{
+		"Networks":     "networks",
+		"WLANs":        "wlans",
+		"DNS":          "dns",
+		"RADIUS":       "radius",
+		"TrafficFlows": "trafficFlows",
+		"APGroups":     "apGroups",
+	} {
+		assert.Equal(t, want, unexportedName(in), in)
+	}
+}
+
+// TestResolveGroups pins group assignment: configured members and the implicit
+// Settings group are grouped, ungrouped resources stay flat-only, and transport
+// functions are never grouped or deprecated.
+func TestResolveGroups(t *testing.T) {
+	t.Parallel()
+
+	ci := NewClientInfoBuilder().
+		AddGroup("Networks", map[string]string{"Network": ""}).
+		AddResource(NewResource("Network", "networkconf"), []string{"Update", "Delete"}).
+		AddResource(NewResource("SettingMgmt", ""), nil).
+		AddResource(NewResource("Loose", "loose"), nil).
+		AddFunction(&CustomClientFunction{FunctionName: "BaseURL", ReturnParameters: []string{"string"}}).
+		Build()
+	require.NoError(t, ci.resolveGroups())
+
+	require.Len(t, ci.Groups(), 2)
+	networks, settings := ci.Groups()[0], ci.Groups()[1]
+	assert.Equal(t, "NetworksClient", networks.InterfaceName())
+	assert.Equal(t, "networksClient", networks.ImplName())
+	assert.Equal(t, []string{"Create", "Get", "List"}, groupMethodNames(networks))
+	assert.Equal(t, "Settings", settings.Name)
+	assert.Equal(t, []string{"GetMgmt", "UpdateMgmt"}, groupMethodNames(settings))
+
+	assert.Equal(t, "Networks().List", ci.Deprecation(&CustomClientFunction{FunctionName: "ListNetwork"}))
+	assert.Empty(t, ci.Deprecation(&CustomClientFunction{FunctionName: "ListLoose"}), "ungrouped resources stay flat-only")
+	assert.Empty(t, ci.Deprecation(&CustomClientFunction{FunctionName: "BaseURL"}))
+}
+
+func TestResolveGroups_GroupOnly(t *testing.T) {
+	t.Parallel()
+
+	ci := NewClientInfoBuilder().
+		AddGroup("Reports", map[string]string{"Report": ""}).
+		AddFunction(&CustomClientFunction{FunctionName: "GetReport", Resource: "Report", GroupOnly: true}).
+		AddFunction(&CustomClientFunction{FunctionName: "ListReport", Resource: "Report"}).
+		Build()
+	require.NoError(t, ci.resolveGroups())
+
+	require.Len(t, ci.Groups(), 1)
+	assert.Equal(t, []string{"Get", "List"}, groupMethodNames(ci.Groups()[0]))
+	flat := make([]string, 0, len(ci.FlatFunctions()))
+	for _, f := range ci.FlatFunctions() {
+		flat = append(flat, f.Name())
+	}
+	assert.Equal(t, []string{"ListReport"}, flat, "group-only functions are not on the flat interface")
+
+	code, err := ci.GenerateCode()
+	require.NoError(t, err)
+	assert.Contains(t, code, "Deprecated: use Reports().List instead.")
+	assert.NotContains(t, code, "GetReport(")
+}
+
+func TestFlatImports(t *testing.T) {
+	t.Parallel()
+
+	ci := NewClientInfoBuilder().
+		AddImports([]string{"io", "iter", "time"}).
+		AddGroup("Reports", map[string]string{"Report": ""}).
+		AddFunction(&CustomClientFunction{FunctionName: "GetReport", Resource: "Report", Parameters: []FunctionParam{{"from", "time.Time"}}}).
+		AddFunction(&CustomClientFunction{FunctionName: "ListReportSeq", Resource: "Report", GroupOnly: true, ReturnParameters: []string{"iter.Seq[Report]"}}).
+		AddFunction(&CustomClientFunction{FunctionName: "Upload", Parameters: []FunctionParam{{"r", "io.Reader"}}}).
+		Build()
+
+	assert.Equal(t, []string{"io", "time"}, ci.FlatImports(), "iter is only used by a group-only function")
+}
+
+func TestResolveGroups_RejectsUngroupedGroupOnly(t *testing.T) {
+	t.Parallel()
+
+	ci := NewClientInfoBuilder().
+		AddFunction(&CustomClientFunction{FunctionName: "GetLoose", Resource: "Loose", GroupOnly: true}).
+		Build()
+	require.ErrorContains(t, ci.resolveGroups(), "GetLoose is groupOnly")
+}
+
+func TestResolveGroups_RejectsCollisions(t *testing.T) {
+	t.Parallel()
+
+	ci := NewClientInfoBuilder().
+		AddGroup("Devices", map[string]string{"Device": ""}).
+		AddResource(NewResource("Device", "device"), nil).
+		AddFunction(&CustomClientFunction{FunctionName: "ListDevices", Resource: "Device", GroupMethod: "List"}).
+		Build()
+	require.ErrorContains(t, ci.resolveGroups(), "both map to Devices().List")
+}
+
+func TestGroupMethodRendering(t *testing.T) {
+	t.Parallel()
+
+	m := &GroupMethod{Name: "GetByMAC", Flat: &CustomClientFunction{
+		FunctionName:     "GetDeviceByMAC",
+		FunctionComment:  "GetDeviceByMAC returns a device by MAC address.",
+		Parameters:       []FunctionParam{{"ctx", "context.Context"}, {"site", "string"}, {"mac", "string"}},
+		ReturnParameters: []string{"*Device", "error"},
+	}}
+	assert.Equal(t, "GetByMAC returns a device by MAC address.", m.Comment())
+	assert.Equal(t, "GetByMAC(ctx context.Context, site string, mac string) (*Device, error)", m.Signature())
+	assert.Equal(t, "ctx, site, mac", m.Args())
+	assert.Equal(t, "func(context.Context, string, string) (*Device, error)", m.FuncType())
+	assert.True(t, m.HasReturns())
+}
+
+func TestGroupMethodRendering_Variadic(t *testing.T) {
+	t.Parallel()
+
+	m := &GroupMethod{Name: "Get", Flat: &CustomClientFunction{
+		FunctionName:     "GetReport",
+		Parameters:       []FunctionParam{{"ctx", "context.Context"}, {"attrs", "...ReportAttribute"}},
+		ReturnParameters: []string{"*Report", "error"},
+	}}
+	assert.Equal(t, "Get(ctx context.Context, attrs ...ReportAttribute) (*Report, error)", m.Signature())
+	assert.Equal(t, "ctx, attrs...", m.Args())
+	assert.Equal(t, "func(context.Context, ...ReportAttribute) (*Report, error)", m.FuncType())
+}
+
+func TestGenerateClientGroupsCode(t *testing.T) {
+	t.Parallel()
+
+	ci := NewClientInfoBuilder().
+		AddGroup("Networks", map[string]string{"Network": ""}).
+		AddResource(NewResource("Network", "networkconf"), nil).
+		Build()
+
+	groups, err := ci.GroupsFile().GenerateCode()
+	require.NoError(t, err)
+	assert.Contains(t, groups, "type NetworksClient interface {")
+	assert.Contains(t, groups, "func (c *client) Networks() NetworksClient {")
+	assert.Contains(t, groups, "return g.c.ListNetwork(ctx, site)")
+	assert.Regexp(t, `ListFunc\s+func\(context\.Context, string\) \(\[\]Network, error\)`, groups)
+
+	client, err := ci.GenerateCode()
+	require.NoError(t, err)
+	assert.Contains(t, client, "Networks() NetworksClient")
+	assert.Contains(t, client, "// Deprecated: use Networks().List instead.\n\tListNetwork(")
+}
+
+func groupMethodNames(g *ClientGroup) []string {
+	names := make([]string, 0, len(g.Methods))
+	for _, m := range g.Methods {
+		names = append(names, m.Name)
+	}
+	return names
+}
@@ -3,6 +3,8 @@ package internal
 import (
 	_ "embed"
 	"fmt"
+	"path"
+	"slices"
 	"sort"
 	"strings"
 )
@@ -48,6 +50,13 @@ type CustomClientFunction struct {
 	Parameters       []FunctionParam `yaml:"params"`
 	ReturnParameters []string        `yaml:"returns"`
 	FunctionComment  string          `yaml:"comment"`
+	// GroupMethod names the function under its client group when it cannot be
+	// derived from the function name (ListSites -> Sites().List).
+	GroupMethod string `yaml:"groupMethod"`
+	// GroupOnly leaves the function off the flat InternalClient, so it is only
+	// reachable through its client group. New functions are group-only: a flat
+	// form would be a deprecated alias from the start.
+	GroupOnly bool `yaml:"groupOnly"`
 }
 
 func (c *CustomClientFunction) Name() string {
@@ -93,14 +102,20 @@ func (c *CustomClientFunction) Comment() string {
 }
 
 // newClientInfo creates ClientInfo from the provided resources.
-func newClientInfo(imports []string, functions []ClientFunction) *ClientInfo {
-	return &ClientInfo{imports, functions}
+func newClientInfo(imports []string, functions []ClientFunction, members map[string]clientGroupMember) *ClientInfo {
+	return &ClientInfo{Imports: imports, Functions: functions, members: members}
 }
 
 // ClientInfo represents the client information used for code generation.
 type ClientInfo struct {
 	Imports   []string
 	Functions []ClientFunction
+
+	// members places each resource in its client group; groups and groupPaths
+	// are resolved from it (and Functions) by resolveGroups.
+	members    map[string]clientGroupMember
+	groups     []*ClientGroup
+	groupPaths map[string]string
 }
 
 // Name returns the name of the client.
@@ -116,6 +131,30 @@ func (c *ClientInfo) ResourceFunctions() []ClientFunction {
 	return filterFunctions(c.Functions, func(f ClientFunction) bool { return f.ResourceName() != "" })
 }
 
+// FlatFunctions returns the resource functions carried by the flat
+// InternalClient interface: all of them but the group-only ones.
+func (c *ClientInfo) FlatFunctions() []ClientFunction {
+	return filterFunctions(c.ResourceFunctions(), func(f ClientFunction) bool {
+		cf, ok := f.(*CustomClientFunction)
+		return !ok || !cf.GroupOnly
+	})
+}
+
+// FlatImports returns the Imports used by the flat functions' signatures, so the
+// InternalClient file does not import packages only group-only functions use.
+func (c *ClientInfo) FlatImports() []string {
+	var signatures strings.Builder
+	for _, f := range c.FlatFunctions() {
+		signatures.WriteString(f.Signature())
+	}
+	for _, f := range c.TransportFunctions() {
+		signatures.WriteString(f.Signature())
+	}
+	return slices.DeleteFunc(slices.Clone(c.Imports), func(imp string) bool {
+		return !strings.Contains(signatures.String(), path.Base(imp)+".")
+	})
+}
+
 // TransportFunctions returns the transport/lifecycle functions (Do/Get/Post/Put/
 // Delete, Login/Logout, Version, BaseURL, ...), which sit on the top-level Client
 // interface alongside the Internal()/Official() accessors. They are the functions
@@ -140,12 +179,16 @@ var clientGoTemplate string
 
 // GenerateCode generates the code for the client using a template.
 func (c *ClientInfo) GenerateCode() (string, error) {
+	if err := c.resolveGroups(); err != nil {
+		return "", err
+	}
 	return generateCodeFromTemplate("client.go.tmpl", clientGoTemplate, c)
 }
 
 type ClientInfoBuilder struct {
 	imports   []string
 	functions []ClientFunction
+	members   map[string]clientGroupMember
 }
 
 func NewClientInfoBuilder() *ClientInfoBuilder {
@@ -204,10 +247,9 @@ func standardActionNames(r *Resource) map[string]bool {
 	return names
 }
 
-// AddResource adds the standard client CRUD methods for r, omitting any whose
-// action name appears in excludeFunctions. When every action is excluded, nothing
-// is emitted (not even the section marker comments).
-func (c *ClientInfoBuilder) AddResource(r *Resource, excludeFunctions []string) *ClientInfoBuilder {
+// includedActions returns the standard actions for r minus those named in
+// excludeFunctions, preserving the standardActions order.
+func includedActions(r *Resource, excludeFunctions []string) []resourceAction {
 	excluded := make(map[string]bool, len(excludeFunctions))
 	for _, a := range excludeFunctions {
 		excluded[a] = true
@@ -218,6 +260,25 @@ func (c *ClientInfoBuilder) AddResource(r *Resource, excludeFunctions []string)
 			included = append(included, a)
 		}
 	}
+	return included
+}
+
+// includedActionNames is includedActions as a name set, the shape the resource
+// templates consult (Resource.ClientActions) when wiring the kind registration.
+func includedActionNames(r *Resource, excludeFunctions []string) map[string]bool {
+	included := includedActions(r, excludeFunctions)
+	names := make(map[string]bool, len(included))
+	for _, a := range included {
+		names[a.name] = true
+	}
+	return names
+}
+
+// AddResource adds the standard client CRUD methods for r, omitting any whose
+// action name appears in excludeFunctions. When every action is excluded, nothing
+// is emitted (not even the section marker comments).
+func (c *ClientInfoBuilder) AddResource(r *Resource, excludeFunctions []string) *ClientInfoBuilder {
+	included := includedActions(r, excludeFunctions)
 	if len(included) == 0 {
 		return c
 	}
@@ -229,6 +290,18 @@ func (c *ClientInfoBuilder) AddResource(r *Resource, excludeFunctions []string)
 	return c
 }
 
+// AddGroup places each resource of members in the client group name, with the
+// mapped stem standing in for the resource name under the group accessor.
+func (c *ClientInfoBuilder) AddGroup(name string, members map[string]string) *ClientInfoBuilder {
+	if c.members == nil {
+		c.members = make(map[string]clientGroupMember, len(members))
+	}
+	for resource, stem := range members {
+		c.members[resource] = clientGroupMember{Group: name, Stem: stem}
+	}
+	return c
+}
+
 func (c *ClientInfoBuilder) AddImport(i string) *ClientInfoBuilder {
 	c.imports = append(c.imports, i)
 	return c
@@ -248,7 +321,7 @@ func (c *ClientInfoBuilder) Build() *ClientInfo {
 		return c.functions[i].ResourceName() < c.functions[j].ResourceName()
 	})
 
-	return newClientInfo(c.imports, c.functions)
+	return newClientInfo(c.imports, c.functions, c.members)
 }
 
 func (c *ClientInfoBuilder) addResourceFunction(actionName, resourceName, comment string, additionalParams []FunctionParam, additionalReturns []string) {
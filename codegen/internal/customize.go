@@ -52,6 +52,12 @@ type ClientCustomization struct {
 	// are unsupported and have no hand-written wrapper, so no dead generated code
 	// ships. Glob patterns follow the same rules as ExcludeResources.
 	ExcludeGeneration []string `yaml:"excludeGeneration"`
+	// Groups organizes the InternalClient into fluent per-resource sub-clients
+	// (Networks(), Firewall(), ...). Each group maps the resources it holds to the
+	// stem that replaces the resource name in their method names under the
+	// accessor ("" for the group's primary resource). Setting* resources join the
+	// Settings group implicitly and need no entry.
+	Groups map[string]map[string]string `yaml:"groups"`
 }
 
 type FieldCustomization struct {
@@ -323,6 +329,9 @@ func (r *CodeCustomizer) ApplyToClient(client *ClientInfoBuilder) {
 	}
 	client.AddFunctions(r.Customizations.Client.Functions)
 	client.AddImports(r.Customizations.Client.Imports)
+	for name, members := range r.Customizations.Client.Groups {
+		client.AddGroup(name, members)
+	}
 }
 
 // log returns the customizer's injected logger, or the package-global fallback
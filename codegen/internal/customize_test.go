@@ -426,6 +426,41 @@ customizations:
 	)
 }
 
+// TestCollectResourceGenerators_RecordsClientActions pins the kind-registration
+// wiring: a resource on the Client records exactly its non-excluded CRUD
+// actions, while a resource excluded from the Client and a setting register no
+// ResourceKind at all.
+func TestCollectResourceGenerators_RecordsClientActions(t *testing.T) {
+	t.Parallel()
+
+	yamlContent := `
+customizations:
+  client:
+    excludeResources: ["Hidden"]
+  resources:
+    Partial:
+      excludeFunctions: ["Get", "Delete"]
+`
+	tempFile := createTempCustomizationsYaml(t, yamlContent)
+	cc, err := NewCodeCustomizer(tempFile)
+	require.NoError(t, err)
+
+	full := NewResource("Full", "full")
+	partial := NewResource("Partial", "partial")
+	hidden := NewResource("Hidden", "hidden")
+	setting := NewResource("SettingFoo", "")
+
+	collectResourceGenerators([]*Resource{full, partial, hidden, setting}, *cc, nil)
+
+	assert.Equal(t, map[string]bool{"Get": true, "List": true, "Create": true, "Update": true, "Delete": true}, full.ClientActions)
+	assert.Equal(t, map[string]bool{"List": true, "Create": true, "Update": true}, partial.ClientActions)
+	assert.True(t, full.RegistersKind())
+	assert.True(t, partial.RegistersKind())
+	assert.False(t, partial.HasClientAction("Get"))
+	assert.False(t, hidden.RegistersKind(), "a resource excluded from the Client must not register a kind")
+	assert.False(t, setting.RegistersKind(), "settings have their own registry and must not register a kind")
+}
+
 // TestApplyToResource_ResourceAndFieldOverridesSeparated pins that resource-level
 // overrides (resourcePath) and field-level overrides (FieldProcessor) are applied
 // independently: a resource customization that only sets resourcePath must not
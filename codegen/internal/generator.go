@@ -99,10 +99,13 @@ func generateCode(fieldsDir, v2BaseDir, outDir string, customizer CodeCustomizer
 
 // collectResourceGenerators filters resources, wires the eligible ones into the
 // Client interface builder, and returns the per-resource generators followed by
-// the built client generator. Resources excluded from generation produce no
-// .generated.go file and no Client interface methods at all — they are
-// unsupported and have no hand-written wrapper, so emitting them would only ship
-// dead code.
+// the built client generator and its sub-client groups generator. Each resource
+// on the Client also records its exposed CRUD actions (Resource.ClientActions)
+// so its generated file can self-register a ResourceKind wired to exactly those
+// methods. Resources
+// excluded from generation produce no .generated.go file and no Client
+// interface methods at all — they are unsupported and have no hand-written
+// wrapper, so emitting them would only ship dead code.
 //
 // Customizations are NOT (re)applied here: buildResourcesFromDownloadedFields
 // already calls ApplyToResource on every resource before Resource.processJSON,
@@ -121,11 +124,14 @@ func collectResourceGenerators(resources []*Resource, customizer CodeCustomizer,
 			continue
 		}
 		if !customizer.IsExcludedFromClient(resource.Name()) {
-			cb.AddResource(resource, customizer.ExcludedClientFunctions(resource))
+			excluded := customizer.ExcludedClientFunctions(resource)
+			cb.AddResource(resource, excluded)
+			resource.ClientActions = includedActionNames(resource, excluded)
 		}
 		generators = append(generators, resource)
 	}
-	return append(generators, cb.Build())
+	client := cb.Build()
+	return append(generators, client, client.GroupsFile())
 }
 
 // writeGeneratedFile writes generated file content to a file.
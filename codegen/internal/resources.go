@@ -140,6 +140,11 @@ type Resource struct {
 	Types          map[string]*FieldInfo
 	FieldProcessor FieldProcessor
 	V2             bool
+	// ClientActions is the set of standard CRUD action names (Get/List/Create/
+	// Update/Delete) this resource exposes on the generated Client interface. It
+	// is populated by collectResourceGenerators; a resource left off the Client
+	// (or a setting) has none and registers no ResourceKind.
+	ClientActions map[string]bool
 	// logger receives this resource's generation diagnostics (dropped-field and
 	// collision warnings). It is injected by buildResourcesFromDownloadedFields;
 	// when nil (e.g. a Resource built directly in a test), log() falls back to
@@ -191,6 +196,29 @@ func (r *Resource) BaseType() *FieldInfo {
 	return r.Types[r.StructName]
 }
 
+// RegistersKind reports whether the generated file self-registers a
+// ResourceKind: only non-setting resources reachable through the Client do.
+func (r *Resource) RegistersKind() bool {
+	return !r.IsSetting() && len(r.ClientActions) > 0
+}
+
+// HasClientAction reports whether the standard CRUD action is exposed on the
+// Client for this resource, so the kind registration only wires real methods.
+func (r *Resource) HasClientAction(action string) bool {
+	return r.ClientActions[action]
+}
+
+// NameField returns the JSON wire name of the resource's human-readable name
+// field ("name") when the base type has one, or "" otherwise.
+func (r *Resource) NameField() string {
+	for _, f := range r.BaseType().Fields {
+		if f != nil && f.JSONName == "name" && f.FieldType == "string" && !f.IsArray {
+			return f.JSONName
+		}
+	}
+	return ""
+}
+
 type FieldInfo struct {
 	FieldName              string
 	JSONName               string
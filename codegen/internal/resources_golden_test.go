@@ -108,6 +108,10 @@ func newWidgetV1(t *testing.T) *Resource {
 	guarded.CustomUnmarshalFunc = "emptyBoolToTrue"
 	r.BaseType().Fields["Guarded"] = guarded
 
+	// On the Client with the full CRUD set, so the kind self-registration is
+	// rendered with every operation wired.
+	r.ClientActions = includedActionNames(r, nil)
+
 	return r
 }
 
@@ -123,6 +127,9 @@ func newGadgetV2(t *testing.T) *Resource {
 		"count": "^[0-9]*$"
 	}`
 	require.NoError(t, r.processJSON([]byte(fields)))
+	// Get excluded (like ContentFiltering) so the kind registration's partial
+	// operation wiring is pinned too.
+	r.ClientActions = includedActionNames(r, []string{"Get"})
 	return r
 }
 
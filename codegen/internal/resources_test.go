@@ -923,3 +923,27 @@ func TestCustomizeResourceV2Flag(t *testing.T) {
 		})
 	}
 }
+
+// TestResourceNameField pins the kind's NameField inference: a top-level string
+// "name" field is reported, anything else (absent, nested, non-string) is not.
+func TestResourceNameField(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		fields string
+		want   string
+	}{
+		"string name":     {fields: `{"name": ".{0,32}"}`, want: "name"},
+		"no name":         {fields: `{"label": ".{0,32}"}`, want: ""},
+		"nested name":     {fields: `{"inner": {"name": ".{0,32}"}}`, want: ""},
+		"non-string name": {fields: `{"name": "true|false"}`, want: ""},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			r := NewResource("Thing", "thing")
+			require.NoError(t, r.processJSON([]byte(tc.fields)))
+			assert.Equal(t, tc.want, r.NameField())
+		})
+	}
+}
@@ -15,6 +15,7 @@ func main() {
 	outDir := flag.String("out-dir", "../../unifi/official", "output directory for the generated Official surface files")
 	pkg := flag.String("package", defaultPackageName, "package name for the generated code")
 	specVersion := flag.String("openapi-version", "", "specific committed OpenAPI spec version to generate from (default: the .unifi-version-official pin, else newest committed)")
+	mockOutDir := flag.String("mock-out-dir", "../../unifi/official/officialtest/specs", "output directory for the reduced specs of every committed snapshot served by the officialtest fake server (empty to skip)")
 	flag.Parse()
 
 	spec, err := ResolveSnapshot(*openapiDir, resolveSnapshotVersion(*openapiDir, *specVersion))
@@ -26,4 +27,10 @@ func main() {
 		fmt.Fprintln(os.Stderr, "error:", err)
 		os.Exit(1)
 	}
+	if *mockOutDir != "" {
+		if err := GenerateMockSpecs(*openapiDir, *mockOutDir); err != nil {
+			fmt.Fprintln(os.Stderr, "error:", err)
+			os.Exit(1)
+		}
+	}
 }
@@ -0,0 +1,302 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// mockSpecFile names the reduced spec written for the officialtest fake server.
+const mockSpecFile = "integration-%s.json"
+
+// strippedSchemaKeys are documentation-only keywords dropped from the reduced
+// spec; they carry no validation or example semantics.
+var strippedSchemaKeys = map[string]bool{
+	"description": true,
+	"title":       true,
+	"x-tags":      true,
+	"deprecated":  true,
+}
+
+// MockSpec is the reduced form of one committed OpenAPI snapshot the
+// officialtest fake server loads: every operation with its parameters, request
+// body and success response, plus the component schemas they reference. $ref
+// values keep the "#/components/schemas/<name>" form.
+type MockSpec struct {
+	Version    string                     `json:"version"`
+	Operations []MockOperation            `json:"operations"`
+	Schemas    map[string]json.RawMessage `json:"schemas"`
+}
+
+// MockOperation is one method+path of a MockSpec.
+type MockOperation struct {
+	Method       string          `json:"method"`
+	Path         string          `json:"path"`
+	Parameters   []MockParameter `json:"parameters,omitempty"`
+	RequestBody  json.RawMessage `json:"requestBody,omitempty"`
+	BodyOptional bool            `json:"bodyOptional,omitempty"`
+	Status       int             `json:"status"`
+	Response     json.RawMessage `json:"response,omitempty"`
+}
+
+// MockParameter is a path or query parameter of a MockOperation.
+type MockParameter struct {
+	Name     string          `json:"name"`
+	In       string          `json:"in"`
+	Required bool            `json:"required,omitempty"`
+	Schema   json.RawMessage `json:"schema,omitempty"`
+}
+
+// GenerateMockSpecs writes the reduced spec of every committed snapshot in
+// openapiDir into outDir, one integration-<ver>.json each, replacing any stale
+// ones. Unlike the Go surface (generated from the pinned version only), the fake
+// server bundles every committed version so tests can select one.
+func GenerateMockSpecs(openapiDir, outDir string) error {
+	specs, err := listSpecs(openapiDir)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(outDir, 0o755); err != nil { //nolint:gosec
+		return fmt.Errorf("creating %s: %w", outDir, err)
+	}
+	stale, err := filepath.Glob(filepath.Join(outDir, fmt.Sprintf(mockSpecFile, "*")))
+	if err != nil {
+		return err
+	}
+	for _, f := range stale {
+		if err := os.Remove(f); err != nil {
+			return fmt.Errorf("removing stale %s: %w", f, err)
+		}
+	}
+	for _, s := range specs {
+		raw, err := os.ReadFile(s.path)
+		if err != nil {
+			return fmt.Errorf("reading spec %s: %w", s.path, err)
+		}
+		out, err := buildMockSpec(raw, s.version)
+		if err != nil {
+			return fmt.Errorf("reducing spec %s: %w", s.path, err)
+		}
+		target := filepath.Join(outDir, fmt.Sprintf(mockSpecFile, s.version))
+		if err := os.WriteFile(target, out, 0o644); err != nil { //nolint:gosec
+			return fmt.Errorf("writing %s: %w", target, err)
+		}
+	}
+	return nil
+}
+
+// buildMockSpec reduces raw spec bytes to the encoded MockSpec. Output is
+// deterministic: operations sort by path then method and JSON object keys are
+// emitted sorted.
+func buildMockSpec(raw []byte, version string) ([]byte, error) {
+	var doc map[string]any
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("parsing spec JSON: %w", err)
+	}
+	spec := MockSpec{Version: version, Schemas: map[string]json.RawMessage{}}
+
+	paths, _ := doc["paths"].(map[string]any)
+	pathKeys := make([]string, 0, len(paths))
+	for p := range paths {
+		pathKeys = append(pathKeys, p)
+	}
+	sort.Strings(pathKeys)
+	for _, p := range pathKeys {
+		item, _ := paths[p].(map[string]any)
+		shared, _ := item["parameters"].([]any)
+		for _, method := range httpMethods {
+			op, ok := item[method].(map[string]any)
+			if !ok {
+				continue
+			}
+			mop, err := buildMockOperation(strings.ToUpper(method), p, op, shared)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %w", strings.ToUpper(method), p, err)
+			}
+			spec.Operations = append(spec.Operations, mop)
+		}
+	}
+
+	components, _ := doc["components"].(map[string]any)
+	schemas, _ := components["schemas"].(map[string]any)
+	for name, s := range schemas {
+		enc, err := encodeSchema(s)
+		if err != nil {
+			return nil, fmt.Errorf("schema %q: %w", name, err)
+		}
+		spec.Schemas[name] = enc
+	}
+
+	return encodeMockSpec(spec)
+}
+
+// encodeMockSpec writes spec with one operation and one schema per line:
+// compact enough to embed, yet regeneration diffs stay line-oriented. Schema
+// names are emitted sorted.
+func encodeMockSpec(spec MockSpec) ([]byte, error) {
+	var buf bytes.Buffer
+	line := func(v any, last bool) error {
+		raw, err := marshalNoEscape(v)
+		if err != nil {
+			return err
+		}
+		buf.Write(raw)
+		if !last {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('\n')
+		return nil
+	}
+	version, _ := marshalNoEscape(spec.Version)
+	fmt.Fprintf(&buf, "{\n\"version\": %s,\n\"operations\": [\n", version)
+	for i, op := range spec.Operations {
+		if err := line(op, i == len(spec.Operations)-1); err != nil {
+			return nil, err
+		}
+	}
+	buf.WriteString("],\n\"schemas\": {\n")
+	names := make([]string, 0, len(spec.Schemas))
+	for name := range spec.Schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for i, name := range names {
+		key, _ := marshalNoEscape(name)
+		buf.Write(key)
+		buf.WriteString(": ")
+		if err := line(spec.Schemas[name], i == len(names)-1); err != nil {
+			return nil, err
+		}
+	}
+	buf.WriteString("}\n}\n")
+	return buf.Bytes(), nil
+}
+
+// marshalNoEscape is json.Marshal without HTML escaping, so "<" and ">" in
+// examples stay readable.
+func marshalNoEscape(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+func buildMockOperation(method, path string, op map[string]any, shared []any) (MockOperation, error) {
+	mop := MockOperation{Method: method, Path: path}
+
+	// Operation-level parameters override path-level ones of the same name+in.
+	params := map[string]MockParameter{}
+	var order []string
+	own, _ := op["parameters"].([]any)
+	for _, list := range [][]any{shared, own} {
+		for _, p := range list {
+			pm, _ := p.(map[string]any)
+			name, _ := pm["name"].(string)
+			in, _ := pm["in"].(string)
+			if name == "" || (in != "path" && in != "query") {
+				continue
+			}
+			schema, err := encodeSchema(pm["schema"])
+			if err != nil {
+				return mop, err
+			}
+			required, _ := pm["required"].(bool)
+			key := in + ":" + name
+			if _, seen := params[key]; !seen {
+				order = append(order, key)
+			}
+			params[key] = MockParameter{Name: name, In: in, Required: required || in == "path", Schema: schema}
+		}
+	}
+	for _, key := range order {
+		mop.Parameters = append(mop.Parameters, params[key])
+	}
+
+	if body, ok := op["requestBody"].(map[string]any); ok {
+		schema, err := encodeSchema(jsonContentSchema(body))
+		if err != nil {
+			return mop, err
+		}
+		mop.RequestBody = schema
+		required, _ := body["required"].(bool)
+		mop.BodyOptional = !required
+	}
+
+	responses, _ := op["responses"].(map[string]any)
+	status := 0
+	for code := range responses {
+		n, err := strconv.Atoi(code)
+		if err != nil || n < 200 || n > 299 {
+			continue
+		}
+		if status == 0 || n < status {
+			status = n
+		}
+	}
+	if status == 0 {
+		return mop, fmt.Errorf("no 2xx response")
+	}
+	mop.Status = status
+	resp, _ := responses[strconv.Itoa(status)].(map[string]any)
+	schema, err := encodeSchema(jsonContentSchema(resp))
+	if err != nil {
+		return mop, err
+	}
+	mop.Response = schema
+	return mop, nil
+}
+
+// jsonContentSchema returns the application/json schema of a request body or
+// response object, or nil when it has none.
+func jsonContentSchema(obj map[string]any) any {
+	content, _ := obj["content"].(map[string]any)
+	media, _ := content["application/json"].(map[string]any)
+	return media["schema"]
+}
+
+// encodeSchema strips documentation keywords from a schema and encodes it; a
+// nil schema encodes to nil.
+func encodeSchema(s any) (json.RawMessage, error) {
+	if s == nil {
+		return nil, nil
+	}
+	return marshalNoEscape(stripDocs(s, false))
+}
+
+// stripDocs removes strippedSchemaKeys recursively. inNames marks maps whose
+// keys are names (properties, mapping) rather than keywords, so a property
+// literally called "description" survives.
+func stripDocs(v any, inNames bool) any {
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, child := range v {
+			switch {
+			case inNames:
+				out[k] = stripDocs(child, false)
+			case strippedSchemaKeys[k]:
+			case k == "example" || k == "default" || k == "enum":
+				out[k] = child
+			default:
+				out[k] = stripDocs(child, k == "properties" || k == "mapping")
+			}
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, child := range v {
+			out[i] = stripDocs(child, false)
+		}
+		return out
+	default:
+		return v
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const committedMockDir = "../../unifi/official/officialtest/specs"
+
+// TestMockSpecsUpToDate regenerates the reduced specs and diffs them against
+// the committed ones, so a snapshot change without regeneration fails here.
+func TestMockSpecsUpToDate(t *testing.T) {
+	t.Parallel()
+	out := t.TempDir()
+	require.NoError(t, GenerateMockSpecs(snapshotDir, out))
+
+	generated, err := filepath.Glob(filepath.Join(out, "*.json"))
+	require.NoError(t, err)
+	committed, err := filepath.Glob(filepath.Join(committedMockDir, "*.json"))
+	require.NoError(t, err)
+	require.Len(t, generated, len(committed), "one reduced spec per committed snapshot")
+
+	for _, path := range generated {
+		want, err := os.ReadFile(filepath.Join(committedMockDir, filepath.Base(path)))
+		require.NoError(t, err, "missing committed %s; regenerate with -mock-out-dir", filepath.Base(path))
+		got, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, string(want), string(got), "%s is stale; regenerate with -mock-out-dir", filepath.Base(path))
+	}
+}
+
+func TestBuildMockSpec(t *testing.T) {
+	t.Parallel()
+	raw := []byte(`{
+		"paths": {"/v1/sites/{siteId}/things": {
+			"parameters": [{"name": "siteId", "in": "path", "schema": {"type": "string", "format": "uuid"}}],
+			"get": {
+				"parameters": [{"name": "limit", "in": "query", "schema": {"type": "integer", "maximum": 200}},
+					{"name": "X-Trace", "in": "header", "schema": {"type": "string"}}],
+				"responses": {"200": {"description": "OK", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Thing"}}}},
+					"400": {"description": "Bad"}}
+			},
+			"post": {
+				"requestBody": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Thing"}}}},
+				"responses": {"201": {"description": "Created", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Thing"}}}}}
+			}
+		}},
+		"components": {"schemas": {"Thing": {"type": "object", "description": "A thing",
+			"properties": {"description": {"type": "string", "description": "doc", "example": "<b>"}}}}}
+	}`)
+	out, err := buildMockSpec(raw, "1.2.3")
+	require.NoError(t, err)
+	assert.Contains(t, string(out), `"example":"<b>"`, "HTML is not escaped")
+
+	var spec MockSpec
+	require.NoError(t, json.Unmarshal(out, &spec))
+	assert.Equal(t, "1.2.3", spec.Version)
+	require.Len(t, spec.Operations, 2)
+
+	get, post := spec.Operations[0], spec.Operations[1]
+	assert.Equal(t, "GET", get.Method)
+	assert.Equal(t, 200, get.Status)
+	require.Len(t, get.Parameters, 2, "header parameters are dropped")
+	assert.Equal(t, "siteId", get.Parameters[0].Name)
+	assert.True(t, get.Parameters[0].Required, "path parameters are always required")
+	assert.Equal(t, "limit", get.Parameters[1].Name)
+
+	assert.Equal(t, "POST", post.Method)
+	assert.Equal(t, 201, post.Status)
+	assert.True(t, post.BodyOptional)
+	assert.JSONEq(t, `{"$ref":"#/components/schemas/Thing"}`, string(post.RequestBody))
+
+	// Documentation keywords go; a property named "description" stays.
+	assert.JSONEq(t, `{"type":"object","properties":{"description":{"type":"string","example":"<b>"}}}`, string(spec.Schemas["Thing"]))
+	assert.Equal(t, 1, strings.Count(string(out), "\n\"Thing\": "), "one line per schema")
+}
@@ -35,8 +35,10 @@ func runOfficialPass(versionBaseDir, outDir, officialSpecVersion string, logger
 // toolchain stays out of the published root module graph; we shell out rather
 // than import it. It emits the Official models, tri-shape wrappers, Client
 // interface and mock into <outDir>/official from the committed spec snapshot in
-// specDir. officialSpecVersion selects which committed snapshot to generate from
-// (empty falls back to the frontend's default: the pin, else newest).
+// specDir, and the reduced specs of every committed snapshot into
+// <outDir>/official/officialtest/specs for the officialtest fake server.
+// officialSpecVersion selects which committed snapshot to generate from (empty
+// falls back to the frontend's default: the pin, else newest).
 func generateOfficialSurface(codegenDir, specDir, outDir, officialSpecVersion string, logger Logger) error {
 	officialDir := filepath.Join(codegenDir, "official")
 	if _, err := os.Stat(officialDir); err != nil {
@@ -50,7 +52,8 @@ func generateOfficialSurface(codegenDir, specDir, outDir, officialSpecVersion st
 	ctx, cancel := context.WithTimeout(context.Background(), officialPassTimeout)
 	defer cancel()
 	// Args are internal generation paths, not external input (gosec G204).
-	cmd := exec.CommandContext(ctx, "go", "run", ".", "-openapi-dir="+specDir, "-out-dir="+target, "-openapi-version="+officialSpecVersion) //nolint:gosec
+	mockDir := filepath.Join(target, "officialtest", "specs")
+	cmd := exec.CommandContext(ctx, "go", "run", ".", "-openapi-dir="+specDir, "-out-dir="+target, "-openapi-version="+officialSpecVersion, "-mock-out-dir="+mockDir) //nolint:gosec
 	cmd.Dir = officialDir
 	cmd.Env = append(os.Environ(), "GOFLAGS=-buildvcs=false")
 	if out, err := cmd.CombinedOutput(); err != nil {
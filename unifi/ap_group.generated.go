@@ -16,6 +16,26 @@ var (
 	_ json.Marshaler
 )
 
+// Self-register this resource's kind so the resourceKinds registry in
+// resource_registry.go mirrors the generated Client surface and can never
+// drift from it by hand.
+func init() { //nolint:gochecknoinits
+	registerResourceKind(ResourceKind{
+		Name:       "APGroup",
+		Endpoint:   "apgroups",
+		APIVersion: APIVersionV2,
+		IDField:    "_id",
+		NameField:  "name",
+		SiteScoped: true,
+	}, resourceOps[APGroup]{
+		list:   InternalClient.ListAPGroup,
+		get:    InternalClient.GetAPGroup,
+		create: InternalClient.CreateAPGroup,
+		update: InternalClient.UpdateAPGroup,
+		delete: InternalClient.DeleteAPGroup,
+	})
+}
+
 type APGroup struct {
 	ID     string `json:"_id,omitempty"`
 	SiteID string `json:"site_id,omitempty"`
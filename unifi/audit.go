@@ -0,0 +1,314 @@
+package unifi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+/*
+Audit configures an audit trail of every change the client makes to the
+controller: each create, update, delete and command (any request that is not a
+read, see ClientConfig.ReadOnly), on the Internal and Official API alike, is
+written to Sink as an AuditRecord once it completed, successfully or not.
+
+A record carries the object before the change, the request body, the object the
+controller returned, the actor and reason attached to the context with
+AuditActor and AuditReason, and timestamps. The prior object of an update or
+delete is fetched from the controller just before the change (a GET of the same
+object, or of the setting for set/setting/<key>) unless SkipBefore is set or the
+caller supplied it with AuditBefore; creates and commands have none. Secret
+fields are redacted from all three objects, as for RequestLogging.
+
+Requests refused by ReadOnly or recorded by DryRun are not audited, since they
+change nothing.
+*/
+type Audit struct {
+	// Sink receives the records. A nil Sink disables auditing.
+	Sink AuditSink
+	// SkipBefore disables fetching the prior object, saving a request per
+	// change; Before is then only set when supplied with AuditBefore.
+	SkipBefore bool
+	// RedactFields names additional JSON fields whose values are replaced with
+	// "REDACTED", matched case-insensitively at any depth. Fields prefixed
+	// "x_" and the password, passphrase, secret, token, apikey and api_key
+	// fields are always redacted.
+	RedactFields []string
+}
+
+// AuditRecord describes one change made through the client.
+type AuditRecord struct {
+	// Started and Completed bracket the change, excluding the fetch of the
+	// prior object.
+	Started   time.Time `json:"started"`
+	Completed time.Time `json:"completed"`
+	// Actor and Reason are the values attached with AuditActor and AuditReason.
+	Actor  string `json:"actor,omitempty"`
+	Reason string `json:"reason,omitempty"`
+	// Method and Path (with the query) identify the request.
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	// Operation describes the request, as for tracing.
+	Operation Operation `json:"operation"`
+	// Before is the object before the change, when known.
+	Before json.RawMessage `json:"before,omitempty"`
+	// BeforeError is why Before could not be fetched, if it could not.
+	BeforeError string `json:"before_error,omitempty"`
+	// Request is the JSON request body; nil for a request without one, or for
+	// a file upload.
+	Request json.RawMessage `json:"request,omitempty"`
+	// After is the object the controller returned, unwrapped from the v1
+	// {meta, data} envelope.
+	After json.RawMessage `json:"after,omitempty"`
+	// Error is the error the change failed with; empty on success.
+	Error string `json:"error,omitempty"`
+}
+
+// AuditSink receives audit records. WriteAudit is called once per change, from
+// the goroutine that made it, and must be safe for concurrent use. A failure
+// is logged; the change itself has already happened and is not failed.
+type AuditSink interface {
+	WriteAudit(ctx context.Context, record AuditRecord) error
+}
+
+// AuditSinkFunc adapts a function to an AuditSink.
+type AuditSinkFunc func(ctx context.Context, record AuditRecord) error
+
+// WriteAudit calls f.
+func (f AuditSinkFunc) WriteAudit(ctx context.Context, record AuditRecord) error {
+	return f(ctx, record)
+}
+
+// JSONLAuditSink writes each record as one line of JSON.
+type JSONLAuditSink struct {
+	mu     sync.Mutex
+	w      io.Writer
+	closer io.Closer
+}
+
+// NewJSONLAuditSink returns a sink writing JSON lines to w.
+func NewJSONLAuditSink(w io.Writer) *JSONLAuditSink {
+	return &JSONLAuditSink{w: w}
+}
+
+// OpenJSONLAuditFile returns a sink appending JSON lines to the file at path,
+// created with mode 0600 if it does not exist. Close closes the file.
+func OpenJSONLAuditFile(path string) (*JSONLAuditSink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open audit file: %w", err)
+	}
+	return &JSONLAuditSink{w: f, closer: f}, nil
+}
+
+// WriteAudit writes record as one line.
+func (s *JSONLAuditSink) WriteAudit(_ context.Context, record AuditRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(append(line, '\n'))
+	return err
+}
+
+// Close closes the file of a sink opened with OpenJSONLAuditFile; it does
+// nothing for a sink created with NewJSONLAuditSink.
+func (s *JSONLAuditSink) Close() error {
+	if s.closer == nil {
+		return nil
+	}
+	return s.closer.Close()
+}
+
+// AuditActor attaches the actor (a user, service or pipeline) responsible for
+// the changes made with a context to their audit records.
+func AuditActor(actor string) CallOption {
+	return func(o *callOptions) { o.auditActor = actor }
+}
+
+// AuditReason attaches the reason for the changes made with a context (e.g. a
+// ticket) to their audit records.
+func AuditReason(reason string) CallOption {
+	return func(o *callOptions) { o.auditReason = reason }
+}
+
+// AuditBefore supplies the object as it was before the change made with a
+// context, e.g. from the caller's own state, so it is not fetched from the
+// controller. v is marshaled to JSON.
+func AuditBefore(v any) CallOption {
+	return func(o *callOptions) { o.auditBefore = v }
+}
+
+// auditor is the resolved form of an Audit. A nil *auditor disables auditing.
+type auditor struct {
+	sink       AuditSink
+	skipBefore bool
+	redact     []string
+}
+
+func newAuditor(cfg *Audit) *auditor {
+	if cfg == nil || cfg.Sink == nil {
+		return nil
+	}
+	return &auditor{sink: cfg.Sink, skipBefore: cfg.SkipBefore, redact: cfg.RedactFields}
+}
+
+// sendAudited sends a mutating request and writes its audit record.
+func (c *client) sendAudited(ctx context.Context, method, apiPath string, reqURL *url.URL, body io.Reader, headers http.Header, respBody any) error {
+	opts := callOptionsFrom(ctx)
+	record := AuditRecord{
+		Actor:     opts.auditActor,
+		Reason:    opts.auditReason,
+		Method:    method,
+		Path:      reqURL.RequestURI(),
+		Operation: describeOperation(method, reqURL.Path),
+	}
+	before, err := c.auditBefore(ctx, method, reqURL, opts.auditBefore)
+	if err != nil {
+		record.BeforeError = err.Error()
+	}
+	record.Before = redactJSON(before, c.audit.redact)
+
+	var reqBody []byte
+	if body != nil {
+		if reqBody, err = io.ReadAll(body); err != nil {
+			return fmt.Errorf("unable to read request body: %w", err)
+		}
+		body = bytes.NewReader(reqBody)
+	}
+	if strings.HasPrefix(headers.Get("Content-Type"), "application/json") {
+		record.Request = redactJSON(reqBody, c.audit.redact)
+	}
+
+	record.Started = time.Now()
+	var raw rawBody
+	err = c.sendRequest(ctx, method, apiPath, body, headers, &raw)
+	record.Completed = time.Now()
+	if err == nil {
+		record.After = redactJSON(unwrapAuditObject(raw.body), c.audit.redact)
+		if respBody != nil {
+			resp := &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(raw.body))}
+			err = c.decodeResponseBody(resp, respBody, len(raw.body), method, apiPath)
+		}
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	if werr := c.audit.sink.WriteAudit(ctx, record); werr != nil {
+		c.log.Warnf("failed writing audit record for %s %s: %s", method, record.Path, werr)
+	}
+	return err
+}
+
+// auditBefore returns the object a request is about to change: known, when
+// the caller supplied it, or fetched from the controller for an update or
+// delete. It returns nil for creates and commands.
+func (c *client) auditBefore(ctx context.Context, method string, reqURL *url.URL, known any) ([]byte, error) {
+	if known != nil {
+		return json.Marshal(known)
+	}
+	if c.audit.skipBefore {
+		return nil, nil
+	}
+	beforePath := auditBeforePath(method, reqURL)
+	if beforePath == "" {
+		return nil, nil
+	}
+	var raw rawBody
+	if err := c.sendRequest(ctx, http.MethodGet, beforePath, nil, nil, &raw); err != nil {
+		return nil, err
+	}
+	return unwrapAuditObject(raw.body), nil
+}
+
+// auditBeforePath returns the path to GET the object a request changes: the
+// request's own path for a PUT, PATCH or DELETE of an object, and
+// get/setting/<key> for a v1 set/setting/<key>. It returns "" when there is no
+// such object.
+func auditBeforePath(method string, reqURL *url.URL) string {
+	segs := strings.Split(reqURL.Path, "/")
+	if i := slices.Index(segs, "set"); i >= 0 && i+2 < len(segs) && segs[i+1] == "setting" {
+		return "/" + path.Join(append(slices.Clone(segs[:i]), "get", "setting", segs[i+2])...)
+	}
+	switch method {
+	case http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return reqURL.RequestURI()
+	}
+	return ""
+}
+
+// unwrapAuditObject unwraps a v1 {meta, data: [...]} response to its single
+// object, or to the data array when it holds several; other bodies are
+// returned as they are.
+func unwrapAuditObject(body []byte) json.RawMessage {
+	var envelope struct {
+		Data []json.RawMessage `json:"data"`
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil || envelope.Data == nil {
+		return body
+	}
+	if len(envelope.Data) == 1 {
+		return envelope.Data[0]
+	}
+	data, _ := json.Marshal(envelope.Data)
+	return data
+}
+
+// redactJSON replaces the values of secret fields (see isSecretField) at any
+// depth of a JSON document, whatever their type. A body that is not JSON is
+// dropped rather than recorded unredacted.
+func redactJSON(body []byte, extra []string) json.RawMessage {
+	if len(body) == 0 {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil
+	}
+	out, err := json.Marshal(redactValue(v, extra))
+	if err != nil {
+		return nil
+	}
+	return out
+}
+
+func redactValue(v any, extra []string) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, inner := range v {
+			if isSecretField(k, extra) {
+				v[k] = redactedValue
+			} else {
+				v[k] = redactValue(inner, extra)
+			}
+		}
+	case []any:
+		for i, inner := range v {
+			v[i] = redactValue(inner, extra)
+		}
+	}
+	return v
+}
+
+// isSecretField reports whether the JSON field name holds a secret, by
+// IsSecretField or as one of extra, matched case-insensitively.
+func isSecretField(name string, extra []string) bool {
+	return IsSecretField(name) || slices.ContainsFunc(extra, func(f string) bool { return strings.EqualFold(f, name) })
+}
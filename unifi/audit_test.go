@@ -0,0 +1,282 @@
+package unifi //nolint: testpackage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingAuditSink collects the records written to it.
+type recordingAuditSink struct {
+	mu      sync.Mutex
+	records []AuditRecord
+}
+
+func (s *recordingAuditSink) WriteAudit(_ context.Context, r AuditRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = append(s.records, r)
+	return nil
+}
+
+func (s *recordingAuditSink) all() []AuditRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]AuditRecord(nil), s.records...)
+}
+
+func withAudit(a *Audit) func(*ClientConfig) {
+	return func(cfg *ClientConfig) { cfg.Audit = a }
+}
+
+// auditServer serves a user group whose name changes on PUT, and a mgmt setting.
+func auditServer(t *testing.T) *controllerServer {
+	t.Helper()
+	var (
+		mu   sync.Mutex
+		name = "before"
+	)
+	return newControllerServer(t,
+		route{apiV1Path("s/default/rest/usergroup/g1"), func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			switch r.Method {
+			case http.MethodPut:
+				var g UserGroup
+				_ = json.NewDecoder(r.Body).Decode(&g)
+				name = g.Name
+			case http.MethodDelete:
+				_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"_id":"g1","name":"` + name + `","x_secret":"s3"}]}`))
+		}},
+		route{apiV1Path("s/default/rest/usergroup"), func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"_id":"g2","name":"new"}]}`))
+		}},
+		route{apiV1Path("s/default/get/setting/mgmt"), func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"key":"mgmt","x_ssh_password":"old"}]}`))
+		}},
+		route{apiV1Path("s/default/set/setting/mgmt"), func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"key":"mgmt","x_ssh_password":"new"}]}`))
+		}},
+		route{apiV1Path("s/default/cmd/devmgr"), func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"meta":{"rc":"error","msg":"api.err.UnknownDevice"},"data":[]}`))
+		}},
+	)
+}
+
+func TestAuditRecords(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		call       func(ctx context.Context, c *client) error
+		method     string
+		path       string
+		operation  string
+		wantBefore string
+		wantReq    string
+		wantAfter  string
+		wantErr    bool
+	}{
+		"update": {
+			call: func(ctx context.Context, c *client) error {
+				g, err := c.UpdateUserGroup(ctx, "default", &UserGroup{ID: "g1", Name: "after"})
+				if err == nil && g.Name != "after" {
+					return errors.New("response not decoded")
+				}
+				return err
+			},
+			method:     http.MethodPut,
+			path:       apiV1Path("s/default/rest/usergroup/g1"),
+			operation:  "Update",
+			wantBefore: `{"_id":"g1","name":"before","x_secret":"REDACTED"}`,
+			wantReq:    `{"_id":"g1","name":"after"}`,
+			wantAfter:  `{"_id":"g1","name":"after","x_secret":"REDACTED"}`,
+		},
+		"create": {
+			call: func(ctx context.Context, c *client) error {
+				_, err := c.CreateUserGroup(ctx, "default", &UserGroup{Name: "new"})
+				return err
+			},
+			method:    http.MethodPost,
+			path:      apiV1Path("s/default/rest/usergroup"),
+			operation: "Create",
+			wantReq:   `{"name":"new"}`,
+			wantAfter: `{"_id":"g2","name":"new"}`,
+		},
+		"delete": {
+			call: func(ctx context.Context, c *client) error {
+				return c.DeleteUserGroup(ctx, "default", "g1")
+			},
+			method:     http.MethodDelete,
+			path:       apiV1Path("s/default/rest/usergroup/g1"),
+			operation:  "Delete",
+			wantBefore: `{"_id":"g1","name":"before","x_secret":"REDACTED"}`,
+			wantAfter:  `[]`,
+		},
+		"setting": {
+			call: func(ctx context.Context, c *client) error {
+				_, err := c.UpdateSettingMgmt(ctx, "default", &SettingMgmt{XSshPassword: "new"})
+				return err
+			},
+			method:     http.MethodPut,
+			path:       apiV1Path("s/default/set/setting/mgmt"),
+			operation:  "Update",
+			wantBefore: `{"key":"mgmt","x_ssh_password":"REDACTED"}`,
+			wantAfter:  `{"key":"mgmt","x_ssh_password":"REDACTED"}`,
+		},
+		"failed command": {
+			call: func(ctx context.Context, c *client) error {
+				return c.AdoptDevice(ctx, "default", "aa:bb:cc:dd:ee:01")
+			},
+			method:    http.MethodPost,
+			path:      apiV1Path("s/default/cmd/devmgr"),
+			operation: "Command",
+			wantReq:   `{"cmd":"adopt","mac":"aa:bb:cc:dd:ee:01"}`,
+			wantErr:   true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			sink := &recordingAuditSink{}
+			c := auditServer(t).clientWith(withAudit(&Audit{Sink: sink}))
+			ctx := WithCallOptions(context.Background(), AuditActor("alice"), AuditReason("CHG-1"))
+
+			err := tt.call(ctx, c)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			records := sink.all()
+			require.Len(t, records, 1)
+			r := records[0]
+			assert.Equal(t, tt.method, r.Method)
+			assert.Equal(t, tt.path, r.Path)
+			assert.Equal(t, tt.operation, r.Operation.Operation)
+			assert.Equal(t, "default", r.Operation.Site)
+			assert.Equal(t, "alice", r.Actor)
+			assert.Equal(t, "CHG-1", r.Reason)
+			assert.False(t, r.Started.IsZero())
+			assert.False(t, r.Completed.Before(r.Started))
+			assertJSONOrEmpty(t, tt.wantBefore, r.Before)
+			assertJSONOrEmpty(t, tt.wantAfter, r.After)
+			if tt.wantReq != "" {
+				assert.JSONEq(t, tt.wantReq, string(r.Request))
+			}
+			if tt.wantErr {
+				assert.NotEmpty(t, r.Error)
+			} else {
+				assert.Empty(t, r.Error)
+			}
+		})
+	}
+}
+
+func assertJSONOrEmpty(t *testing.T, want string, got json.RawMessage) {
+	t.Helper()
+	if want == "" {
+		assert.Empty(t, got)
+		return
+	}
+	assert.JSONEq(t, want, string(got))
+}
+
+func TestAuditSkipsReadsAndPriorFetch(t *testing.T) {
+	t.Parallel()
+	cs := auditServer(t)
+	sink := &recordingAuditSink{}
+	c := cs.clientWith(withAudit(&Audit{Sink: sink, SkipBefore: true, RedactFields: []string{"Name"}}))
+	ctx := context.Background()
+
+	_, err := c.ListUserGroup(ctx, "default")
+	require.NoError(t, err)
+	assert.Empty(t, sink.all(), "reads are not audited")
+
+	_, err = c.UpdateUserGroup(ctx, "default", &UserGroup{ID: "g1", Name: "after"})
+	require.NoError(t, err)
+	_, err = c.UpdateUserGroup(WithCallOptions(ctx, AuditBefore(&UserGroup{ID: "g1", Name: "known"})), "default", &UserGroup{ID: "g1", Name: "again"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, cs.countRequestsTo(apiV1Path("s/default/rest/usergroup/g1")), "the prior object is not fetched")
+	records := sink.all()
+	require.Len(t, records, 2)
+	assert.Empty(t, records[0].Before)
+	assert.JSONEq(t, `{"_id":"g1","name":"REDACTED"}`, string(records[1].Before), "a supplied prior object is redacted too")
+	assert.JSONEq(t, `{"_id":"g1","name":"REDACTED"}`, string(records[1].Request))
+}
+
+func TestAuditNotWrittenForGuardedRequests(t *testing.T) {
+	t.Parallel()
+	sink := &recordingAuditSink{}
+	c := auditServer(t).clientWith(func(cfg *ClientConfig) {
+		cfg.Audit = &Audit{Sink: sink}
+		cfg.DryRun = &DryRunJournal{}
+	})
+
+	require.NoError(t, c.DeleteUserGroup(context.Background(), "default", "g1"))
+	assert.Empty(t, sink.all())
+}
+
+func TestAuditSinkErrorDoesNotFailCall(t *testing.T) {
+	t.Parallel()
+	calls := 0
+	sink := AuditSinkFunc(func(context.Context, AuditRecord) error {
+		calls++
+		return errors.New("disk full")
+	})
+	c := auditServer(t).clientWith(withAudit(&Audit{Sink: sink}))
+
+	_, err := c.CreateUserGroup(context.Background(), "default", &UserGroup{Name: "new"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestJSONLAuditSink(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	sink := NewJSONLAuditSink(&buf)
+	c := auditServer(t).clientWith(withAudit(&Audit{Sink: sink}))
+
+	_, err := c.CreateUserGroup(context.Background(), "default", &UserGroup{Name: "new"})
+	require.NoError(t, err)
+	require.NoError(t, c.DeleteUserGroup(context.Background(), "default", "g1"))
+	require.NoError(t, sink.Close())
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	var r AuditRecord
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &r))
+	assert.Equal(t, "Create", r.Operation.Operation)
+	assert.JSONEq(t, `{"_id":"g2","name":"new"}`, string(r.After))
+}
+
+func TestOpenJSONLAuditFile(t *testing.T) {
+	t.Parallel()
+	file := filepath.Join(t.TempDir(), "audit.jsonl")
+	for range 2 {
+		sink, err := OpenJSONLAuditFile(file)
+		require.NoError(t, err)
+		require.NoError(t, sink.WriteAudit(context.Background(), AuditRecord{Method: http.MethodPost}))
+		require.NoError(t, sink.Close())
+	}
+
+	data, err := os.ReadFile(file)
+	require.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(string(data), "\n"), "the file is appended to")
+	info, err := os.Stat(file)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
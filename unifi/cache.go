@@ -0,0 +1,395 @@
+package unifi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// defaultCacheTTL is how long a ResponseCache keeps a response when neither
+// TTLs nor DefaultTTL say otherwise.
+const defaultCacheTTL = 5 * time.Second
+
+// defaultCacheMaxEntries bounds a ResponseCache when MaxEntries is unset.
+const defaultCacheMaxEntries = 1024
+
+// Cache results recorded on MetricCacheRequests under AttrCacheResult.
+const (
+	CacheHit       = "hit"
+	CacheMiss      = "miss"
+	CacheCoalesced = "coalesced"
+)
+
+/*
+ResponseCache is an optional read-through cache of successful GET responses,
+installed with ClientConfig.Cache. It is meant for read-heavy workloads such as
+dashboards: single-object lookups that the controller only offers as a full
+list (GetSite, GetFeature, ...) then share one list download per TTL instead
+of fetching it per call.
+
+  - Responses are keyed by URL and kept for the TTL of their resource (a
+    ResourceKind name such as "Device", or the path segment for other
+    endpoints, as in Operation.Resource).
+  - Identical GETs in flight at the same time are coalesced: one request is
+    sent and every caller decodes its response.
+  - Any other request (POST, PUT, PATCH, DELETE) that is not a read invalidates
+    the cached responses of the same site and resource, so a client reads its
+    own writes. Device commands (cmd/devmgr) invalidate the Device resource,
+    client commands (cmd/stamgr) the User resource and the client stats
+    (stat/sta, stat/alluser), site commands (cmd/sitemgr) every Site response.
+
+Only successful responses are cached; errors are never. A cache hit makes no
+request, so interceptors, tracing and request logging do not see it; with a
+ClientConfig.Meter, every cacheable GET is counted on MetricCacheRequests with
+its result. The zero value is ready to use with the defaults. A ResponseCache
+may be shared by clients of the same controller and credentials, but not across
+API keys with different permissions, since responses are keyed by URL only.
+*/
+type ResponseCache struct {
+	// DefaultTTL is how long a response is kept when TTLs has no entry for its
+	// resource. Zero means 5 seconds.
+	DefaultTTL time.Duration
+	// TTLs overrides DefaultTTL per resource, keyed like Operation.Resource
+	// ("Device", "Site", "DNSRecord", "setting", ...). A TTL <= 0 disables
+	// caching for the resource. Spectrum scans ("spectrum-scan"), whose state
+	// changes while they run, are only cached with an entry here.
+	TTLs map[string]time.Duration
+	// MaxEntries bounds the number of cached responses; when full, expired and
+	// then the soonest-expiring entries are evicted. Zero means 1024.
+	MaxEntries int
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+	flights map[string]*cacheFlight
+	// generations counts the invalidations of each resource tag with a GET in
+	// flight, so a GET that was in flight across a mutation does not store its
+	// stale response. A tag is dropped when its last flight completes.
+	generations map[cacheTag]uint64
+	stats       CacheStats
+}
+
+// CacheStats counts the outcomes of cacheable GETs.
+type CacheStats struct {
+	// Hits were served from a cached response.
+	Hits int64
+	// Misses sent a request to the controller.
+	Misses int64
+	// Coalesced joined an identical request already in flight.
+	Coalesced int64
+}
+
+// cacheTag identifies the responses a mutation invalidates.
+type cacheTag struct {
+	site     string
+	resource string
+}
+
+type cacheEntry struct {
+	body    []byte
+	tag     cacheTag
+	expires time.Time
+}
+
+// cacheFlight is a GET in flight, shared by every caller of the same URL.
+type cacheFlight struct {
+	done chan struct{}
+	tag  cacheTag
+	// limit is the response size cap of the caller that sent the request, and
+	// tooLarge whether the response exceeded it.
+	limit    int
+	tooLarge bool
+	body     []byte
+	err      error
+}
+
+// volatileResources change on the controller without a request through the
+// client, so they are not cached unless ResponseCache.TTLs says otherwise:
+// a spectrum scan's state, polled while the scan runs.
+var volatileResources = map[string]bool{
+	"spectrum-scan": true,
+}
+
+// commandResources maps the v1 command managers to the resources they act on.
+var commandResources = map[string][]string{
+	"devmgr":  {"Device"},
+	"stamgr":  {"User", "sta", "alluser"},
+	"sitemgr": {"Site"},
+}
+
+// Stats returns the counts of cache hits, misses and coalesced requests.
+func (rc *ResponseCache) Stats() CacheStats {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return rc.stats
+}
+
+// Purge drops every cached response.
+func (rc *ResponseCache) Purge() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	clear(rc.entries)
+	for tag := range rc.generations {
+		rc.generations[tag]++
+	}
+}
+
+// Invalidate drops the cached responses of resource (as in ResponseCache.TTLs)
+// on site. An empty site drops the resource's responses on every site.
+func (rc *ResponseCache) Invalidate(site, resource string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.invalidateLocked(cacheTag{site: site, resource: resource})
+}
+
+// matches reports whether an invalidation of t covers responses tagged other.
+// Responses not scoped to a site (ListSites) are covered by every site.
+func (t cacheTag) matches(other cacheTag) bool {
+	return t.resource == other.resource && (t.site == "" || other.site == "" || t.site == other.site)
+}
+
+func (rc *ResponseCache) invalidateLocked(tag cacheTag) {
+	for key, e := range rc.entries {
+		if tag.matches(e.tag) {
+			delete(rc.entries, key)
+		}
+	}
+	for other := range rc.generations {
+		if tag.matches(other) {
+			rc.generations[other]++
+		}
+	}
+}
+
+func (rc *ResponseCache) ttl(resource string) time.Duration {
+	if ttl, ok := rc.TTLs[resource]; ok {
+		return ttl
+	}
+	if volatileResources[resource] {
+		return 0
+	}
+	if rc.DefaultTTL > 0 {
+		return rc.DefaultTTL
+	}
+	return defaultCacheTTL
+}
+
+// lookup returns the cached body for key, or joins or starts the flight for
+// it. Exactly one of body and flight is non-nil; lead reports whether the
+// caller started the flight and must complete it, in which case gen is the
+// invalidation count of tag to pass to complete.
+func (rc *ResponseCache) lookup(key string, tag cacheTag, limit int, now time.Time) (body []byte, flight *cacheFlight, lead bool, gen uint64) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if e, ok := rc.entries[key]; ok {
+		if now.Before(e.expires) {
+			rc.stats.Hits++
+			return e.body, nil, false, 0
+		}
+		delete(rc.entries, key)
+	}
+	if f, ok := rc.flights[key]; ok {
+		rc.stats.Coalesced++
+		return nil, f, false, 0
+	}
+	if rc.flights == nil {
+		rc.flights = map[string]*cacheFlight{}
+	}
+	if rc.generations == nil {
+		rc.generations = map[cacheTag]uint64{}
+	}
+	f := &cacheFlight{done: make(chan struct{}), tag: tag, limit: limit}
+	rc.flights[key] = f
+	rc.stats.Misses++
+	// Enroll the tag, so invalidations of a broader tag (all sites) bump it.
+	gen, ok := rc.generations[tag]
+	if !ok {
+		rc.generations[tag] = 0
+	}
+	return nil, f, true, gen
+}
+
+// complete ends the flight for key and, when the request succeeded and no
+// invalidation of its tag happened meanwhile, stores the body.
+func (rc *ResponseCache) complete(key string, f *cacheFlight, gen uint64, ttl time.Duration) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	delete(rc.flights, key)
+	close(f.done)
+	stale := rc.generations[f.tag] != gen
+	if !rc.flyingLocked(f.tag) {
+		delete(rc.generations, f.tag)
+	}
+	if f.err != nil || stale {
+		return
+	}
+	if rc.entries == nil {
+		rc.entries = map[string]*cacheEntry{}
+	}
+	rc.evictLocked(time.Now())
+	rc.entries[key] = &cacheEntry{body: f.body, tag: f.tag, expires: time.Now().Add(ttl)}
+}
+
+// flyingLocked reports whether a GET tagged tag is in flight.
+func (rc *ResponseCache) flyingLocked(tag cacheTag) bool {
+	for _, f := range rc.flights {
+		if f.tag == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// evictLocked makes room for one more entry.
+func (rc *ResponseCache) evictLocked(now time.Time) {
+	limit := rc.MaxEntries
+	if limit <= 0 {
+		limit = defaultCacheMaxEntries
+	}
+	if len(rc.entries) < limit {
+		return
+	}
+	for key, e := range rc.entries {
+		if !now.Before(e.expires) {
+			delete(rc.entries, key)
+		}
+	}
+	for len(rc.entries) >= limit {
+		var oldest string
+		for key, e := range rc.entries {
+			if oldest == "" || e.expires.Before(rc.entries[oldest].expires) {
+				oldest = key
+			}
+		}
+		delete(rc.entries, oldest)
+	}
+}
+
+// cachedGet serves a GET through the cache: from a fresh cached body, by
+// joining an identical request in flight, or by sending it and caching the
+// response. It reports false when the request is not cacheable.
+func (c *client) cachedGet(ctx context.Context, reqURL *url.URL, apiPath string, headers http.Header, respBody any) (bool, error) {
+	op := describeOperation(http.MethodGet, reqURL.Path)
+	ttl := c.cache.ttl(op.Resource)
+	if ttl <= 0 {
+		return false, nil
+	}
+	key := reqURL.String()
+	tag := cacheTag{site: op.Site, resource: op.Resource}
+	limit := responseBodyLimit(ctx)
+	body, f, lead, gen := c.cache.lookup(key, tag, limit, time.Now())
+	switch {
+	case f == nil:
+		c.recordCacheResult(ctx, op, CacheHit)
+	case lead:
+		c.recordCacheResult(ctx, op, CacheMiss)
+		func() {
+			// A panic (e.g. in an interceptor) still fails the flight, so
+			// the callers waiting on it are released.
+			defer c.cache.complete(key, f, gen, ttl)
+			f.err = errFlightAborted
+			var raw rawBody
+			f.err = c.sendRequest(ctx, http.MethodGet, apiPath, nil, headers, &raw)
+			f.body, f.tooLarge = raw.body, raw.tooLarge
+		}()
+		if f.err != nil {
+			return true, f.err
+		}
+		body = f.body
+	default:
+		c.recordCacheResult(ctx, op, CacheCoalesced)
+		select {
+		case <-f.done:
+		case <-ctx.Done():
+			return true, fmt.Errorf("unable to perform request: %s %s %w", http.MethodGet, apiPath, ctx.Err())
+		}
+		if f.err != nil {
+			// The leader's own cancellation, or a response over the leader's
+			// smaller size cap, is not this caller's failure.
+			canceled := errors.Is(f.err, context.Canceled) || errors.Is(f.err, context.DeadlineExceeded)
+			if (canceled && ctx.Err() == nil) || (f.tooLarge && limit > f.limit) {
+				return true, c.sendRequest(ctx, http.MethodGet, apiPath, nil, headers, respBody)
+			}
+			return true, f.err
+		}
+		body = f.body
+	}
+	if respBody == nil {
+		return true, nil
+	}
+	resp := &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}
+	return true, c.decodeResponseBody(resp, respBody, limit, http.MethodGet, apiPath)
+}
+
+// errFlightAborted is what the callers sharing a request see when the request
+// panicked instead of completing.
+var errFlightAborted = errors.New("coalesced request aborted")
+
+// invalidateCache drops the cached responses a request to reqURL with method
+// may have made stale. Reads, including the POST form of stat and list
+// endpoints, invalidate nothing.
+func (c *client) invalidateCache(method string, reqURL *url.URL) {
+	op := describeOperation(method, reqURL.Path)
+	if op.Operation == string(OperationList) || op.Operation == string(OperationGet) {
+		return
+	}
+	resources, ok := commandResources[op.Resource]
+	if !ok {
+		resources = []string{op.Resource}
+	}
+	for _, resource := range resources {
+		site := op.Site
+		if resource == "Site" {
+			site = ""
+		}
+		c.cache.Invalidate(site, resource)
+	}
+}
+
+// newCacheResults creates the MetricCacheRequests counter when both a cache
+// and a meter are configured.
+func newCacheResults(cache *ResponseCache, meter Meter) Int64Counter { //nolint:ireturn
+	if cache == nil || meter == nil {
+		return nil
+	}
+	return meter.Int64Counter(MetricCacheRequests, "{request}", "Number of cacheable requests, by cache result.")
+}
+
+func (c *client) recordCacheResult(ctx context.Context, op Operation, result string) {
+	if c.cacheResults == nil {
+		return
+	}
+	attrs := []Attribute{{AttrSurface, op.Surface}, {AttrResource, op.Resource}, {AttrCacheResult, result}}
+	if op.Site != "" {
+		attrs = append(attrs, Attribute{AttrSite, op.Site})
+	}
+	c.cacheResults.Add(ctx, 1, attrs)
+}
+
+// rawBody captures a successful response body for the cache, after the same
+// size cap and meta envelope check as a decoded response.
+type rawBody struct {
+	body     []byte
+	tooLarge bool
+}
+
+func (r *rawBody) decodeStream(resp *http.Response, limit int) error {
+	body, err := io.ReadAll(io.LimitReader(resp.Body, int64(limit)+1))
+	if err != nil {
+		return err
+	}
+	if len(body) > limit {
+		r.tooLarge = true
+		return fmt.Errorf("response body exceeded %d bytes", limit)
+	}
+	if err := metaEnvelopeError(resp, body); err != nil {
+		return err
+	}
+	r.body = body
+	return nil
+}
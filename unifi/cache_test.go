@@ -0,0 +1,374 @@
+package unifi //nolint: testpackage
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cachedServer serves device and user lists on two sites and accepts writes to
+// them, for exercising a cached client.
+func cachedServer(t *testing.T) *controllerServer {
+	t.Helper()
+	list := func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"_id":"1","mac":"aa:aa:aa:aa:aa:01","name":"one"}]}`))
+	}
+	ok := func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[]}`))
+	}
+	return newControllerServer(t,
+		route{apiV1Path("s/default/stat/device"), list},
+		route{apiV1Path("s/other/stat/device"), list},
+		route{apiV1Path("s/default/rest/user"), list},
+		route{apiV1Path("s/default/rest/user/1"), ok},
+		route{apiV1Path("s/default/stat/sta"), list},
+		route{apiV1Path("s/default/cmd/stamgr"), ok},
+		route{apiV1Path("s/default/cmd/devmgr"), ok},
+		route{apiV1Path("s/default/cmd/sitemgr"), ok},
+		route{apiV1Path("self/sites"), list},
+	)
+}
+
+func withCache(rc *ResponseCache) func(*ClientConfig) {
+	return func(cfg *ClientConfig) { cfg.Cache = rc }
+}
+
+func TestResponseCacheHits(t *testing.T) {
+	t.Parallel()
+	cs := cachedServer(t)
+	rc := &ResponseCache{}
+	c := cs.clientWith(withCache(rc))
+	ctx := context.Background()
+
+	for range 3 {
+		site, err := c.GetSite(ctx, "1")
+		require.NoError(t, err)
+		assert.Equal(t, "one", site.Name)
+	}
+	sites, err := c.ListSites(ctx)
+	require.NoError(t, err)
+	require.Len(t, sites, 1)
+	sites[0].Name = "changed"
+	site, err := c.GetSite(ctx, "1")
+	require.NoError(t, err)
+	assert.Equal(t, "one", site.Name, "callers must not share decoded values")
+
+	assert.Equal(t, 1, cs.countRequestsTo(apiV1Path("self/sites")))
+	assert.Equal(t, CacheStats{Hits: 4, Misses: 1}, rc.Stats())
+
+	// Streaming reads bypass the cache.
+	_, err = c.ListDevice(ctx, "default")
+	require.NoError(t, err)
+	_, err = collectSeq(t, c.ListDeviceSeq(ctx, "default"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, cs.countRequestsTo(apiV1Path("s/default/stat/device")))
+}
+
+func TestResponseCacheCoalescing(t *testing.T) {
+	t.Parallel()
+	release := make(chan struct{})
+	cs := newControllerServer(t, route{apiV1Path("s/default/stat/device"), func(w http.ResponseWriter, _ *http.Request) {
+		<-release
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"_id":"1"}]}`))
+	}})
+	rc := &ResponseCache{}
+	c := cs.clientWith(withCache(rc))
+
+	const callers = 8
+	var wg sync.WaitGroup
+	errs := make(chan error, callers)
+	for range callers {
+		wg.Go(func() {
+			devices, err := c.ListDevice(context.Background(), "default")
+			if err == nil && len(devices) != 1 {
+				err = assert.AnError
+			}
+			errs <- err
+		})
+	}
+	require.Eventually(t, func() bool { return rc.Stats().Coalesced == callers-1 }, 5*time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, cs.requestCount())
+	assert.Equal(t, CacheStats{Misses: 1, Coalesced: callers - 1}, rc.Stats())
+}
+
+func TestResponseCacheInvalidation(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		write     func(ctx context.Context, c *client) error
+		path      string
+		wantFresh bool
+	}{
+		"write to the same resource": {
+			write: func(ctx context.Context, c *client) error {
+				return c.Put(ctx, "s/default/rest/user/1", struct{}{}, nil)
+			},
+			path:      "s/default/rest/user",
+			wantFresh: true,
+		},
+		"delete of the same resource": {
+			write:     func(ctx context.Context, c *client) error { return c.Delete(ctx, "s/default/rest/user/1", nil, nil) },
+			path:      "s/default/rest/user",
+			wantFresh: true,
+		},
+		"write to another resource": {
+			write: func(ctx context.Context, c *client) error {
+				return c.Put(ctx, "s/default/rest/user/1", struct{}{}, nil)
+			},
+			path: "s/default/stat/device",
+		},
+		"device command": {
+			write:     func(ctx context.Context, c *client) error { return c.AdoptDevice(ctx, "default", "aa:aa:aa:aa:aa:01") },
+			path:      "s/default/stat/device",
+			wantFresh: true,
+		},
+		"client command": {
+			write: func(ctx context.Context, c *client) error {
+				return c.Post(ctx, "s/default/cmd/stamgr", map[string]string{"cmd": "kick-sta"}, nil)
+			},
+			path:      "s/default/stat/sta",
+			wantFresh: true,
+		},
+		"device command on another site": {
+			write: func(ctx context.Context, c *client) error { return c.AdoptDevice(ctx, "default", "aa:aa:aa:aa:aa:01") },
+			path:  "s/other/stat/device",
+		},
+		"site command": {
+			write: func(ctx context.Context, c *client) error {
+				return c.Post(ctx, "s/default/cmd/sitemgr", struct{}{}, nil)
+			},
+			path:      "self/sites",
+			wantFresh: true,
+		},
+		"POST read": {
+			write: func(ctx context.Context, c *client) error {
+				return c.Post(ctx, "s/default/stat/device", struct{}{}, nil)
+			},
+			path: "s/default/stat/device",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			cs := cachedServer(t)
+			rc := &ResponseCache{}
+			c := cs.clientWith(withCache(rc))
+			ctx := context.Background()
+
+			require.NoError(t, c.Get(ctx, tt.path, nil, nil))
+			require.NoError(t, tt.write(ctx, c))
+			require.NoError(t, c.Get(ctx, tt.path, nil, nil))
+
+			want := CacheStats{Hits: 1, Misses: 1}
+			if tt.wantFresh {
+				want = CacheStats{Misses: 2}
+			}
+			assert.Equal(t, want, rc.Stats())
+		})
+	}
+}
+
+// TestResponseCacheStaleFlight proves a GET in flight across an invalidation
+// does not store the response it read before the write.
+func TestResponseCacheStaleFlight(t *testing.T) {
+	t.Parallel()
+	arrived := make(chan struct{}, 1)
+	release := make(chan struct{})
+	cs := newControllerServer(t, route{apiV1Path("s/default/stat/device"), func(w http.ResponseWriter, _ *http.Request) {
+		select {
+		case arrived <- struct{}{}:
+			<-release
+		default:
+		}
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[]}`))
+	}})
+	rc := &ResponseCache{}
+	c := cs.clientWith(withCache(rc))
+	ctx := context.Background()
+
+	done := make(chan error)
+	go func() { _, err := c.ListDevice(ctx, "default"); done <- err }()
+	<-arrived
+	rc.Invalidate("", "Device")
+	close(release)
+	require.NoError(t, <-done)
+
+	_, err := c.ListDevice(ctx, "default")
+	require.NoError(t, err)
+	assert.Equal(t, 2, cs.requestCount())
+	assert.Empty(t, rc.generations, "tags are dropped once no request is in flight")
+}
+
+// TestResponseCacheCoalescedBodyLimit proves callers sharing a request each
+// apply their own response size cap.
+func TestResponseCacheCoalescedBodyLimit(t *testing.T) {
+	t.Parallel()
+	// The first request of each round waits for the follower to join it.
+	var mu sync.Mutex
+	var gate chan struct{}
+	arrived := make(chan struct{})
+	cs := newControllerServer(t, route{apiV1Path("s/default/stat/device"), func(w http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		wait := gate
+		gate = nil
+		mu.Unlock()
+		if wait != nil {
+			arrived <- struct{}{}
+			<-wait
+		}
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"_id":"1","name":"a device with a long enough name"}]}`))
+	}})
+	ctx := context.Background()
+	small := WithResponseBodyLimit(ctx, 32)
+
+	coalesce := func(leader, follower context.Context) (leaderErr, followerErr error) {
+		rc := &ResponseCache{}
+		c := cs.clientWith(withCache(rc))
+		release := make(chan struct{})
+		mu.Lock()
+		gate = release
+		mu.Unlock()
+		led, followed := make(chan error), make(chan error)
+		go func() { _, err := c.ListDevice(leader, "default"); led <- err }()
+		<-arrived
+		go func() { _, err := c.ListDevice(follower, "default"); followed <- err }()
+		require.Eventually(t, func() bool { return rc.Stats().Coalesced == 1 }, 5*time.Second, time.Millisecond)
+		close(release)
+		return <-led, <-followed
+	}
+
+	leaderErr, followerErr := coalesce(small, ctx)
+	require.ErrorContains(t, leaderErr, "response body exceeded 32 bytes")
+	require.NoError(t, followerErr, "a larger cap re-sends the request")
+
+	leaderErr, followerErr = coalesce(ctx, small)
+	require.NoError(t, leaderErr)
+	require.ErrorContains(t, followerErr, "response body exceeded 32 bytes")
+}
+
+func TestResponseCacheTTL(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("expiry", func(t *testing.T) {
+		t.Parallel()
+		cs := cachedServer(t)
+		c := cs.clientWith(withCache(&ResponseCache{DefaultTTL: 20 * time.Millisecond}))
+		_, err := c.ListDevice(ctx, "default")
+		require.NoError(t, err)
+		time.Sleep(40 * time.Millisecond)
+		_, err = c.ListDevice(ctx, "default")
+		require.NoError(t, err)
+		assert.Equal(t, 2, cs.requestCount())
+	})
+	t.Run("disabled per resource", func(t *testing.T) {
+		t.Parallel()
+		cs := cachedServer(t)
+		rc := &ResponseCache{TTLs: map[string]time.Duration{"Device": 0}}
+		c := cs.clientWith(withCache(rc))
+		for range 2 {
+			_, err := c.ListDevice(ctx, "default")
+			require.NoError(t, err)
+			_, err = c.ListUser(ctx, "default")
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 2, cs.countRequestsTo(apiV1Path("s/default/stat/device")))
+		assert.Equal(t, 1, cs.countRequestsTo(apiV1Path("s/default/rest/user")))
+		assert.Equal(t, CacheStats{Hits: 1, Misses: 1}, rc.Stats(), "uncached resources are not counted")
+	})
+}
+
+func TestResponseCacheErrorsAreNotCached(t *testing.T) {
+	t.Parallel()
+	calls := 0
+	cs := newControllerServer(t, route{apiV1Path("s/default/stat/device"), func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if calls == 2 {
+			_, _ = w.Write([]byte(`{"meta":{"rc":"error","msg":"api.err.NoPermission"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"_id":"1"}]}`))
+	}})
+	c := cs.clientWith(withCache(&ResponseCache{}))
+	ctx := context.Background()
+
+	_, err := c.ListDevice(ctx, "default")
+	require.ErrorIs(t, err, ErrControllerBusy)
+	_, err = c.ListDevice(ctx, "default")
+	require.ErrorIs(t, err, ErrForbidden)
+	for range 2 {
+		devices, err := c.ListDevice(ctx, "default")
+		require.NoError(t, err)
+		assert.Len(t, devices, 1)
+	}
+	assert.Equal(t, 3, cs.requestCount())
+}
+
+func TestResponseCachePurgeAndEviction(t *testing.T) {
+	t.Parallel()
+	cs := cachedServer(t)
+	rc := &ResponseCache{MaxEntries: 1}
+	c := cs.clientWith(withCache(rc))
+	ctx := context.Background()
+	get := func(path string) {
+		t.Helper()
+		require.NoError(t, c.Get(ctx, path, nil, nil))
+	}
+
+	get("s/default/stat/device")
+	get("s/default/stat/device")
+	get("s/default/rest/user")
+	get("s/default/stat/device")
+	assert.Equal(t, 2, cs.countRequestsTo(apiV1Path("s/default/stat/device")), "the second entry evicts the first")
+
+	rc.Purge()
+	get("s/default/stat/device")
+	assert.Equal(t, 3, cs.countRequestsTo(apiV1Path("s/default/stat/device")))
+
+	rc.Invalidate("other", "Device")
+	get("s/default/stat/device")
+	assert.Equal(t, 3, cs.countRequestsTo(apiV1Path("s/default/stat/device")), "another site is untouched")
+	rc.Invalidate("", "Device")
+	get("s/default/stat/device")
+	assert.Equal(t, 4, cs.countRequestsTo(apiV1Path("s/default/stat/device")))
+}
+
+func TestResponseCacheMetrics(t *testing.T) {
+	t.Parallel()
+	cs := cachedServer(t)
+	rec := newRecordingTelemetry()
+	c := cs.clientWith(withCache(&ResponseCache{}), withTelemetry(rec))
+
+	for range 2 {
+		_, err := c.ListDevice(context.Background(), "default")
+		require.NoError(t, err)
+	}
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	metrics := rec.metrics[MetricCacheRequests]
+	require.Len(t, metrics, 2)
+	for i, result := range []string{CacheMiss, CacheHit} {
+		assert.Equal(t, map[string]any{
+			AttrSurface:     "internal",
+			AttrResource:    "Device",
+			AttrCacheResult: result,
+			AttrSite:        "default",
+		}, metrics[i].attrs)
+	}
+	assert.Len(t, rec.metrics[MetricRequests], 1, "a hit sends no request")
+}
@@ -0,0 +1,148 @@
+package unifi
+
+import (
+	"context"
+	"maps"
+	"net/http"
+	"time"
+)
+
+// CallOption overrides a ClientConfig setting for the calls made with a
+// context, see WithCallOptions.
+type CallOption func(*callOptions)
+
+// callOptions are the per-call overrides carried by a context. A nil pointer
+// field keeps the client's setting.
+type callOptions struct {
+	timeout        *time.Duration
+	validationMode *ValidationMode
+	headers        http.Header
+	bodyLimit      int
+	auditActor     string
+	auditReason    string
+	auditBefore    any
+}
+
+// callOptionsKey is the context key of WithCallOptions.
+type callOptionsKey struct{}
+
+/*
+WithCallOptions returns a context that applies opts to every call made with it,
+on top of the client's configuration. Every method honors it, since the context
+is passed down to Do: the generated CRUD helpers, the Official API surface and
+raw Get/Post/Put/Patch/Delete calls alike.
+
+	ctx := unifi.WithCallOptions(ctx,
+		unifi.CallTimeout(10*time.Minute),
+		unifi.CallHeader("X-Correlation-ID", id),
+	)
+	devices, err := c.ListDevice(ctx, "default")
+
+Options of an outer WithCallOptions stay in effect unless overridden; headers
+accumulate.
+*/
+func WithCallOptions(ctx context.Context, opts ...CallOption) context.Context {
+	o := callOptionsFrom(ctx)
+	o.headers = o.headers.Clone()
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return context.WithValue(ctx, callOptionsKey{}, o)
+}
+
+// callOptionsFrom returns the call options carried by ctx.
+func callOptionsFrom(ctx context.Context) callOptions {
+	o, _ := ctx.Value(callOptionsKey{}).(callOptions)
+	return o
+}
+
+// CallTimeout replaces ClientConfig.Timeout for the call, so a long operation
+// such as a backup, firmware upload or spectrum scan can wait longer than the
+// client-wide limit (or a quick probe shorter). Zero means no timeout. A
+// deadline on the context still applies.
+func CallTimeout(timeout time.Duration) CallOption {
+	return func(o *callOptions) { o.timeout = &timeout }
+}
+
+// CallValidationMode replaces ClientConfig.ValidationMode for the call, e.g.
+// DisableValidation for a single update the controller accepts despite the
+// validation rules.
+func CallValidationMode(mode ValidationMode) CallOption {
+	return func(o *callOptions) { o.validationMode = &mode }
+}
+
+// CallHeader adds a header to the call, e.g. a correlation ID. It is set after
+// the interceptors ran, replacing any value they set for the same key; the
+// Content-Type of the request body is not overridden. A call with headers is
+// never served from ClientConfig.Cache, so the headers reach the controller.
+func CallHeader(key, value string) CallOption {
+	return func(o *callOptions) {
+		if o.headers == nil {
+			o.headers = http.Header{}
+		}
+		o.headers.Add(key, value)
+	}
+}
+
+// CallResponseBodyLimit overrides the response size cap (64 MiB by default)
+// for the call. A buffered call, such as ListUser, fails when the whole body
+// exceeds limit; a streaming call, such as Users().ListSeq, holds one list element
+// at a time and fails when a single element exceeds it. A limit <= 0 keeps the
+// default.
+func CallResponseBodyLimit(limit int) CallOption {
+	return func(o *callOptions) { o.bodyLimit = limit }
+}
+
+// WithResponseBodyLimit returns a context that overrides the response size cap
+// for the calls made with it. It is shorthand for WithCallOptions with
+// CallResponseBodyLimit.
+func WithResponseBodyLimit(ctx context.Context, limit int) context.Context {
+	return WithCallOptions(ctx, CallResponseBodyLimit(limit))
+}
+
+// responseBodyLimit returns the response size cap in effect for ctx.
+func responseBodyLimit(ctx context.Context) int {
+	if limit := callOptionsFrom(ctx).bodyLimit; limit > 0 {
+		return limit
+	}
+	return maxResponseBodySize
+}
+
+// validationModeFor returns the validation mode in effect for ctx.
+func (c *client) validationModeFor(ctx context.Context) ValidationMode {
+	if mode := callOptionsFrom(ctx).validationMode; mode != nil {
+		return *mode
+	}
+	return c.validationMode
+}
+
+// httpClientFor returns the HTTP client to send a request made with ctx: the
+// client's own, or a copy without its timeout when the call has its own, which
+// then bounds ctx instead (see withCallTimeout).
+func (c *client) httpClientFor(ctx context.Context) *http.Client {
+	if callOptionsFrom(ctx).timeout == nil || c.http.Timeout == 0 {
+		return c.http
+	}
+	hc := *c.http
+	hc.Timeout = 0
+	return &hc
+}
+
+// withCallTimeout bounds ctx by the call's CallTimeout, if any.
+func withCallTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if timeout := callOptionsFrom(ctx).timeout; timeout != nil && *timeout > 0 {
+		return context.WithTimeout(ctx, *timeout)
+	}
+	return ctx, func() {}
+}
+
+// callHeaders merges the call's headers under the request's own headers.
+func callHeaders(ctx context.Context, headers http.Header) http.Header {
+	extra := callOptionsFrom(ctx).headers
+	if len(extra) == 0 {
+		return headers
+	}
+	merged := extra.Clone()
+	maps.Copy(merged, headers)
+	return merged
+}
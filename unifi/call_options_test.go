@@ -0,0 +1,138 @@
+package unifi //nolint: testpackage
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallTimeout(t *testing.T) {
+	t.Parallel()
+	cs := newControllerServer(t, route{apiV1Path("s/default/stat/device"), func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(200 * time.Millisecond):
+		case <-r.Context().Done():
+			return
+		}
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"_id":"1"}]}`))
+	}})
+
+	tests := map[string]struct {
+		clientTimeout time.Duration
+		opts          []CallOption
+		wantErr       bool
+	}{
+		"client timeout applies":       {clientTimeout: 50 * time.Millisecond, wantErr: true},
+		"longer call timeout":          {clientTimeout: 50 * time.Millisecond, opts: []CallOption{CallTimeout(5 * time.Second)}},
+		"zero call timeout lifts it":   {clientTimeout: 50 * time.Millisecond, opts: []CallOption{CallTimeout(0)}},
+		"shorter call timeout":         {clientTimeout: 5 * time.Second, opts: []CallOption{CallTimeout(50 * time.Millisecond)}, wantErr: true},
+		"call timeout without default": {opts: []CallOption{CallTimeout(50 * time.Millisecond)}, wantErr: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			c := cs.clientWith(func(cfg *ClientConfig) { cfg.Timeout = tt.clientTimeout })
+
+			devices, err := c.ListDevice(WithCallOptions(context.Background(), tt.opts...), "default")
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, devices, 1)
+		})
+	}
+}
+
+func TestCallHeader(t *testing.T) {
+	t.Parallel()
+	var (
+		mu      sync.Mutex
+		headers []http.Header
+	)
+	cs := newControllerServer(t, route{apiV1Path("s/default/rest/user"), func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		headers = append(headers, r.Header.Clone())
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[]}`))
+	}})
+	c := cs.clientWith(withCache(&ResponseCache{}))
+
+	ctx := WithCallOptions(context.Background(), CallHeader("X-Correlation-ID", "abc"), CallHeader("User-Agent", "job/1"))
+	ctx = WithCallOptions(ctx, CallHeader("X-Tag", "t1"), CallHeader("Content-Type", "text/plain"))
+	require.NoError(t, c.Post(ctx, "s/default/rest/user", struct{}{}, nil))
+	for range 2 {
+		_, err := c.ListUser(ctx, "default")
+		require.NoError(t, err)
+	}
+	_, err := c.ListUser(context.Background(), "default")
+	require.NoError(t, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, headers, 4, "calls with headers bypass the cache")
+	post := headers[0]
+	assert.Equal(t, "abc", post.Get("X-Correlation-ID"))
+	assert.Equal(t, "t1", post.Get("X-Tag"), "headers of nested options accumulate")
+	assert.Equal(t, "job/1", post.Get("User-Agent"), "call headers replace interceptor headers")
+	assert.Equal(t, "application/json", post.Get("Content-Type"), "the body's content type wins")
+	assert.Equal(t, "test-key", post.Get("X-API-Key"), "interceptor headers are kept")
+	assert.Equal(t, "abc", headers[1].Get("X-Correlation-ID"))
+	assert.Empty(t, headers[3].Get("X-Correlation-ID"))
+	assert.NotEqual(t, "job/1", headers[3].Get("User-Agent"))
+}
+
+func TestCallValidationMode(t *testing.T) {
+	t.Parallel()
+	type named struct {
+		Name string `json:"name" validate:"required"`
+	}
+	cs := newControllerServer(t, route{apiV1Path("s/default/rest/thing"), func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[]}`))
+	}})
+
+	tests := map[string]struct {
+		clientMode ValidationMode
+		opts       []CallOption
+		wantErr    bool
+	}{
+		"client hard mode":          {clientMode: HardValidation, wantErr: true},
+		"disabled for the call":     {clientMode: HardValidation, opts: []CallOption{CallValidationMode(DisableValidation)}},
+		"soft for the call":         {clientMode: HardValidation, opts: []CallOption{CallValidationMode(SoftValidation)}},
+		"hard for the call":         {clientMode: SoftValidation, opts: []CallOption{CallValidationMode(HardValidation)}, wantErr: true},
+		"unrelated option keeps it": {clientMode: HardValidation, opts: []CallOption{CallTimeout(time.Second)}, wantErr: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			c := cs.clientWith(func(cfg *ClientConfig) { cfg.ValidationMode = tt.clientMode })
+
+			err := c.Post(WithCallOptions(context.Background(), tt.opts...), "s/default/rest/thing", &named{}, nil)
+			if tt.wantErr {
+				var ve *ValidationError
+				require.ErrorAs(t, err, &ve)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCallOptionsLayering(t *testing.T) {
+	t.Parallel()
+	base := WithCallOptions(context.Background(), CallTimeout(time.Second), CallResponseBodyLimit(10))
+	inner := WithCallOptions(base, CallResponseBodyLimit(20), CallHeader("X-A", "1"))
+
+	o := callOptionsFrom(inner)
+	require.NotNil(t, o.timeout)
+	assert.Equal(t, time.Second, *o.timeout, "outer options stay in effect")
+	assert.Equal(t, 20, responseBodyLimit(inner))
+	assert.Equal(t, 10, responseBodyLimit(base), "the outer context is not modified")
+	assert.Empty(t, callOptionsFrom(base).headers)
+	assert.Equal(t, maxResponseBodySize, responseBodyLimit(context.Background()))
+}
@@ -16,6 +16,26 @@ var (
 	_ json.Marshaler
 )
 
+// Self-register this resource's kind so the resourceKinds registry in
+// resource_registry.go mirrors the generated Client surface and can never
+// drift from it by hand.
+func init() { //nolint:gochecknoinits
+	registerResourceKind(ResourceKind{
+		Name:       "ChannelPlan",
+		Endpoint:   "channelplan",
+		APIVersion: APIVersionV1,
+		IDField:    "_id",
+		NameField:  "",
+		SiteScoped: true,
+	}, resourceOps[ChannelPlan]{
+		list:   InternalClient.ListChannelPlan,
+		get:    InternalClient.GetChannelPlan,
+		create: InternalClient.CreateChannelPlan,
+		update: InternalClient.UpdateChannelPlan,
+		delete: InternalClient.DeleteChannelPlan,
+	})
+}
+
 type ChannelPlan struct {
 	ID     string `json:"_id,omitempty"`
 	SiteID string `json:"site_id,omitempty"`
@@ -0,0 +1,631 @@
+package unifi
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidChannelPlan is returned by PlanChannels for options it cannot plan
+// with: an unsupported channel width, or a band left without channels.
+var ErrInvalidChannelPlan = errors.New("invalid channel plan")
+
+const (
+	radioNG = "ng"
+	radioNA = "na"
+	radio6E = "6e"
+)
+
+const (
+	// unknownNeighborSignal is the signal assumed between two APs of a band
+	// when nothing tells how well they hear each other.
+	unknownNeighborSignal = -70
+	// inaudibleSignal is the level below which APs do not contend for airtime.
+	inaudibleSignal = -90
+	// colocatedSignal is the signal assumed between two radios of one AP on the
+	// same band (dual 5 GHz), which sit centimeters apart.
+	colocatedSignal = -20
+	// conflictSignal is the level at which co-channel APs defer to each other
+	// (the preamble detection threshold).
+	conflictSignal = -82
+	// channelPlanPasses bounds the local search that improves the greedy plan.
+	channelPlanPasses = 20
+)
+
+var defaultChannelWidths = map[string]int{radioNG: 20, radioNA: 40, radio6E: 80}
+
+// channelRegion is the set of 5 and 6 GHz channels a regulatory domain permits.
+type channelRegion struct {
+	na, sixE []int
+}
+
+func channelRange(from, to int) []int {
+	var channels []int
+	for ch := from; ch <= to; ch += 4 {
+		channels = append(channels, ch)
+	}
+	return channels
+}
+
+var (
+	regionFCC   = channelRegion{na: slices.Concat(channelRange(36, 64), channelRange(100, 144), channelRange(149, 165)), sixE: channelRange(1, 233)}
+	regionETSI  = channelRegion{na: slices.Concat(channelRange(36, 64), channelRange(100, 140)), sixE: channelRange(1, 93)}
+	regionJapan = channelRegion{na: slices.Concat(channelRange(36, 64), channelRange(100, 144)), sixE: channelRange(1, 93)}
+	regionChina = channelRegion{na: slices.Concat(channelRange(36, 64), channelRange(149, 165))}
+	regionAU    = channelRegion{na: slices.Concat(channelRange(36, 64), channelRange(100, 116), channelRange(132, 144), channelRange(149, 165)), sixE: channelRange(1, 93)}
+	// regionDefault is used for countries without an entry: the 5 GHz
+	// channels permitted nearly everywhere, and no 6 GHz.
+	regionDefault = channelRegion{na: slices.Concat(channelRange(36, 64), channelRange(100, 140))}
+)
+
+// channelRegions maps SettingCountry.Code (ISO 3166-1 numeric) to its channels.
+var channelRegions = map[int]channelRegion{
+	840: regionFCC, 124: regionFCC, 76: regionFCC, 410: regionFCC, // US, CA, BR, KR
+	36: regionAU, 554: regionAU, // AU, NZ
+	156: regionChina,
+	392: regionJapan,
+	// EU, EEA, UK and Switzerland.
+	40: regionETSI, 56: regionETSI, 100: regionETSI, 191: regionETSI, 196: regionETSI, 203: regionETSI,
+	208: regionETSI, 233: regionETSI, 246: regionETSI, 250: regionETSI, 276: regionETSI, 300: regionETSI,
+	348: regionETSI, 352: regionETSI, 372: regionETSI, 380: regionETSI, 428: regionETSI, 440: regionETSI,
+	442: regionETSI, 470: regionETSI, 528: regionETSI, 578: regionETSI, 616: regionETSI, 620: regionETSI,
+	642: regionETSI, 703: regionETSI, 705: regionETSI, 724: regionETSI, 752: regionETSI, 756: regionETSI,
+	826: regionETSI,
+}
+
+// ChannelPlanRadio is a radio of an AP to plan, as in DeviceRadioTable.
+type ChannelPlanRadio struct {
+	// Radio is the band: "ng" (2.4 GHz), "na" (5 GHz) or "6e".
+	Radio string
+	Name  string
+}
+
+// ChannelPlanAP is an AP to plan channels for.
+type ChannelPlanAP struct {
+	MAC    string
+	Radios []ChannelPlanRadio
+	// Floor identifies the plan X and Y are measured on, in meters; positions
+	// are only compared within a floor. Positioned is false when the AP was
+	// not placed.
+	Floor      string
+	X, Y       float64
+	Positioned bool
+}
+
+// ChannelPlanNeighbor is how loudly an AP heard another of the site's APs.
+type ChannelPlanNeighbor struct {
+	MAC         string
+	NeighborMAC string
+	Radio       string
+	// Signal is in dBm.
+	Signal int
+}
+
+// ChannelPlanInput is what PlanChannels plans from: the APs, how they hear
+// each other, and the site's regulatory country. Maps().GetChannelPlanInput
+// gathers it from a site; it can also be built by hand, e.g. from a site survey.
+type ChannelPlanInput struct {
+	APs       []ChannelPlanAP
+	Neighbors []ChannelPlanNeighbor
+	// Country is SettingCountry.Code, the ISO 3166-1 numeric country code.
+	Country int
+}
+
+// ChannelPlanOptions constrain PlanChannels.
+type ChannelPlanOptions struct {
+	// AllowDFS permits the 5 GHz DFS channels (52 to 144). Radios on them
+	// leave the channel for a while when they detect radar.
+	AllowDFS bool
+	// Widths sets the channel width in MHz per band; the defaults are 20 for
+	// "ng", 40 for "na" and 80 for "6e". 2.4 GHz only supports 20.
+	Widths map[string]int
+	// Channels replaces the country's channels of a band, e.g. to use 1, 5,
+	// 9 and 13 on 2.4 GHz instead of 1, 6 and 11.
+	Channels map[string][]int
+}
+
+// ChannelAssignment is the channel and transmit power PlanChannels chose for a
+// radio.
+type ChannelAssignment struct {
+	MAC       string
+	Radio     string
+	RadioName string
+	// Channel is the primary (lowest) channel of the Width MHz block.
+	Channel int
+	Width   int
+	// TxPowerMode is "low", "medium" or "high", as in
+	// DeviceRadioTable.TxPowerMode: lower where neighbors are close.
+	TxPowerMode string
+}
+
+// ChannelConflict is a pair of radios PlanChannels had to leave on
+// overlapping channels within hearing of each other, for lack of channels.
+type ChannelConflict struct {
+	MAC         string
+	NeighborMAC string
+	Radio       string
+	Channel     int
+	// Signal is how loudly the radios hear each other, in dBm.
+	Signal int
+}
+
+// GeneratedChannelPlan is the result of PlanChannels.
+type GeneratedChannelPlan struct {
+	Assignments []ChannelAssignment
+	// Conflicts are sorted by signal, loudest first; a plan without conflicts
+	// is non-overlapping.
+	Conflicts []ChannelConflict
+}
+
+/*
+PlanChannels computes a channel, width and transmit power for every radio of
+in.APs. It runs offline: nothing is sent to the controller until the plan is
+applied with Apply and UpdateDevice.
+
+Two radios of a band interfere in proportion to how loudly they hear each other
+and how much their channels overlap. How loudly is, in order of preference, the
+strongest signal in in.Neighbors, an estimate from the APs' distance on the same
+floor, nothing when either AP reported neighbors on the band without the other,
+or else an assumed -70 dBm. The most constrained radios are assigned first, to
+the channel that adds the least interference, and the plan is then improved one
+radio at a time until no move helps.
+*/
+func PlanChannels(in *ChannelPlanInput, opts ChannelPlanOptions) (*GeneratedChannelPlan, error) {
+	region, ok := channelRegions[in.Country]
+	if !ok {
+		region = regionDefault
+	}
+	plan := &GeneratedChannelPlan{}
+	for _, radio := range []string{radioNG, radioNA, radio6E} {
+		var radios []plannedRadio
+		for i := range in.APs {
+			ap := &in.APs[i]
+			for _, r := range ap.Radios {
+				if r.Radio == radio {
+					radios = append(radios, plannedRadio{ap: ap, name: r.Name})
+				}
+			}
+		}
+		if len(radios) == 0 {
+			continue
+		}
+		width := cmp.Or(opts.Widths[radio], defaultChannelWidths[radio])
+		candidates, err := channelCandidates(radio, width, region, opts)
+		if err != nil {
+			return nil, err
+		}
+		slices.SortFunc(radios, func(a, b plannedRadio) int { return strings.Compare(a.ap.MAC, b.ap.MAC) })
+		band := newBandPlan(radio, radios, candidates, in)
+		band.assign()
+		band.appendTo(plan, width)
+	}
+	slices.SortFunc(plan.Conflicts, func(a, b ChannelConflict) int {
+		return cmp.Or(cmp.Compare(b.Signal, a.Signal), strings.Compare(a.MAC, b.MAC))
+	})
+	return plan, nil
+}
+
+// channelCandidate is a channel block a radio can be assigned, spanning lo to
+// hi MHz.
+type channelCandidate struct {
+	channel, lo, hi int
+}
+
+func (a channelCandidate) overlap(b channelCandidate) float64 {
+	shared := min(a.hi, b.hi) - max(a.lo, b.lo)
+	if shared <= 0 {
+		return 0
+	}
+	return float64(shared) / float64(min(a.hi-a.lo, b.hi-b.lo))
+}
+
+// channelCenter returns the center frequency in MHz of a 20 MHz channel.
+func channelCenter(radio string, channel int) int {
+	switch radio {
+	case radioNG:
+		if channel == 14 {
+			return 2484
+		}
+		return 2407 + 5*channel
+	case radio6E:
+		return 5950 + 5*channel
+	default:
+		return 5000 + 5*channel
+	}
+}
+
+// fiveGHzBlocks are the primary channels of the 5 GHz channel blocks wider
+// than 20 MHz, by width.
+var fiveGHzBlocks = map[int][]int{
+	40:  {36, 44, 52, 60, 100, 108, 116, 124, 132, 140, 149, 157},
+	80:  {36, 52, 100, 116, 132, 149},
+	160: {36, 100},
+}
+
+func isDFSChannel(radio string, channel int) bool {
+	return radio == radioNA && channel >= 52 && channel <= 144
+}
+
+// channelCandidates returns the channel blocks of width MHz the band permits.
+func channelCandidates(radio string, width int, region channelRegion, opts ChannelPlanOptions) ([]channelCandidate, error) {
+	var allowed []int
+	switch radio {
+	case radioNG:
+		if width != 20 {
+			return nil, fmt.Errorf("%w: %d MHz channels on %s, only 20 MHz is supported", ErrInvalidChannelPlan, width, radio)
+		}
+		allowed = []int{1, 6, 11}
+	case radioNA:
+		allowed = region.na
+	case radio6E:
+		allowed = region.sixE
+	}
+	if channels, ok := opts.Channels[radio]; ok {
+		allowed = channels
+	}
+	if !slices.Contains([]int{20, 40, 80, 160}, width) {
+		return nil, fmt.Errorf("%w: unsupported channel width %d MHz on %s", ErrInvalidChannelPlan, width, radio)
+	}
+	usable := func(channel int) bool {
+		return slices.Contains(allowed, channel) && (opts.AllowDFS || !isDFSChannel(radio, channel))
+	}
+
+	var starts []int
+	switch {
+	case width == 20:
+		starts = allowed
+	case radio == radioNA:
+		starts = fiveGHzBlocks[width]
+	default:
+		// 6 GHz blocks are aligned on channel 1.
+		for ch := 1; ch <= 233; ch += width / 5 {
+			starts = append(starts, ch)
+		}
+	}
+	var candidates []channelCandidate
+	for _, start := range starts {
+		last := start + 4*(width/20-1)
+		if slices.ContainsFunc(channelRange(start, last), func(ch int) bool { return !usable(ch) }) {
+			continue
+		}
+		candidates = append(candidates, channelCandidate{
+			channel: start,
+			lo:      channelCenter(radio, start) - 10,
+			hi:      channelCenter(radio, last) + 10,
+		})
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("%w: no %d MHz channels on %s for the country and options", ErrInvalidChannelPlan, width, radio)
+	}
+	return candidates, nil
+}
+
+type plannedRadio struct {
+	ap     *ChannelPlanAP
+	name   string
+	choice int
+}
+
+// bandPlan assigns the radios of one band.
+type bandPlan struct {
+	radio      string
+	radios     []plannedRadio
+	candidates []channelCandidate
+	// signal[i][j] is how loudly radios i and j hear each other, in dBm, or
+	// NaN when they do not.
+	signal [][]float64
+}
+
+func newBandPlan(radio string, radios []plannedRadio, candidates []channelCandidate, in *ChannelPlanInput) *bandPlan {
+	observed := map[[2]string]float64{}
+	scanned := map[string]bool{}
+	for _, n := range in.Neighbors {
+		if n.Radio != radio {
+			continue
+		}
+		a, b := strings.ToLower(n.MAC), strings.ToLower(n.NeighborMAC)
+		scanned[a] = true
+		for _, key := range [][2]string{{a, b}, {b, a}} {
+			if s, ok := observed[key]; !ok || float64(n.Signal) > s {
+				observed[key] = float64(n.Signal)
+			}
+		}
+	}
+
+	p := &bandPlan{radio: radio, radios: radios, candidates: candidates, signal: make([][]float64, len(radios))}
+	for i := range radios {
+		p.signal[i] = make([]float64, len(radios))
+		for j := range radios {
+			if i == j {
+				p.signal[i][j] = math.NaN()
+				continue
+			}
+			a, b := radios[i].ap, radios[j].ap
+			am, bm := strings.ToLower(a.MAC), strings.ToLower(b.MAC)
+			s, ok := observed[[2]string{am, bm}]
+			switch {
+			case a == b:
+				s = colocatedSignal
+			case ok:
+			case a.Positioned && b.Positioned && a.Floor == b.Floor:
+				s = estimatedSignal(radio, math.Hypot(a.X-b.X, a.Y-b.Y))
+			case scanned[am] || scanned[bm]:
+				s = math.NaN()
+			default:
+				s = unknownNeighborSignal
+			}
+			if s < inaudibleSignal {
+				s = math.NaN()
+			}
+			p.signal[i][j] = s
+		}
+	}
+	return p
+}
+
+// estimatedSignal estimates the signal between two APs meters apart, with an
+// indoor log-distance path loss model and typical transmit power.
+func estimatedSignal(radio string, meters float64) float64 {
+	const txPower, exponent = 20, 3.0
+	lossAt1m := map[string]float64{radioNG: 40, radioNA: 47, radio6E: 48}[radio]
+	return txPower - lossAt1m - 10*exponent*math.Log10(max(meters, 1))
+}
+
+// weight is how much radios i and j interfere on fully overlapping channels.
+func (p *bandPlan) weight(i, j int) float64 {
+	s := p.signal[i][j]
+	if math.IsNaN(s) {
+		return 0
+	}
+	return s - inaudibleSignal
+}
+
+// cost is the interference radio i suffers on candidate c from the radios
+// already assigned.
+func (p *bandPlan) cost(i, c int, assigned []bool) float64 {
+	total := 0.0
+	for j := range p.radios {
+		if j != i && assigned[j] {
+			total += p.weight(i, j) * p.candidates[c].overlap(p.candidates[p.radios[j].choice])
+		}
+	}
+	return total
+}
+
+func (p *bandPlan) assign() {
+	n := len(p.radios)
+	order := make([]int, n)
+	pressure := make([]float64, n)
+	for i := range n {
+		order[i] = i
+		for j := range n {
+			pressure[i] += p.weight(i, j)
+		}
+	}
+	slices.SortStableFunc(order, func(a, b int) int { return cmp.Compare(pressure[b], pressure[a]) })
+
+	assigned := make([]bool, n)
+	usage := make([]int, len(p.candidates))
+	for _, i := range order {
+		best, bestCost := 0, math.Inf(1)
+		for c := range p.candidates {
+			cost := p.cost(i, c, assigned)
+			// Among equally good channels, spread the radios out.
+			if cost < bestCost || (cost == bestCost && usage[c] < usage[best]) {
+				best, bestCost = c, cost
+			}
+		}
+		p.radios[i].choice = best
+		assigned[i] = true
+		usage[best]++
+	}
+
+	for range channelPlanPasses {
+		moved := false
+		for _, i := range order {
+			current := p.cost(i, p.radios[i].choice, assigned)
+			for c := range p.candidates {
+				if cost := p.cost(i, c, assigned); cost < current-1e-9 {
+					p.radios[i].choice, current, moved = c, cost, true
+				}
+			}
+		}
+		if !moved {
+			break
+		}
+	}
+}
+
+// txPowerMode lowers the power of radios with close neighbors, so cells stay
+// small where APs are dense; 2.4 GHz, which carries further, one step lower.
+func (p *bandPlan) txPowerMode(i int) string {
+	loudest := math.Inf(-1)
+	for j := range p.radios {
+		// A radio of the same AP is no reason to transmit more quietly.
+		if p.radios[j].ap == p.radios[i].ap {
+			continue
+		}
+		if s := p.signal[i][j]; !math.IsNaN(s) {
+			loudest = max(loudest, s)
+		}
+	}
+	modes := []string{"low", "medium", "high"}
+	level := 2
+	switch {
+	case loudest >= -60:
+		level = 0
+	case loudest >= -70:
+		level = 1
+	}
+	if p.radio == radioNG {
+		level = max(level-1, 0)
+	}
+	return modes[level]
+}
+
+func (p *bandPlan) appendTo(plan *GeneratedChannelPlan, width int) {
+	for i, r := range p.radios {
+		plan.Assignments = append(plan.Assignments, ChannelAssignment{
+			MAC:         r.ap.MAC,
+			Radio:       p.radio,
+			RadioName:   r.name,
+			Channel:     p.candidates[r.choice].channel,
+			Width:       width,
+			TxPowerMode: p.txPowerMode(i),
+		})
+		for j := i + 1; j < len(p.radios); j++ {
+			s := p.signal[i][j]
+			if math.IsNaN(s) || s < conflictSignal || p.candidates[r.choice].overlap(p.candidates[p.radios[j].choice]) == 0 {
+				continue
+			}
+			plan.Conflicts = append(plan.Conflicts, ChannelConflict{
+				MAC:         r.ap.MAC,
+				NeighborMAC: p.radios[j].ap.MAC,
+				Radio:       p.radio,
+				Channel:     p.candidates[r.choice].channel,
+				Signal:      int(math.Round(s)),
+			})
+		}
+	}
+}
+
+// Apply sets the channel, width and transmit power mode of d's radios from the
+// plan, as overrides to save with UpdateDevice. It reports whether any radio
+// changed; radios the plan has no assignment for are left alone. Radios are
+// matched by name when both sides have one, so each radio of an AP with two
+// radios on a band (dual 5 GHz) gets its own assignment, and by band otherwise.
+func (p *GeneratedChannelPlan) Apply(d *Device) bool {
+	changed := false
+	for i := range d.RadioTable {
+		r := &d.RadioTable[i]
+		for _, a := range p.Assignments {
+			if !strings.EqualFold(a.MAC, d.MAC) || a.Radio != r.Radio {
+				continue
+			}
+			if a.RadioName != "" && r.Name != "" && a.RadioName != r.Name {
+				continue
+			}
+			channel := strconv.Itoa(a.Channel)
+			if r.Channel != channel || r.Ht != a.Width || r.TxPowerMode != a.TxPowerMode {
+				r.Channel, r.Ht, r.TxPowerMode = channel, a.Width, a.TxPowerMode
+				changed = true
+			}
+		}
+	}
+	return changed
+}
+
+// ChannelPlan returns the plan as a ChannelPlan record dated at date, to keep
+// alongside the controller's own plans.
+func (p *GeneratedChannelPlan) ChannelPlan(date time.Time) *ChannelPlan {
+	plan := &ChannelPlan{Date: date.UTC().Format("2006-01-02T15:04:05Z")}
+	for _, a := range p.Assignments {
+		plan.RadioTable = append(plan.RadioTable, ChannelPlanRadioTable{
+			Channel:     strconv.Itoa(a.Channel),
+			DeviceMAC:   strings.ToLower(a.MAC),
+			Name:        a.RadioName,
+			TxPowerMode: a.TxPowerMode,
+			Width:       a.Width,
+		})
+	}
+	return plan
+}
+
+/*
+GetChannelPlanInput implements Maps().GetChannelPlanInput. It gathers the input
+of PlanChannels from the site: its APs and their radios, their positions, the
+other APs of the site each AP heard in its scans (see RogueAPs().List), and the
+site's country (SettingCountry).
+
+An AP's position comes from its place on a floor plan (Device.X and Y, scaled by
+the Map's units per pixel) or else from a SpatialRecord. Heat-map points are
+survey measurements rather than AP positions, so they are not used.
+*/
+func (c *client) GetChannelPlanInput(ctx context.Context, site string) (*ChannelPlanInput, error) {
+	devices, err := c.ListDevice(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+	floorMaps, err := c.ListMap(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+	records, err := c.ListSpatialRecord(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+	country, err := c.GetSettingCountry(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+	bssids, err := c.listSiteBSSIDs(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+	rogues, err := c.ListRogueAPs(ctx, site, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	in := &ChannelPlanInput{Country: country.Code}
+	for _, d := range devices {
+		if len(d.RadioTable) == 0 {
+			continue
+		}
+		ap := ChannelPlanAP{MAC: strings.ToLower(d.MAC)}
+		for _, r := range d.RadioTable {
+			ap.Radios = append(ap.Radios, ChannelPlanRadio{Radio: r.Radio, Name: r.Name})
+		}
+		placeChannelPlanAP(&ap, d, floorMaps, records)
+		in.APs = append(in.APs, ap)
+	}
+	for _, r := range rogues {
+		owner, ok := bssids[strings.ToLower(r.BSSID)]
+		if !ok || owner == strings.ToLower(r.APMAC) {
+			continue
+		}
+		signal := r.Signal
+		if signal == 0 {
+			signal = r.RSSI + inaudibleSignal
+		}
+		in.Neighbors = append(in.Neighbors, ChannelPlanNeighbor{
+			MAC:         strings.ToLower(r.APMAC),
+			NeighborMAC: owner,
+			Radio:       r.Radio,
+			Signal:      signal,
+		})
+	}
+	return in, nil
+}
+
+// placeChannelPlanAP positions ap from the device's place on its floor plan,
+// or else from the first spatial record placing it.
+func placeChannelPlanAP(ap *ChannelPlanAP, d Device, floorMaps []Map, records []SpatialRecord) {
+	if d.MapID != "" && (d.X != 0 || d.Y != 0) {
+		for _, m := range floorMaps {
+			if m.ID != d.MapID || m.Upp <= 0 {
+				continue
+			}
+			scale := m.Upp
+			if m.Unit == "f" {
+				scale *= 0.3048
+			}
+			ap.Floor, ap.X, ap.Y, ap.Positioned = m.ID, d.X*scale, d.Y*scale, true
+			return
+		}
+	}
+	for _, rec := range records {
+		for _, rd := range rec.Devices {
+			if strings.EqualFold(rd.MAC, d.MAC) {
+				ap.Floor, ap.X, ap.Y, ap.Positioned = rec.ID, rd.Position.X, rd.Position.Y, true
+				return
+			}
+		}
+	}
+}
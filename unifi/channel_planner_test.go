@@ -0,0 +1,254 @@
+package unifi //nolint: testpackage
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func planAP(mac string, radios ...string) ChannelPlanAP {
+	ap := ChannelPlanAP{MAC: mac}
+	for _, r := range radios {
+		ap.Radios = append(ap.Radios, ChannelPlanRadio{Radio: r, Name: "wifi-" + r})
+	}
+	return ap
+}
+
+func assignmentsByMAC(plan *GeneratedChannelPlan, radio string) map[string]ChannelAssignment {
+	out := map[string]ChannelAssignment{}
+	for _, a := range plan.Assignments {
+		if a.Radio == radio {
+			out[a.MAC] = a
+		}
+	}
+	return out
+}
+
+func TestPlanChannels2GHz(t *testing.T) {
+	t.Parallel()
+	// Three APs hearing each other loudly, and a fourth far from all but c.
+	in := &ChannelPlanInput{
+		APs: []ChannelPlanAP{planAP("a", "ng"), planAP("b", "ng"), planAP("c", "ng"), planAP("d", "ng")},
+		Neighbors: []ChannelPlanNeighbor{
+			{MAC: "a", NeighborMAC: "b", Radio: "ng", Signal: -50},
+			{MAC: "b", NeighborMAC: "c", Radio: "ng", Signal: -55},
+			{MAC: "c", NeighborMAC: "a", Radio: "ng", Signal: -58},
+			{MAC: "d", NeighborMAC: "c", Radio: "ng", Signal: -85},
+		},
+		Country: 840,
+	}
+
+	plan, err := PlanChannels(in, ChannelPlanOptions{})
+	require.NoError(t, err)
+	got := assignmentsByMAC(plan, "ng")
+	require.Len(t, got, 4)
+	assert.ElementsMatch(t, []int{1, 6, 11}, []int{got["a"].Channel, got["b"].Channel, got["c"].Channel})
+	assert.NotEqual(t, got["c"].Channel, got["d"].Channel, "d avoids the only AP it hears")
+	assert.Empty(t, plan.Conflicts)
+	assert.Equal(t, 20, got["a"].Width)
+	assert.Equal(t, "low", got["a"].TxPowerMode, "close neighbors lower the power")
+	assert.Equal(t, "medium", got["d"].TxPowerMode, "2.4 GHz stays a step below 5 GHz")
+	assert.Equal(t, "wifi-ng", got["a"].RadioName)
+
+	// A fourth loud AP cannot get a channel of its own.
+	in.Neighbors = append(in.Neighbors, ChannelPlanNeighbor{MAC: "d", NeighborMAC: "a", Radio: "ng", Signal: -60},
+		ChannelPlanNeighbor{MAC: "d", NeighborMAC: "b", Radio: "ng", Signal: -75},
+		ChannelPlanNeighbor{MAC: "c", NeighborMAC: "d", Radio: "ng", Signal: -70})
+	plan, err = PlanChannels(in, ChannelPlanOptions{})
+	require.NoError(t, err)
+	require.Len(t, plan.Conflicts, 1)
+	assert.Equal(t, -75, plan.Conflicts[0].Signal, "the quietest pair shares a channel")
+}
+
+func TestPlanChannels5GHz(t *testing.T) {
+	t.Parallel()
+	in := &ChannelPlanInput{APs: []ChannelPlanAP{planAP("a", "na"), planAP("b", "na"), planAP("c", "na")}, Country: 840}
+
+	plan, err := PlanChannels(in, ChannelPlanOptions{Widths: map[string]int{"na": 80}})
+	require.NoError(t, err)
+	got := assignmentsByMAC(plan, "na")
+	for _, a := range got {
+		assert.Contains(t, []int{36, 149}, a.Channel, "without DFS, only two 80 MHz blocks remain")
+		assert.Equal(t, 80, a.Width)
+	}
+	require.Len(t, plan.Conflicts, 1, "APs that are not known to be apart are assumed to hear each other")
+	assert.Equal(t, unknownNeighborSignal, plan.Conflicts[0].Signal)
+
+	plan, err = PlanChannels(in, ChannelPlanOptions{AllowDFS: true, Widths: map[string]int{"na": 80}})
+	require.NoError(t, err)
+	assert.Empty(t, plan.Conflicts)
+	channels := map[int]bool{}
+	for _, a := range plan.Assignments {
+		channels[a.Channel] = true
+	}
+	assert.Len(t, channels, 3)
+}
+
+func TestPlanChannelsPositions(t *testing.T) {
+	t.Parallel()
+	near := planAP("a", "na")
+	near.Floor, near.X, near.Y, near.Positioned = "f1", 0, 0, true
+	nearby := planAP("b", "na")
+	nearby.Floor, nearby.X, nearby.Y, nearby.Positioned = "f1", 3, 4, true
+	far := planAP("c", "na")
+	far.Floor, far.X, far.Y, far.Positioned = "f1", 500, 0, true
+	in := &ChannelPlanInput{APs: []ChannelPlanAP{near, nearby, far}, Country: 250}
+
+	plan, err := PlanChannels(in, ChannelPlanOptions{Channels: map[string][]int{"na": {36, 40}}, Widths: map[string]int{"na": 20}})
+	require.NoError(t, err)
+	got := assignmentsByMAC(plan, "na")
+	assert.NotEqual(t, got["a"].Channel, got["b"].Channel)
+	assert.Empty(t, plan.Conflicts, "the distant AP is out of hearing")
+	assert.Equal(t, "high", got["c"].TxPowerMode)
+	assert.Equal(t, "low", got["a"].TxPowerMode, "5 m apart")
+}
+
+// TestPlanChannelsDual5GHz proves the two 5 GHz radios of one AP never share a
+// channel, even when the AP is not positioned and has heard other neighbors.
+func TestPlanChannelsDual5GHz(t *testing.T) {
+	t.Parallel()
+	dual := ChannelPlanAP{MAC: "a", Radios: []ChannelPlanRadio{{Radio: "na", Name: "wifi1"}, {Radio: "na", Name: "wifi2"}}}
+	in := &ChannelPlanInput{
+		APs:       []ChannelPlanAP{dual, planAP("b", "na")},
+		Neighbors: []ChannelPlanNeighbor{{MAC: "a", NeighborMAC: "b", Radio: "na", Signal: -80}},
+		Country:   840,
+	}
+
+	for _, tc := range []struct {
+		width int
+		dfs   bool
+	}{{80, false}, {160, true}} {
+		plan, err := PlanChannels(in, ChannelPlanOptions{AllowDFS: tc.dfs, Widths: map[string]int{"na": tc.width}})
+		require.NoError(t, err)
+		radios := map[string]ChannelAssignment{}
+		for _, a := range plan.Assignments {
+			if a.MAC == "a" {
+				radios[a.RadioName] = a
+			}
+		}
+		require.Len(t, radios, 2)
+		assert.NotEqual(t, radios["wifi1"].Channel, radios["wifi2"].Channel, "%d MHz", tc.width)
+		for _, c := range plan.Conflicts {
+			assert.NotEqual(t, c.MAC, c.NeighborMAC, "%d MHz: the AP's radios do not share a channel", tc.width)
+		}
+		assert.Equal(t, "high", radios["wifi1"].TxPowerMode, "the AP's own radio does not lower its power")
+	}
+}
+
+func TestPlanChannelsInvalid(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		in   *ChannelPlanInput
+		opts ChannelPlanOptions
+	}{
+		"40 MHz on 2.4 GHz":    {&ChannelPlanInput{APs: []ChannelPlanAP{planAP("a", "ng")}}, ChannelPlanOptions{Widths: map[string]int{"ng": 40}}},
+		"unsupported width":    {&ChannelPlanInput{APs: []ChannelPlanAP{planAP("a", "na")}}, ChannelPlanOptions{Widths: map[string]int{"na": 60}}},
+		"no 6 GHz in country":  {&ChannelPlanInput{APs: []ChannelPlanAP{planAP("a", "6e")}, Country: 156}, ChannelPlanOptions{}},
+		"160 MHz without DFS":  {&ChannelPlanInput{APs: []ChannelPlanAP{planAP("a", "na")}, Country: 840}, ChannelPlanOptions{Widths: map[string]int{"na": 160}}},
+		"no channels overlaid": {&ChannelPlanInput{APs: []ChannelPlanAP{planAP("a", "na")}}, ChannelPlanOptions{Channels: map[string][]int{"na": {}}}},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			_, err := PlanChannels(tc.in, tc.opts)
+			require.ErrorIs(t, err, ErrInvalidChannelPlan)
+		})
+	}
+}
+
+func TestGeneratedChannelPlanApply(t *testing.T) {
+	t.Parallel()
+	plan := &GeneratedChannelPlan{Assignments: []ChannelAssignment{
+		{MAC: "aa:bb:cc:00:00:01", Radio: "ng", RadioName: "wifi0", Channel: 6, Width: 20, TxPowerMode: "medium"},
+		{MAC: "aa:bb:cc:00:00:01", Radio: "na", RadioName: "wifi1", Channel: 149, Width: 80, TxPowerMode: "high"},
+	}}
+	d := &Device{MAC: "AA:BB:CC:00:00:01", RadioTable: []DeviceRadioTable{
+		{Radio: "ng", Channel: "auto", TxPowerMode: "auto"},
+		{Radio: "na", Channel: "36", Ht: 40},
+		{Radio: "6e", Channel: "auto"},
+	}}
+
+	require.True(t, plan.Apply(d))
+	assert.Equal(t, DeviceRadioTable{Radio: "ng", Channel: "6", Ht: 20, TxPowerMode: "medium"}, d.RadioTable[0])
+	assert.Equal(t, DeviceRadioTable{Radio: "na", Channel: "149", Ht: 80, TxPowerMode: "high"}, d.RadioTable[1])
+	assert.Equal(t, "auto", d.RadioTable[2].Channel, "radios without an assignment are left alone")
+	assert.False(t, plan.Apply(d), "applying again changes nothing")
+	assert.False(t, plan.Apply(&Device{MAC: "aa:bb:cc:00:00:02", RadioTable: []DeviceRadioTable{{Radio: "ng"}}}))
+
+	record := plan.ChannelPlan(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	assert.Equal(t, "2026-01-02T03:04:05Z", record.Date)
+	assert.Equal(t, []ChannelPlanRadioTable{
+		{Channel: "6", DeviceMAC: "aa:bb:cc:00:00:01", Name: "wifi0", TxPowerMode: "medium", Width: 20},
+		{Channel: "149", DeviceMAC: "aa:bb:cc:00:00:01", Name: "wifi1", TxPowerMode: "high", Width: 80},
+	}, record.RadioTable)
+}
+
+func TestGeneratedChannelPlanApplyDual5GHz(t *testing.T) {
+	t.Parallel()
+	plan := &GeneratedChannelPlan{Assignments: []ChannelAssignment{
+		{MAC: "aa:bb:cc:00:00:01", Radio: "na", RadioName: "wifi1", Channel: 36, Width: 80, TxPowerMode: "high"},
+		{MAC: "aa:bb:cc:00:00:01", Radio: "na", RadioName: "wifi2", Channel: 149, Width: 80, TxPowerMode: "high"},
+	}}
+	d := &Device{MAC: "aa:bb:cc:00:00:01", RadioTable: []DeviceRadioTable{
+		{Radio: "na", Name: "wifi1", Channel: "auto"},
+		{Radio: "na", Name: "wifi2", Channel: "auto"},
+	}}
+
+	require.True(t, plan.Apply(d))
+	assert.Equal(t, "36", d.RadioTable[0].Channel)
+	assert.Equal(t, "149", d.RadioTable[1].Channel, "each 5 GHz radio gets its own assignment")
+	assert.False(t, plan.Apply(d))
+}
+
+func TestGetChannelPlanInput(t *testing.T) {
+	t.Parallel()
+	okJSON := func(body string) func(http.ResponseWriter, *http.Request) {
+		return func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":` + body + `}`))
+		}
+	}
+	cs := newControllerServer(t,
+		route{apiV1Path("s/default/stat/device"), okJSON(`[
+			{"mac":"aa:bb:cc:00:00:01","map_id":"m1","x":100,"y":50,"radio_table":[{"radio":"ng","name":"wifi0"},{"radio":"na","name":"wifi1"}],
+			 "vap_table":[{"bssid":"aa:bb:cc:00:00:a1"}]},
+			{"mac":"aa:bb:cc:00:00:02","radio_table":[{"radio":"na","name":"wifi1"}],"vap_table":[{"bssid":"aa:bb:cc:00:00:a2"}]},
+			{"mac":"aa:bb:cc:00:00:03","type":"usw"}
+		]`)},
+		route{apiV1Path("s/default/rest/map"), okJSON(`[{"_id":"m1","upp":0.1,"unit":"f"}]`)},
+		route{apiV1Path("s/default/rest/spatialrecord"), okJSON(`[{"_id":"r1","devices":[{"mac":"AA:BB:CC:00:00:02","position":{"x":4,"y":2}}]}]`)},
+		route{apiV1Path("s/default/get/setting"), okJSON(`[{"key":"country","code":276}]`)},
+		route{apiV1Path("s/default/stat/rogueap"), okJSON(`[
+			{"bssid":"aa:bb:cc:00:00:a2","radio":"na","signal":-61,"ap_mac":"aa:bb:cc:00:00:01"},
+			{"bssid":"aa:bb:cc:00:00:a1","radio":"na","rssi":25,"ap_mac":"aa:bb:cc:00:00:02"},
+			{"bssid":"aa:bb:cc:00:00:a1","radio":"na","signal":-30,"ap_mac":"aa:bb:cc:00:00:01"},
+			{"bssid":"de:ad:be:ef:00:01","radio":"na","signal":-40,"ap_mac":"aa:bb:cc:00:00:01"}
+		]`)},
+	)
+
+	in, err := cs.client().Maps().GetChannelPlanInput(context.Background(), "default")
+	require.NoError(t, err)
+	assert.Equal(t, 276, in.Country)
+	require.Len(t, in.APs, 2, "devices without radios are not planned")
+	first := in.APs[0]
+	assert.Equal(t, []ChannelPlanRadio{{Radio: "ng", Name: "wifi0"}, {Radio: "na", Name: "wifi1"}}, first.Radios)
+	assert.True(t, first.Positioned)
+	assert.Equal(t, "m1", first.Floor)
+	assert.InDelta(t, 3.048, first.X, 1e-9, "feet are converted to meters")
+	assert.InDelta(t, 1.524, first.Y, 1e-9)
+	assert.Equal(t, "r1", in.APs[1].Floor)
+	assert.InDelta(t, 4.0, in.APs[1].X, 0)
+
+	assert.Equal(t, []ChannelPlanNeighbor{
+		{MAC: "aa:bb:cc:00:00:01", NeighborMAC: "aa:bb:cc:00:00:02", Radio: "na", Signal: -61},
+		{MAC: "aa:bb:cc:00:00:02", NeighborMAC: "aa:bb:cc:00:00:01", Radio: "na", Signal: -65},
+	}, in.Neighbors, "only other APs of the site are neighbors")
+
+	plan, err := PlanChannels(in, ChannelPlanOptions{})
+	require.NoError(t, err)
+	assert.Len(t, plan.Assignments, 3)
+	assert.Empty(t, plan.Conflicts)
+}
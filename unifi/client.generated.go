@@ -10,26 +10,77 @@ import (
 	"github.com/filipowm/go-unifi/v2/unifi/official"
 )
 
-// InternalClient is the legacy UniFi Network ("Internal") API surface: every
-// resource CRUD method. In 2.0.0 this is the canonical client; 3.0.0 is expected
-// to flip the default to the Official OpenAPI client.
+// InternalClient is the legacy UniFi Network ("Internal") API surface, exposed as
+// one fluent accessor per resource group (e.g. Firewall().ListRule(ctx, site)).
+// The flat resource methods it also carries are deprecated forwarding aliases of
+// the grouped ones, kept so existing code keeps compiling; methods added since
+// are only reachable through their group. In 2.0.0 this is the canonical client;
+// 3.0.0 is expected to flip the default to the Official OpenAPI client.
 type InternalClient interface {
+	// DNS returns the DNS resource group.
+	DNS() DNSClient
+	// DPI returns the DPI resource group.
+	DPI() DPIClient
+	// Devices returns the Devices resource group.
+	Devices() DevicesClient
+	// Features returns the Features resource group.
+	Features() FeaturesClient
+	// Firewall returns the Firewall resource group.
+	Firewall() FirewallClient
+	// Hotspot returns the Hotspot resource group.
+	Hotspot() HotspotClient
+	// Maps returns the Maps resource group.
+	Maps() MapsClient
+	// Networks returns the Networks resource group.
+	Networks() NetworksClient
+	// RADIUS returns the RADIUS resource group.
+	RADIUS() RADIUSClient
+	// Reports returns the Reports resource group.
+	Reports() ReportsClient
+	// RogueAPs returns the RogueAPs resource group.
+	RogueAPs() RogueAPsClient
+	// Settings returns the Settings resource group.
+	Settings() SettingsClient
+	// Sites returns the Sites resource group.
+	Sites() SitesClient
+	// SpectrumScans returns the SpectrumScans resource group.
+	SpectrumScans() SpectrumScansClient
+	// SpeedTests returns the SpeedTests resource group.
+	SpeedTests() SpeedTestsClient
+	// System returns the System resource group.
+	System() SystemClient
+	// TrafficFlows returns the TrafficFlows resource group.
+	TrafficFlows() TrafficFlowsClient
+	// Users returns the Users resource group.
+	Users() UsersClient
+	// WLANs returns the WLANs resource group.
+	WLANs() WLANsClient
 
 	// ==== client methods for APGroup resource ====
 
 	// CreateAPGroup creates a resource
+	//
+	// Deprecated: use WLANs().CreateAPGroup instead.
 	CreateAPGroup(ctx context.Context, site string, a *APGroup) (*APGroup, error)
 
 	// DeleteAPGroup deletes a resource
+	//
+	// Deprecated: use WLANs().DeleteAPGroup instead.
 	DeleteAPGroup(ctx context.Context, site string, id string) error
 
 	// GetAPGroup retrieves a resource
+	//
+	// Deprecated: use WLANs().GetAPGroup instead.
 	GetAPGroup(ctx context.Context, site string, id string) (*APGroup, error)
 
 	// ListAPGroup lists the resources
+	//
+	// Deprecated: use WLANs().ListAPGroup instead.
 	ListAPGroup(ctx context.Context, site string) ([]APGroup, error)
 
 	// UpdateAPGroup updates a resource
+	//
+	// Deprecated: use WLANs().UpdateAPGroup instead.
 	UpdateAPGroup(ctx context.Context, site string, a *APGroup) (*APGroup, error)
 
 	// ==== end of client methods for APGroup resource ====
@@ -37,18 +88,28 @@ type InternalClient interface {
 	// ==== client methods for Account resource ====
 
 	// CreateAccount creates a resource
+	//
+	// Deprecated: use RADIUS().CreateAccount instead.
 	CreateAccount(ctx context.Context, site string, a *Account) (*Account, error)
 
 	// DeleteAccount deletes a resource
+	//
+	// Deprecated: use RADIUS().DeleteAccount instead.
 	DeleteAccount(ctx context.Context, site string, id string) error
 
 	// GetAccount retrieves a resource
+	//
+	// Deprecated: use RADIUS().GetAccount instead.
 	GetAccount(ctx context.Context, site string, id string) (*Account, error)
 
 	// ListAccount lists the resources
+	//
+	// Deprecated: use RADIUS().ListAccount instead.
 	ListAccount(ctx context.Context, site string) ([]Account, error)
 
 	// UpdateAccount updates a resource
+	//
+	// Deprecated: use RADIUS().UpdateAccount instead.
 	UpdateAccount(ctx context.Context, site string, a *Account) (*Account, error)
 
 	// ==== end of client methods for Account resource ====
@@ -56,18 +117,28 @@ type InternalClient interface {
 	// ==== client methods for BroadcastGroup resource ====
 
 	// CreateBroadcastGroup creates a resource
+	//
+	// Deprecated: use System().CreateBroadcastGroup instead.
 	CreateBroadcastGroup(ctx context.Context, site string, b *BroadcastGroup) (*BroadcastGroup, error)
 
 	// DeleteBroadcastGroup deletes a resource
+	//
+	// Deprecated: use System().DeleteBroadcastGroup instead.
 	DeleteBroadcastGroup(ctx context.Context, site string, id string) error
 
 	// GetBroadcastGroup retrieves a resource
+	//
+	// Deprecated: use System().GetBroadcastGroup instead.
 	GetBroadcastGroup(ctx context.Context, site string, id string) (*BroadcastGroup, error)
 
 	// ListBroadcastGroup lists the resources
+	//
+	// Deprecated: use System().ListBroadcastGroup instead.
 	ListBroadcastGroup(ctx context.Context, site string) ([]BroadcastGroup, error)
 
 	// UpdateBroadcastGroup updates a resource
+	//
+	// Deprecated: use System().UpdateBroadcastGroup instead.
 	UpdateBroadcastGroup(ctx context.Context, site string, b *BroadcastGroup) (*BroadcastGroup, error)
 
 	// ==== end of client methods for BroadcastGroup resource ====
@@ -75,18 +146,28 @@ type InternalClient interface {
 	// ==== client methods for ChannelPlan resource ====
 
 	// CreateChannelPlan creates a resource
+	//
+	// Deprecated: use Maps().CreateChannelPlan instead.
 	CreateChannelPlan(ctx context.Context, site string, c *ChannelPlan) (*ChannelPlan, error)
 
 	// DeleteChannelPlan deletes a resource
+	//
+	// Deprecated: use Maps().DeleteChannelPlan instead.
 	DeleteChannelPlan(ctx context.Context, site string, id string) error
 
 	// GetChannelPlan retrieves a resource
+	//
+	// Deprecated: use Maps().GetChannelPlan instead.
 	GetChannelPlan(ctx context.Context, site string, id string) (*ChannelPlan, error)
 
 	// ListChannelPlan lists the resources
+	//
+	// Deprecated: use Maps().ListChannelPlan instead.
 	ListChannelPlan(ctx context.Context, site string) ([]ChannelPlan, error)
 
 	// UpdateChannelPlan updates a resource
+	//
+	// Deprecated: use Maps().UpdateChannelPlan instead.
 	UpdateChannelPlan(ctx context.Context, site string, c *ChannelPlan) (*ChannelPlan, error)
 
 	// ==== end of client methods for ChannelPlan resource ====
@@ -94,15 +175,23 @@ type InternalClient interface {
 	// ==== client methods for ContentFiltering resource ====
 
 	// CreateContentFiltering creates a resource
+	//
+	// Deprecated: use Firewall().CreateContentFiltering instead.
 	CreateContentFiltering(ctx context.Context, site string, c *ContentFiltering) (*ContentFiltering, error)
 
 	// DeleteContentFiltering deletes a resource
+	//
+	// Deprecated: use Firewall().DeleteContentFiltering instead.
 	DeleteContentFiltering(ctx context.Context, site string, id string) error
 
 	// ListContentFiltering lists the resources
+	//
+	// Deprecated: use Firewall().ListContentFiltering instead.
 	ListContentFiltering(ctx context.Context, site string) ([]ContentFiltering, error)
 
 	// UpdateContentFiltering updates a resource
+	//
+	// Deprecated: use Firewall().UpdateContentFiltering instead.
 	UpdateContentFiltering(ctx context.Context, site string, c *ContentFiltering) (*ContentFiltering, error)
 
 	// ==== end of client methods for ContentFiltering resource ====
@@ -110,18 +199,28 @@ type InternalClient interface {
 	// ==== client methods for DHCPOption resource ====
 
 	// CreateDHCPOption creates a resource
+	//
+	// Deprecated: use Networks().CreateDHCPOption instead.
 	CreateDHCPOption(ctx context.Context, site string, d *DHCPOption) (*DHCPOption, error)
 
 	// DeleteDHCPOption deletes a resource
+	//
+	// Deprecated: use Networks().DeleteDHCPOption instead.
 	DeleteDHCPOption(ctx context.Context, site string, id string) error
 
 	// GetDHCPOption retrieves a resource
+	//
+	// Deprecated: use Networks().GetDHCPOption instead.
 	GetDHCPOption(ctx context.Context, site string, id string) (*DHCPOption, error)
 
 	// ListDHCPOption lists the resources
+	//
+	// Deprecated: use Networks().ListDHCPOption instead.
 	ListDHCPOption(ctx context.Context, site string) ([]DHCPOption, error)
 
 	// UpdateDHCPOption updates a resource
+	//
+	// Deprecated: use Networks().UpdateDHCPOption instead.
 	UpdateDHCPOption(ctx context.Context, site string, d *DHCPOption) (*DHCPOption, error)
 
 	// ==== end of client methods for DHCPOption resource ====
@@ -129,18 +228,28 @@ type InternalClient interface {
 	// ==== client methods for DNSRecord resource ====
 
 	// CreateDNSRecord creates a resource
+	//
+	// Deprecated: use DNS().CreateRecord instead.
 	CreateDNSRecord(ctx context.Context, site string, d *DNSRecord) (*DNSRecord, error)
 
 	// DeleteDNSRecord deletes a resource
+	//
+	// Deprecated: use DNS().DeleteRecord instead.
 	DeleteDNSRecord(ctx context.Context, site string, id string) error
 
 	// GetDNSRecord retrieves a resource
+	//
+	// Deprecated: use DNS().GetRecord instead.
 	GetDNSRecord(ctx context.Context, site string, id string) (*DNSRecord, error)
 
 	// ListDNSRecord lists the resources
+	//
+	// Deprecated: use DNS().ListRecord instead.
 	ListDNSRecord(ctx context.Context, site string) ([]DNSRecord, error)
 
 	// UpdateDNSRecord updates a resource
+	//
+	// Deprecated: use DNS().UpdateRecord instead.
 	UpdateDNSRecord(ctx context.Context, site string, d *DNSRecord) (*DNSRecord, error)
 
 	// ==== end of client methods for DNSRecord resource ====
@@ -148,54 +257,85 @@ type InternalClient interface {
 	// ==== client methods for Dashboard resource ====
 
 	// CreateDashboard creates a resource
+	//
+	// Deprecated: use System().CreateDashboard instead.
 	CreateDashboard(ctx context.Context, site string, d *Dashboard) (*Dashboard, error)
 
 	// DeleteDashboard deletes a resource
+	//
+	// Deprecated: use System().DeleteDashboard instead.
 	DeleteDashboard(ctx context.Context, site string, id string) error
 
 	// GetDashboard retrieves a resource
+	//
+	// Deprecated: use System().GetDashboard instead.
 	GetDashboard(ctx context.Context, site string, id string) (*Dashboard, error)
 
 	// ListDashboard lists the resources
+	//
+	// Deprecated: use System().ListDashboard instead.
 	ListDashboard(ctx context.Context, site string) ([]Dashboard, error)
 
 	// UpdateDashboard updates a resource
+	//
+	// Deprecated: use System().UpdateDashboard instead.
 	UpdateDashboard(ctx context.Context, site string, d *Dashboard) (*Dashboard, error)
 
 	// ==== end of client methods for Dashboard resource ====
 
 	// GetFeature returns a specific feature by it's name. Name is case-insensitive.
+	//
+	// Deprecated: use Features().Get instead.
 	GetFeature(ctx context.Context, site string, name string) (*DescribedFeature, error)
 
 	// IsFeatureEnabled returns if a specific feature is enabled by it's name. Name is case-insensitive.
+	//
+	// Deprecated: use Features().IsEnabled instead.
 	IsFeatureEnabled(ctx context.Context, site string, name string) (bool, error)
 
 	// ListFeatures returns all features of the UniFi controller.
+	//
+	// Deprecated: use Features().List instead.
 	ListFeatures(ctx context.Context, site string) ([]DescribedFeature, error)
 
 	// ==== client methods for Device resource ====
 
 	// AdoptDevice adopts a device by MAC address.
+	//
+	// Deprecated: use Devices().Adopt instead.
 	AdoptDevice(ctx context.Context, site string, mac string) error
 
 	// CreateDevice creates a resource
+	//
+	// Deprecated: use Devices().Create instead.
 	CreateDevice(ctx context.Context, site string, d *Device) (*Device, error)
 
 	// DeleteDevice deletes a resource
+	//
+	// Deprecated: use Devices().Delete instead.
 	DeleteDevice(ctx context.Context, site string, id string) error
 
 	// ForgetDevice forgets a device by MAC address.
+	//
+	// Deprecated: use Devices().Forget instead.
 	ForgetDevice(ctx context.Context, site string, mac string) error
 
 	// GetDevice retrieves a resource
+	//
+	// Deprecated: use Devices().Get instead.
 	GetDevice(ctx context.Context, site string, id string) (*Device, error)
 
+	// Deprecated: use Devices().GetByMAC instead.
 	GetDeviceByMAC(ctx context.Context, site string, mac string) (*Device, error)
 
 	// ListDevice lists the resources
+	//
+	// Deprecated: use Devices().List instead.
 	ListDevice(ctx context.Context, site string) ([]Device, error)
 
 	// UpdateDevice updates a resource
+	//
+	// Deprecated: use Devices().Update instead.
 	UpdateDevice(ctx context.Context, site string, d *Device) (*Device, error)
 
 	// ==== end of client methods for Device resource ====
@@ -203,18 +343,28 @@ type InternalClient interface {
 	// ==== client methods for DynamicDNS resource ====
 
 	// CreateDynamicDNS creates a resource
+	//
+	// Deprecated: use Networks().CreateDynamicDNS instead.
 	CreateDynamicDNS(ctx context.Context, site string, d *DynamicDNS) (*DynamicDNS, error)
 
 	// DeleteDynamicDNS deletes a resource
+	//
+	// Deprecated: use Networks().DeleteDynamicDNS instead.
 	DeleteDynamicDNS(ctx context.Context, site string, id string) error
 
 	// GetDynamicDNS retrieves a resource
+	//
+	// Deprecated: use Networks().GetDynamicDNS instead.
 	GetDynamicDNS(ctx context.Context, site string, id string) (*DynamicDNS, error)
 
 	// ListDynamicDNS lists the resources
+	//
+	// Deprecated: use Networks().ListDynamicDNS instead.
 	ListDynamicDNS(ctx context.Context, site string) ([]DynamicDNS, error)
 
 	// UpdateDynamicDNS updates a resource
+	//
+	// Deprecated: use Networks().UpdateDynamicDNS instead.
 	UpdateDynamicDNS(ctx context.Context, site string, d *DynamicDNS) (*DynamicDNS, error)
 
 	// ==== end of client methods for DynamicDNS resource ====
@@ -222,18 +372,28 @@ type InternalClient interface {
 	// ==== client methods for FirewallGroup resource ====
 
 	// CreateFirewallGroup creates a resource
+	//
+	// Deprecated: use Firewall().CreateGroup instead.
 	CreateFirewallGroup(ctx context.Context, site string, f *FirewallGroup) (*FirewallGroup, error)
 
 	// DeleteFirewallGroup deletes a resource
+	//
+	// Deprecated: use Firewall().DeleteGroup instead.
 	DeleteFirewallGroup(ctx context.Context, site string, id string) error
 
 	// GetFirewallGroup retrieves a resource
+	//
+	// Deprecated: use Firewall().GetGroup instead.
 	GetFirewallGroup(ctx context.Context, site string, id string) (*FirewallGroup, error)
 
 	// ListFirewallGroup lists the resources
+	//
+	// Deprecated: use Firewall().ListGroup instead.
 	ListFirewallGroup(ctx context.Context, site string) ([]FirewallGroup, error)
 
 	// UpdateFirewallGroup updates a resource
+	//
+	// Deprecated: use Firewall().UpdateGroup instead.
 	UpdateFirewallGroup(ctx context.Context, site string, f *FirewallGroup) (*FirewallGroup, error)
 
 	// ==== end of client methods for FirewallGroup resource ====
@@ -241,20 +401,31 @@ type InternalClient interface {
 	// ==== client methods for FirewallRule resource ====
 
 	// CreateFirewallRule creates a resource
+	//
+	// Deprecated: use Firewall().CreateRule instead.
 	CreateFirewallRule(ctx context.Context, site string, f *FirewallRule) (*FirewallRule, error)
 
 	// DeleteFirewallRule deletes a resource
+	//
+	// Deprecated: use Firewall().DeleteRule instead.
 	DeleteFirewallRule(ctx context.Context, site string, id string) error
 
 	// GetFirewallRule retrieves a resource
+	//
+	// Deprecated: use Firewall().GetRule instead.
 	GetFirewallRule(ctx context.Context, site string, id string) (*FirewallRule, error)
 
 	// ListFirewallRule lists the resources
+	//
+	// Deprecated: use Firewall().ListRule instead.
 	ListFirewallRule(ctx context.Context, site string) ([]FirewallRule, error)
 
+	// Deprecated: use Firewall().ReorderRules instead.
 	ReorderFirewallRules(ctx context.Context, site string, ruleset string, reorder []FirewallRuleIndexUpdate) error
 
 	// UpdateFirewallRule updates a resource
+	//
+	// Deprecated: use Firewall().UpdateRule instead.
 	UpdateFirewallRule(ctx context.Context, site string, f *FirewallRule) (*FirewallRule, error)
 
 	// ==== end of client methods for FirewallRule resource ====
@@ -262,39 +433,61 @@ type InternalClient interface {
 	// ==== client methods for FirewallZone resource ====
 
 	// CreateFirewallZone creates a resource
+	//
+	// Deprecated: use Firewall().CreateZone instead.
 	CreateFirewallZone(ctx context.Context, site string, f *FirewallZone) (*FirewallZone, error)
 
 	// DeleteFirewallZone deletes a resource
+	//
+	// Deprecated: use Firewall().DeleteZone instead.
 	DeleteFirewallZone(ctx context.Context, site string, id string) error
 
 	// GetFirewallZone retrieves a resource
+	//
+	// Deprecated: use Firewall().GetZone instead.
 	GetFirewallZone(ctx context.Context, site string, id string) (*FirewallZone, error)
 
 	// ListFirewallZone lists the resources
+	//
+	// Deprecated: use Firewall().ListZone instead.
 	ListFirewallZone(ctx context.Context, site string) ([]FirewallZone, error)
 
 	// UpdateFirewallZone updates a resource
+	//
+	// Deprecated: use Firewall().UpdateZone instead.
 	UpdateFirewallZone(ctx context.Context, site string, f *FirewallZone) (*FirewallZone, error)
 
+	// Deprecated: use Firewall().ListZoneMatrix instead.
 	ListFirewallZoneMatrix(ctx context.Context, site string) ([]FirewallZoneMatrix, error)
 
 	// ==== client methods for FirewallZonePolicy resource ====
 
 	// CreateFirewallZonePolicy creates a resource
+	//
+	// Deprecated: use Firewall().CreateZonePolicy instead.
 	CreateFirewallZonePolicy(ctx context.Context, site string, f *FirewallZonePolicy) (*FirewallZonePolicy, error)
 
 	// DeleteFirewallZonePolicy deletes a resource
+	//
+	// Deprecated: use Firewall().DeleteZonePolicy instead.
 	DeleteFirewallZonePolicy(ctx context.Context, site string, id string) error
 
 	// GetFirewallZonePolicy retrieves a resource
+	//
+	// Deprecated: use Firewall().GetZonePolicy instead.
 	GetFirewallZonePolicy(ctx context.Context, site string, id string) (*FirewallZonePolicy, error)
 
 	// ListFirewallZonePolicy lists the resources
+	//
+	// Deprecated: use Firewall().ListZonePolicy instead.
 	ListFirewallZonePolicy(ctx context.Context, site string) ([]FirewallZonePolicy, error)
 
+	// Deprecated: use Firewall().ReorderZonePolicies instead.
 	ReorderFirewallPolicies(ctx context.Context, site string, d *FirewallPolicyOrderUpdate) ([]FirewallZonePolicy, error)
 
 	// UpdateFirewallZonePolicy updates a resource
+	//
+	// Deprecated: use Firewall().UpdateZonePolicy instead.
 	UpdateFirewallZonePolicy(ctx context.Context, site string, f *FirewallZonePolicy) (*FirewallZonePolicy, error)
 
 	// ==== end of client methods for FirewallZonePolicy resource ====
@@ -304,35 +497,55 @@ type InternalClient interface {
 	// ==== client methods for HeatMap resource ====
 
 	// CreateHeatMap creates a resource
+	//
+	// Deprecated: use Maps().CreateHeatMap instead.
 	CreateHeatMap(ctx context.Context, site string, h *HeatMap) (*HeatMap, error)
 
 	// DeleteHeatMap deletes a resource
+	//
+	// Deprecated: use Maps().DeleteHeatMap instead.
 	DeleteHeatMap(ctx context.Context, site string, id string) error
 
 	// GetHeatMap retrieves a resource
+	//
+	// Deprecated: use Maps().GetHeatMap instead.
 	GetHeatMap(ctx context.Context, site string, id string) (*HeatMap, error)
 
 	// ListHeatMap lists the resources
+	//
+	// Deprecated: use Maps().ListHeatMap instead.
 	ListHeatMap(ctx context.Context, site string) ([]HeatMap, error)
 
 	// UpdateHeatMap updates a resource
+	//
+	// Deprecated: use Maps().UpdateHeatMap instead.
 	UpdateHeatMap(ctx context.Context, site string, h *HeatMap) (*HeatMap, error)
 
 	// ==== client methods for HeatMapPoint resource ====
 
 	// CreateHeatMapPoint creates a resource
+	//
+	// Deprecated: use Maps().CreateHeatMapPoint instead.
 	CreateHeatMapPoint(ctx context.Context, site string, h *HeatMapPoint) (*HeatMapPoint, error)
 
 	// DeleteHeatMapPoint deletes a resource
+	//
+	// Deprecated: use Maps().DeleteHeatMapPoint instead.
 	DeleteHeatMapPoint(ctx context.Context, site string, id string) error
 
 	// GetHeatMapPoint retrieves a resource
+	//
+	// Deprecated: use Maps().GetHeatMapPoint instead.
 	GetHeatMapPoint(ctx context.Context, site string, id string) (*HeatMapPoint, error)
 
 	// ListHeatMapPoint lists the resources
+	//
+	// Deprecated: use Maps().ListHeatMapPoint instead.
 	ListHeatMapPoint(ctx context.Context, site string) ([]HeatMapPoint, error)
 
 	// UpdateHeatMapPoint updates a resource
+	//
+	// Deprecated: use Maps().UpdateHeatMapPoint instead.
 	UpdateHeatMapPoint(ctx context.Context, site string, h *HeatMapPoint) (*HeatMapPoint, error)
 
 	// ==== end of client methods for HeatMapPoint resource ====
@@ -342,18 +555,28 @@ type InternalClient interface {
 	// ==== client methods for Hotspot2Conf resource ====
 
 	// CreateHotspot2Conf creates a resource
+	//
+	// Deprecated: use Hotspot().CreateHotspot2Conf instead.
 	CreateHotspot2Conf(ctx context.Context, site string, h *Hotspot2Conf) (*Hotspot2Conf, error)
 
 	// DeleteHotspot2Conf deletes a resource
+	//
+	// Deprecated: use Hotspot().DeleteHotspot2Conf instead.
 	DeleteHotspot2Conf(ctx context.Context, site string, id string) error
 
 	// GetHotspot2Conf retrieves a resource
+	//
+	// Deprecated: use Hotspot().GetHotspot2Conf instead.
 	GetHotspot2Conf(ctx context.Context, site string, id string) (*Hotspot2Conf, error)
 
 	// ListHotspot2Conf lists the resources
+	//
+	// Deprecated: use Hotspot().ListHotspot2Conf instead.
 	ListHotspot2Conf(ctx context.Context, site string) ([]Hotspot2Conf, error)
 
 	// UpdateHotspot2Conf updates a resource
+	//
+	// Deprecated: use Hotspot().UpdateHotspot2Conf instead.
 	UpdateHotspot2Conf(ctx context.Context, site string, h *Hotspot2Conf) (*Hotspot2Conf, error)
 
 	// ==== end of client methods for Hotspot2Conf resource ====
@@ -361,18 +584,28 @@ type InternalClient interface {
 	// ==== client methods for HotspotOp resource ====
 
 	// CreateHotspotOp creates a resource
+	//
+	// Deprecated: use Hotspot().CreateOperator instead.
 	CreateHotspotOp(ctx context.Context, site string, h *HotspotOp) (*HotspotOp, error)
 
 	// DeleteHotspotOp deletes a resource
+	//
+	// Deprecated: use Hotspot().DeleteOperator instead.
 	DeleteHotspotOp(ctx context.Context, site string, id string) error
 
 	// GetHotspotOp retrieves a resource
+	//
+	// Deprecated: use Hotspot().GetOperator instead.
 	GetHotspotOp(ctx context.Context, site string, id string) (*HotspotOp, error)
 
 	// ListHotspotOp lists the resources
+	//
+	// Deprecated: use Hotspot().ListOperator instead.
 	ListHotspotOp(ctx context.Context, site string) ([]HotspotOp, error)
 
 	// UpdateHotspotOp updates a resource
+	//
+	// Deprecated: use Hotspot().UpdateOperator instead.
 	UpdateHotspotOp(ctx context.Context, site string, h *HotspotOp) (*HotspotOp, error)
 
 	// ==== end of client methods for HotspotOp resource ====
@@ -380,18 +613,28 @@ type InternalClient interface {
 	// ==== client methods for HotspotPackage resource ====
 
 	// CreateHotspotPackage creates a resource
+	//
+	// Deprecated: use Hotspot().CreatePackage instead.
 	CreateHotspotPackage(ctx context.Context, site string, h *HotspotPackage) (*HotspotPackage, error)
 
 	// DeleteHotspotPackage deletes a resource
+	//
+	// Deprecated: use Hotspot().DeletePackage instead.
 	DeleteHotspotPackage(ctx context.Context, site string, id string) error
 
 	// GetHotspotPackage retrieves a resource
+	//
+	// Deprecated: use Hotspot().GetPackage instead.
 	GetHotspotPackage(ctx context.Context, site string, id string) (*HotspotPackage, error)
 
 	// ListHotspotPackage lists the resources
+	//
+	// Deprecated: use Hotspot().ListPackage instead.
 	ListHotspotPackage(ctx context.Context, site string) ([]HotspotPackage, error)
 
 	// UpdateHotspotPackage updates a resource
+	//
+	// Deprecated: use Hotspot().UpdatePackage instead.
 	UpdateHotspotPackage(ctx context.Context, site string, h *HotspotPackage) (*HotspotPackage, error)
 
 	// ==== end of client methods for HotspotPackage resource ====
@@ -399,18 +642,28 @@ type InternalClient interface {
 	// ==== client methods for Map resource ====
 
 	// CreateMap creates a resource
+	//
+	// Deprecated: use Maps().Create instead.
 	CreateMap(ctx context.Context, site string, m *Map) (*Map, error)
 
 	// DeleteMap deletes a resource
+	//
+	// Deprecated: use Maps().Delete instead.
 	DeleteMap(ctx context.Context, site string, id string) error
 
 	// GetMap retrieves a resource
+	//
+	// Deprecated: use Maps().Get instead.
 	GetMap(ctx context.Context, site string, id string) (*Map, error)
 
 	// ListMap lists the resources
+	//
+	// Deprecated: use Maps().List instead.
 	ListMap(ctx context.Context, site string) ([]Map, error)
 
 	// UpdateMap updates a resource
+	//
+	// Deprecated: use Maps().Update instead.
 	UpdateMap(ctx context.Context, site string, m *Map) (*Map, error)
 
 	// ==== end of client methods for Map resource ====
@@ -418,18 +671,28 @@ type InternalClient interface {
 	// ==== client methods for MediaFile resource ====
 
 	// CreateMediaFile creates a resource
+	//
+	// Deprecated: use System().CreateMediaFile instead.
 	CreateMediaFile(ctx context.Context, site string, m *MediaFile) (*MediaFile, error)
 
 	// DeleteMediaFile deletes a resource
+	//
+	// Deprecated: use System().DeleteMediaFile instead.
 	DeleteMediaFile(ctx context.Context, site string, id string) error
 
 	// GetMediaFile retrieves a resource
+	//
+	// Deprecated: use System().GetMediaFile instead.
 	GetMediaFile(ctx context.Context, site string, id string) (*MediaFile, error)
 
 	// ListMediaFile lists the resources
+	//
+	// Deprecated: use System().ListMediaFile instead.
 	ListMediaFile(ctx context.Context, site string) ([]MediaFile, error)
 
 	// UpdateMediaFile updates a resource
+	//
+	// Deprecated: use System().UpdateMediaFile instead.
 	UpdateMediaFile(ctx context.Context, site string, m *MediaFile) (*MediaFile, error)
 
 	// ==== end of client methods for MediaFile resource ====
@@ -437,18 +700,28 @@ type InternalClient interface {
 	// ==== client methods for Network resource ====
 
 	// CreateNetwork creates a resource
+	//
+	// Deprecated: use Networks().Create instead.
 	CreateNetwork(ctx context.Context, site string, n *Network) (*Network, error)
 
 	// DeleteNetwork deletes a resource
+	//
+	// Deprecated: use Networks().Delete instead.
 	DeleteNetwork(ctx context.Context, site string, id string) error
 
 	// GetNetwork retrieves a resource
+	//
+	// Deprecated: use Networks().Get instead.
 	GetNetwork(ctx context.Context, site string, id string) (*Network, error)
 
 	// ListNetwork lists the resources
+	//
+	// Deprecated: use Networks().List instead.
 	ListNetwork(ctx context.Context, site string) ([]Network, error)
 
 	// UpdateNetwork updates a resource
+	//
+	// Deprecated: use Networks().Update instead.
 	UpdateNetwork(ctx context.Context, site string, n *Network) (*Network, error)
 
 	// ==== end of client methods for Network resource ====
@@ -456,18 +729,28 @@ type InternalClient interface {
 	// ==== client methods for PortForward resource ====
 
 	// CreatePortForward creates a resource
+	//
+	// Deprecated: use Firewall().CreatePortForward instead.
 	CreatePortForward(ctx context.Context, site string, p *PortForward) (*PortForward, error)
 
 	// DeletePortForward deletes a resource
+	//
+	// Deprecated: use Firewall().DeletePortForward instead.
 	DeletePortForward(ctx context.Context, site string, id string) error
 
 	// GetPortForward retrieves a resource
+	//
+	// Deprecated: use Firewall().GetPortForward instead.
 	GetPortForward(ctx context.Context, site string, id string) (*PortForward, error)
 
 	// ListPortForward lists the resources
+	//
+	// Deprecated: use Firewall().ListPortForward instead.
 	ListPortForward(ctx context.Context, site string) ([]PortForward, error)
 
 	// UpdatePortForward updates a resource
+	//
+	// Deprecated: use Firewall().UpdatePortForward instead.
 	UpdatePortForward(ctx context.Context, site string, p *PortForward) (*PortForward, error)
 
 	// ==== end of client methods for PortForward resource ====
@@ -475,52 +758,82 @@ type InternalClient interface {
 	// ==== client methods for PortProfile resource ====
 
 	// CreatePortProfile creates a resource
+	//
+	// Deprecated: use Networks().CreatePortProfile instead.
 	CreatePortProfile(ctx context.Context, site string, p *PortProfile) (*PortProfile, error)
 
 	// DeletePortProfile deletes a resource
+	//
+	// Deprecated: use Networks().DeletePortProfile instead.
 	DeletePortProfile(ctx context.Context, site string, id string) error
 
 	// GetPortProfile retrieves a resource
+	//
+	// Deprecated: use Networks().GetPortProfile instead.
 	GetPortProfile(ctx context.Context, site string, id string) (*PortProfile, error)
 
 	// ListPortProfile lists the resources
+	//
+	// Deprecated: use Networks().ListPortProfile instead.
 	ListPortProfile(ctx context.Context, site string) ([]PortProfile, error)
 
 	// UpdatePortProfile updates a resource
+	//
+	// Deprecated: use Networks().UpdatePortProfile instead.
 	UpdatePortProfile(ctx context.Context, site string, p *PortProfile) (*PortProfile, error)
 
 	// ==== end of client methods for PortProfile resource ====
 
 	// DeletePortalFile deletes a Hotspot Portal file from the controller.
+	//
+	// Deprecated: use Hotspot().DeletePortalFile instead.
 	DeletePortalFile(ctx context.Context, site string, id string) error
 
 	// GetPortalFile returns a specific Hotspot Portal file by it's ID.
+	//
+	// Deprecated: use Hotspot().GetPortalFile instead.
 	GetPortalFile(ctx context.Context, site string, id string) (*PortalFile, error)
 
 	// ListPortalFiles lists all Hotspot Portal files on the controller.
+	//
+	// Deprecated: use Hotspot().ListPortalFiles instead.
 	ListPortalFiles(ctx context.Context, site string) ([]PortalFile, error)
 
 	// UploadPortalFile uploads a Hotspot Portal file to the controller.
+	//
+	// Deprecated: use Hotspot().UploadPortalFile instead.
 	UploadPortalFile(ctx context.Context, site string, filepath string) (*PortalFile, error)
 
 	// UploadPortalFileFromReader uploads a Hotspot Portal file using io.Reader to the controller.
+	//
+	// Deprecated: use Hotspot().UploadPortalFileFromReader instead.
 	UploadPortalFileFromReader(ctx context.Context, site string, reader io.Reader, filename string) (*PortalFile, error)
 
 	// ==== client methods for RADIUSProfile resource ====
 
 	// CreateRADIUSProfile creates a resource
+	//
+	// Deprecated: use RADIUS().CreateProfile instead.
 	CreateRADIUSProfile(ctx context.Context, site string, r *RADIUSProfile) (*RADIUSProfile, error)
 
 	// DeleteRADIUSProfile deletes a resource
+	//
+	// Deprecated: use RADIUS().DeleteProfile instead.
 	DeleteRADIUSProfile(ctx context.Context, site string, id string) error
 
 	// GetRADIUSProfile retrieves a resource
+	//
+	// Deprecated: use RADIUS().GetProfile instead.
 	GetRADIUSProfile(ctx context.Context, site string, id string) (*RADIUSProfile, error)
 
 	// ListRADIUSProfile lists the resources
+	//
+	// Deprecated: use RADIUS().ListProfile instead.
 	ListRADIUSProfile(ctx context.Context, site string) ([]RADIUSProfile, error)
 
 	// UpdateRADIUSProfile updates a resource
+	//
+	// Deprecated: use RADIUS().UpdateProfile instead.
 	UpdateRADIUSProfile(ctx context.Context, site string, r *RADIUSProfile) (*RADIUSProfile, error)
 
 	// ==== end of client methods for RADIUSProfile resource ====
@@ -528,18 +841,28 @@ type InternalClient interface {
 	// ==== client methods for Routing resource ====
 
 	// CreateRouting creates a resource
+	//
+	// Deprecated: use Networks().CreateRoute instead.
 	CreateRouting(ctx context.Context, site string, r *Routing) (*Routing, error)
 
 	// DeleteRouting deletes a resource
+	//
+	// Deprecated: use Networks().DeleteRoute instead.
 	DeleteRouting(ctx context.Context, site string, id string) error
 
 	// GetRouting retrieves a resource
+	//
+	// Deprecated: use Networks().GetRoute instead.
 	GetRouting(ctx context.Context, site string, id string) (*Routing, error)
 
 	// ListRouting lists the resources
+	//
+	// Deprecated: use Networks().ListRoute instead.
 	ListRouting(ctx context.Context, site string) ([]Routing, error)
 
 	// UpdateRouting updates a resource
+	//
+	// Deprecated: use Networks().UpdateRoute instead.
 	UpdateRouting(ctx context.Context, site string, r *Routing) (*Routing, error)
 
 	// ==== end of client methods for Routing resource ====
@@ -547,32 +870,48 @@ type InternalClient interface {
 	// ==== client methods for ScheduleTask resource ====
 
 	// CreateScheduleTask creates a resource
+	//
+	// Deprecated: use System().CreateScheduleTask instead.
 	CreateScheduleTask(ctx context.Context, site string, s *ScheduleTask) (*ScheduleTask, error)
 
 	// DeleteScheduleTask deletes a resource
+	//
+	// Deprecated: use System().DeleteScheduleTask instead.
 	DeleteScheduleTask(ctx context.Context, site string, id string) error
 
 	// GetScheduleTask retrieves a resource
+	//
+	// Deprecated: use System().GetScheduleTask instead.
 	GetScheduleTask(ctx context.Context, site string, id string) (*ScheduleTask, error)
 
 	// ListScheduleTask lists the resources
+	//
+	// Deprecated: use System().ListScheduleTask instead.
 	ListScheduleTask(ctx context.Context, site string) ([]ScheduleTask, error)
 
 	// UpdateScheduleTask updates a resource
+	//
+	// Deprecated: use System().UpdateScheduleTask instead.
 	UpdateScheduleTask(ctx context.Context, site string, s *ScheduleTask) (*ScheduleTask, error)
 
 	// ==== end of client methods for ScheduleTask resource ====
 
+	// Deprecated: use Settings().Get instead.
 	GetSetting(ctx context.Context, site string, key string) (*Setting, any, error)
 
+	// Deprecated: use Settings().Set instead.
 	SetSetting(ctx context.Context, site string, key string, reqBody any) (any, error)
 
 	// ==== client methods for SettingAutoSpeedtest resource ====
 
 	// GetSettingAutoSpeedtest retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetAutoSpeedtest instead.
 	GetSettingAutoSpeedtest(ctx context.Context, site string) (*SettingAutoSpeedtest, error)
 
 	// UpdateSettingAutoSpeedtest updates a resource
+	//
+	// Deprecated: use Settings().UpdateAutoSpeedtest instead.
 	UpdateSettingAutoSpeedtest(ctx context.Context, site string, s *SettingAutoSpeedtest) (*SettingAutoSpeedtest, error)
 
 	// ==== end of client methods for SettingAutoSpeedtest resource ====
@@ -580,9 +919,13 @@ type InternalClient interface {
 	// ==== client methods for SettingBaresip resource ====
 
 	// GetSettingBaresip retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetBaresip instead.
 	GetSettingBaresip(ctx context.Context, site string) (*SettingBaresip, error)
 
 	// UpdateSettingBaresip updates a resource
+	//
+	// Deprecated: use Settings().UpdateBaresip instead.
 	UpdateSettingBaresip(ctx context.Context, site string, s *SettingBaresip) (*SettingBaresip, error)
 
 	// ==== end of client methods for SettingBaresip resource ====
@@ -590,9 +933,13 @@ type InternalClient interface {
 	// ==== client methods for SettingBroadcast resource ====
 
 	// GetSettingBroadcast retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetBroadcast instead.
 	GetSettingBroadcast(ctx context.Context, site string) (*SettingBroadcast, error)
 
 	// UpdateSettingBroadcast updates a resource
+	//
+	// Deprecated: use Settings().UpdateBroadcast instead.
 	UpdateSettingBroadcast(ctx context.Context, site string, s *SettingBroadcast) (*SettingBroadcast, error)
 
 	// ==== end of client methods for SettingBroadcast resource ====
@@ -600,9 +947,13 @@ type InternalClient interface {
 	// ==== client methods for SettingConnectivity resource ====
 
 	// GetSettingConnectivity retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetConnectivity instead.
 	GetSettingConnectivity(ctx context.Context, site string) (*SettingConnectivity, error)
 
 	// UpdateSettingConnectivity updates a resource
+	//
+	// Deprecated: use Settings().UpdateConnectivity instead.
 	UpdateSettingConnectivity(ctx context.Context, site string, s *SettingConnectivity) (*SettingConnectivity, error)
 
 	// ==== end of client methods for SettingConnectivity resource ====
@@ -610,9 +961,13 @@ type InternalClient interface {
 	// ==== client methods for SettingCountry resource ====
 
 	// GetSettingCountry retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetCountry instead.
 	GetSettingCountry(ctx context.Context, site string) (*SettingCountry, error)
 
 	// UpdateSettingCountry updates a resource
+	//
+	// Deprecated: use Settings().UpdateCountry instead.
 	UpdateSettingCountry(ctx context.Context, site string, s *SettingCountry) (*SettingCountry, error)
 
 	// ==== end of client methods for SettingCountry resource ====
@@ -620,9 +975,13 @@ type InternalClient interface {
 	// ==== client methods for SettingDashboard resource ====
 
 	// GetSettingDashboard retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetDashboard instead.
 	GetSettingDashboard(ctx context.Context, site string) (*SettingDashboard, error)
 
 	// UpdateSettingDashboard updates a resource
+	//
+	// Deprecated: use Settings().UpdateDashboard instead.
 	UpdateSettingDashboard(ctx context.Context, site string, s *SettingDashboard) (*SettingDashboard, error)
 
 	// ==== end of client methods for SettingDashboard resource ====
@@ -630,9 +989,13 @@ type InternalClient interface {
 	// ==== client methods for SettingDoh resource ====
 
 	// GetSettingDoh retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetDoh instead.
 	GetSettingDoh(ctx context.Context, site string) (*SettingDoh, error)
 
 	// UpdateSettingDoh updates a resource
+	//
+	// Deprecated: use Settings().UpdateDoh instead.
 	UpdateSettingDoh(ctx context.Context, site string, s *SettingDoh) (*SettingDoh, error)
 
 	// ==== end of client methods for SettingDoh resource ====
@@ -640,9 +1003,13 @@ type InternalClient interface {
 	// ==== client methods for SettingDpi resource ====
 
 	// GetSettingDpi retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetDpi instead.
 	GetSettingDpi(ctx context.Context, site string) (*SettingDpi, error)
 
 	// UpdateSettingDpi updates a resource
+	//
+	// Deprecated: use Settings().UpdateDpi instead.
 	UpdateSettingDpi(ctx context.Context, site string, s *SettingDpi) (*SettingDpi, error)
 
 	// ==== end of client methods for SettingDpi resource ====
@@ -650,9 +1017,13 @@ type InternalClient interface {
 	// ==== client methods for SettingElementAdopt resource ====
 
 	// GetSettingElementAdopt retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetElementAdopt instead.
 	GetSettingElementAdopt(ctx context.Context, site string) (*SettingElementAdopt, error)
 
 	// UpdateSettingElementAdopt updates a resource
+	//
+	// Deprecated: use Settings().UpdateElementAdopt instead.
 	UpdateSettingElementAdopt(ctx context.Context, site string, s *SettingElementAdopt) (*SettingElementAdopt, error)
 
 	// ==== end of client methods for SettingElementAdopt resource ====
@@ -660,9 +1031,13 @@ type InternalClient interface {
 	// ==== client methods for SettingEtherLighting resource ====
 
 	// GetSettingEtherLighting retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetEtherLighting instead.
 	GetSettingEtherLighting(ctx context.Context, site string) (*SettingEtherLighting, error)
 
 	// UpdateSettingEtherLighting updates a resource
+	//
+	// Deprecated: use Settings().UpdateEtherLighting instead.
 	UpdateSettingEtherLighting(ctx context.Context, site string, s *SettingEtherLighting) (*SettingEtherLighting, error)
 
 	// ==== end of client methods for SettingEtherLighting resource ====
@@ -670,9 +1045,13 @@ type InternalClient interface {
 	// ==== client methods for SettingEvaluationScore resource ====
 
 	// GetSettingEvaluationScore retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetEvaluationScore instead.
 	GetSettingEvaluationScore(ctx context.Context, site string) (*SettingEvaluationScore, error)
 
 	// UpdateSettingEvaluationScore updates a resource
+	//
+	// Deprecated: use Settings().UpdateEvaluationScore instead.
 	UpdateSettingEvaluationScore(ctx context.Context, site string, s *SettingEvaluationScore) (*SettingEvaluationScore, error)
 
 	// ==== end of client methods for SettingEvaluationScore resource ====
@@ -680,9 +1059,13 @@ type InternalClient interface {
 	// ==== client methods for SettingGlobalAp resource ====
 
 	// GetSettingGlobalAp retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetGlobalAp instead.
 	GetSettingGlobalAp(ctx context.Context, site string) (*SettingGlobalAp, error)
 
 	// UpdateSettingGlobalAp updates a resource
+	//
+	// Deprecated: use Settings().UpdateGlobalAp instead.
 	UpdateSettingGlobalAp(ctx context.Context, site string, s *SettingGlobalAp) (*SettingGlobalAp, error)
 
 	// ==== end of client methods for SettingGlobalAp resource ====
@@ -690,9 +1073,13 @@ type InternalClient interface {
 	// ==== client methods for SettingGlobalNat resource ====
 
 	// GetSettingGlobalNat retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetGlobalNat instead.
 	GetSettingGlobalNat(ctx context.Context, site string) (*SettingGlobalNat, error)
 
 	// UpdateSettingGlobalNat updates a resource
+	//
+	// Deprecated: use Settings().UpdateGlobalNat instead.
 	UpdateSettingGlobalNat(ctx context.Context, site string, s *SettingGlobalNat) (*SettingGlobalNat, error)
 
 	// ==== end of client methods for SettingGlobalNat resource ====
@@ -700,9 +1087,13 @@ type InternalClient interface {
 	// ==== client methods for SettingGlobalSwitch resource ====
 
 	// GetSettingGlobalSwitch retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetGlobalSwitch instead.
 	GetSettingGlobalSwitch(ctx context.Context, site string) (*SettingGlobalSwitch, error)
 
 	// UpdateSettingGlobalSwitch updates a resource
+	//
+	// Deprecated: use Settings().UpdateGlobalSwitch instead.
 	UpdateSettingGlobalSwitch(ctx context.Context, site string, s *SettingGlobalSwitch) (*SettingGlobalSwitch, error)
 
 	// ==== end of client methods for SettingGlobalSwitch resource ====
@@ -710,9 +1101,13 @@ type InternalClient interface {
 	// ==== client methods for SettingGuestAccess resource ====
 
 	// GetSettingGuestAccess retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetGuestAccess instead.
 	GetSettingGuestAccess(ctx context.Context, site string) (*SettingGuestAccess, error)
 
 	// UpdateSettingGuestAccess updates a resource
+	//
+	// Deprecated: use Settings().UpdateGuestAccess instead.
 	UpdateSettingGuestAccess(ctx context.Context, site string, s *SettingGuestAccess) (*SettingGuestAccess, error)
 
 	// ==== end of client methods for SettingGuestAccess resource ====
@@ -720,9 +1115,13 @@ type InternalClient interface {
 	// ==== client methods for SettingIps resource ====
 
 	// GetSettingIps retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetIps instead.
 	GetSettingIps(ctx context.Context, site string) (*SettingIps, error)
 
 	// UpdateSettingIps updates a resource
+	//
+	// Deprecated: use Settings().UpdateIps instead.
 	UpdateSettingIps(ctx context.Context, site string, s *SettingIps) (*SettingIps, error)
 
 	// ==== end of client methods for SettingIps resource ====
@@ -730,9 +1129,13 @@ type InternalClient interface {
 	// ==== client methods for SettingLcm resource ====
 
 	// GetSettingLcm retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetLcm instead.
 	GetSettingLcm(ctx context.Context, site string) (*SettingLcm, error)
 
 	// UpdateSettingLcm updates a resource
+	//
+	// Deprecated: use Settings().UpdateLcm instead.
 	UpdateSettingLcm(ctx context.Context, site string, s *SettingLcm) (*SettingLcm, error)
 
 	// ==== end of client methods for SettingLcm resource ====
@@ -740,9 +1143,13 @@ type InternalClient interface {
 	// ==== client methods for SettingLocale resource ====
 
 	// GetSettingLocale retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetLocale instead.
 	GetSettingLocale(ctx context.Context, site string) (*SettingLocale, error)
 
 	// UpdateSettingLocale updates a resource
+	//
+	// Deprecated: use Settings().UpdateLocale instead.
 	UpdateSettingLocale(ctx context.Context, site string, s *SettingLocale) (*SettingLocale, error)
 
 	// ==== end of client methods for SettingLocale resource ====
@@ -750,9 +1157,13 @@ type InternalClient interface {
 	// ==== client methods for SettingMagicSiteToSiteVpn resource ====
 
 	// GetSettingMagicSiteToSiteVpn retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetMagicSiteToSiteVpn instead.
 	GetSettingMagicSiteToSiteVpn(ctx context.Context, site string) (*SettingMagicSiteToSiteVpn, error)
 
 	// UpdateSettingMagicSiteToSiteVpn updates a resource
+	//
+	// Deprecated: use Settings().UpdateMagicSiteToSiteVpn instead.
 	UpdateSettingMagicSiteToSiteVpn(ctx context.Context, site string, s *SettingMagicSiteToSiteVpn) (*SettingMagicSiteToSiteVpn, error)
 
 	// ==== end of client methods for SettingMagicSiteToSiteVpn resource ====
@@ -760,9 +1171,13 @@ type InternalClient interface {
 	// ==== client methods for SettingMdns resource ====
 
 	// GetSettingMdns retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetMdns instead.
 	GetSettingMdns(ctx context.Context, site string) (*SettingMdns, error)
 
 	// UpdateSettingMdns updates a resource
+	//
+	// Deprecated: use Settings().UpdateMdns instead.
 	UpdateSettingMdns(ctx context.Context, site string, s *SettingMdns) (*SettingMdns, error)
 
 	// ==== end of client methods for SettingMdns resource ====
@@ -770,9 +1185,13 @@ type InternalClient interface {
 	// ==== client methods for SettingMgmt resource ====
 
 	// GetSettingMgmt retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetMgmt instead.
 	GetSettingMgmt(ctx context.Context, site string) (*SettingMgmt, error)
 
 	// UpdateSettingMgmt updates a resource
+	//
+	// Deprecated: use Settings().UpdateMgmt instead.
 	UpdateSettingMgmt(ctx context.Context, site string, s *SettingMgmt) (*SettingMgmt, error)
 
 	// ==== end of client methods for SettingMgmt resource ====
@@ -780,9 +1199,13 @@ type InternalClient interface {
 	// ==== client methods for SettingNetflow resource ====
 
 	// GetSettingNetflow retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetNetflow instead.
 	GetSettingNetflow(ctx context.Context, site string) (*SettingNetflow, error)
 
 	// UpdateSettingNetflow updates a resource
+	//
+	// Deprecated: use Settings().UpdateNetflow instead.
 	UpdateSettingNetflow(ctx context.Context, site string, s *SettingNetflow) (*SettingNetflow, error)
 
 	// ==== end of client methods for SettingNetflow resource ====
@@ -790,9 +1213,13 @@ type InternalClient interface {
 	// ==== client methods for SettingNetworkOptimization resource ====
 
 	// GetSettingNetworkOptimization retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetNetworkOptimization instead.
 	GetSettingNetworkOptimization(ctx context.Context, site string) (*SettingNetworkOptimization, error)
 
 	// UpdateSettingNetworkOptimization updates a resource
+	//
+	// Deprecated: use Settings().UpdateNetworkOptimization instead.
 	UpdateSettingNetworkOptimization(ctx context.Context, site string, s *SettingNetworkOptimization) (*SettingNetworkOptimization, error)
 
 	// ==== end of client methods for SettingNetworkOptimization resource ====
@@ -800,9 +1227,13 @@ type InternalClient interface {
 	// ==== client methods for SettingNtp resource ====
 
 	// GetSettingNtp retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetNtp instead.
 	GetSettingNtp(ctx context.Context, site string) (*SettingNtp, error)
 
 	// UpdateSettingNtp updates a resource
+	//
+	// Deprecated: use Settings().UpdateNtp instead.
 	UpdateSettingNtp(ctx context.Context, site string, s *SettingNtp) (*SettingNtp, error)
 
 	// ==== end of client methods for SettingNtp resource ====
@@ -810,9 +1241,13 @@ type InternalClient interface {
 	// ==== client methods for SettingPorta resource ====
 
 	// GetSettingPorta retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetPorta instead.
 	GetSettingPorta(ctx context.Context, site string) (*SettingPorta, error)
 
 	// UpdateSettingPorta updates a resource
+	//
+	// Deprecated: use Settings().UpdatePorta instead.
 	UpdateSettingPorta(ctx context.Context, site string, s *SettingPorta) (*SettingPorta, error)
 
 	// ==== end of client methods for SettingPorta resource ====
@@ -820,9 +1255,13 @@ type InternalClient interface {
 	// ==== client methods for SettingRadioAi resource ====
 
 	// GetSettingRadioAi retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetRadioAi instead.
 	GetSettingRadioAi(ctx context.Context, site string) (*SettingRadioAi, error)
 
 	// UpdateSettingRadioAi updates a resource
+	//
+	// Deprecated: use Settings().UpdateRadioAi instead.
 	UpdateSettingRadioAi(ctx context.Context, site string, s *SettingRadioAi) (*SettingRadioAi, error)
 
 	// ==== end of client methods for SettingRadioAi resource ====
@@ -830,9 +1269,13 @@ type InternalClient interface {
 	// ==== client methods for SettingRadius resource ====
 
 	// GetSettingRadius retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetRadius instead.
 	GetSettingRadius(ctx context.Context, site string) (*SettingRadius, error)
 
 	// UpdateSettingRadius updates a resource
+	//
+	// Deprecated: use Settings().UpdateRadius instead.
 	UpdateSettingRadius(ctx context.Context, site string, s *SettingRadius) (*SettingRadius, error)
 
 	// ==== end of client methods for SettingRadius resource ====
@@ -840,9 +1283,13 @@ type InternalClient interface {
 	// ==== client methods for SettingRoamingAssistant resource ====
 
 	// GetSettingRoamingAssistant retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetRoamingAssistant instead.
 	GetSettingRoamingAssistant(ctx context.Context, site string) (*SettingRoamingAssistant, error)
 
 	// UpdateSettingRoamingAssistant updates a resource
+	//
+	// Deprecated: use Settings().UpdateRoamingAssistant instead.
 	UpdateSettingRoamingAssistant(ctx context.Context, site string, s *SettingRoamingAssistant) (*SettingRoamingAssistant, error)
 
 	// ==== end of client methods for SettingRoamingAssistant resource ====
@@ -850,9 +1297,13 @@ type InternalClient interface {
 	// ==== client methods for SettingRsyslogd resource ====
 
 	// GetSettingRsyslogd retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetRsyslogd instead.
 	GetSettingRsyslogd(ctx context.Context, site string) (*SettingRsyslogd, error)
 
 	// UpdateSettingRsyslogd updates a resource
+	//
+	// Deprecated: use Settings().UpdateRsyslogd instead.
 	UpdateSettingRsyslogd(ctx context.Context, site string, s *SettingRsyslogd) (*SettingRsyslogd, error)
 
 	// ==== end of client methods for SettingRsyslogd resource ====
@@ -860,9 +1311,13 @@ type InternalClient interface {
 	// ==== client methods for SettingSnmp resource ====
 
 	// GetSettingSnmp retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetSnmp instead.
 	GetSettingSnmp(ctx context.Context, site string) (*SettingSnmp, error)
 
 	// UpdateSettingSnmp updates a resource
+	//
+	// Deprecated: use Settings().UpdateSnmp instead.
 	UpdateSettingSnmp(ctx context.Context, site string, s *SettingSnmp) (*SettingSnmp, error)
 
 	// ==== end of client methods for SettingSnmp resource ====
@@ -870,9 +1325,13 @@ type InternalClient interface {
 	// ==== client methods for SettingSslInspection resource ====
 
 	// GetSettingSslInspection retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetSslInspection instead.
 	GetSettingSslInspection(ctx context.Context, site string) (*SettingSslInspection, error)
 
 	// UpdateSettingSslInspection updates a resource
+	//
+	// Deprecated: use Settings().UpdateSslInspection instead.
 	UpdateSettingSslInspection(ctx context.Context, site string, s *SettingSslInspection) (*SettingSslInspection, error)
 
 	// ==== end of client methods for SettingSslInspection resource ====
@@ -880,9 +1339,13 @@ type InternalClient interface {
 	// ==== client methods for SettingSuperCloudaccess resource ====
 
 	// GetSettingSuperCloudaccess retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetSuperCloudaccess instead.
 	GetSettingSuperCloudaccess(ctx context.Context, site string) (*SettingSuperCloudaccess, error)
 
 	// UpdateSettingSuperCloudaccess updates a resource
+	//
+	// Deprecated: use Settings().UpdateSuperCloudaccess instead.
 	UpdateSettingSuperCloudaccess(ctx context.Context, site string, s *SettingSuperCloudaccess) (*SettingSuperCloudaccess, error)
 
 	// ==== end of client methods for SettingSuperCloudaccess resource ====
@@ -890,9 +1353,13 @@ type InternalClient interface {
 	// ==== client methods for SettingSuperEvents resource ====
 
 	// GetSettingSuperEvents retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetSuperEvents instead.
 	GetSettingSuperEvents(ctx context.Context, site string) (*SettingSuperEvents, error)
 
 	// UpdateSettingSuperEvents updates a resource
+	//
+	// Deprecated: use Settings().UpdateSuperEvents instead.
 	UpdateSettingSuperEvents(ctx context.Context, site string, s *SettingSuperEvents) (*SettingSuperEvents, error)
 
 	// ==== end of client methods for SettingSuperEvents resource ====
@@ -900,9 +1367,13 @@ type InternalClient interface {
 	// ==== client methods for SettingSuperFwupdate resource ====
 
 	// GetSettingSuperFwupdate retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetSuperFwupdate instead.
 	GetSettingSuperFwupdate(ctx context.Context, site string) (*SettingSuperFwupdate, error)
 
 	// UpdateSettingSuperFwupdate updates a resource
+	//
+	// Deprecated: use Settings().UpdateSuperFwupdate instead.
 	UpdateSettingSuperFwupdate(ctx context.Context, site string, s *SettingSuperFwupdate) (*SettingSuperFwupdate, error)
 
 	// ==== end of client methods for SettingSuperFwupdate resource ====
@@ -910,9 +1381,13 @@ type InternalClient interface {
 	// ==== client methods for SettingSuperIdentity resource ====
 
 	// GetSettingSuperIdentity retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetSuperIdentity instead.
 	GetSettingSuperIdentity(ctx context.Context, site string) (*SettingSuperIdentity, error)
 
 	// UpdateSettingSuperIdentity updates a resource
+	//
+	// Deprecated: use Settings().UpdateSuperIdentity instead.
 	UpdateSettingSuperIdentity(ctx context.Context, site string, s *SettingSuperIdentity) (*SettingSuperIdentity, error)
 
 	// ==== end of client methods for SettingSuperIdentity resource ====
@@ -920,9 +1395,13 @@ type InternalClient interface {
 	// ==== client methods for SettingSuperMail resource ====
 
 	// GetSettingSuperMail retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetSuperMail instead.
 	GetSettingSuperMail(ctx context.Context, site string) (*SettingSuperMail, error)
 
 	// UpdateSettingSuperMail updates a resource
+	//
+	// Deprecated: use Settings().UpdateSuperMail instead.
 	UpdateSettingSuperMail(ctx context.Context, site string, s *SettingSuperMail) (*SettingSuperMail, error)
 
 	// ==== end of client methods for SettingSuperMail resource ====
@@ -930,9 +1409,13 @@ type InternalClient interface {
 	// ==== client methods for SettingSuperMgmt resource ====
 
 	// GetSettingSuperMgmt retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetSuperMgmt instead.
 	GetSettingSuperMgmt(ctx context.Context, site string) (*SettingSuperMgmt, error)
 
 	// UpdateSettingSuperMgmt updates a resource
+	//
+	// Deprecated: use Settings().UpdateSuperMgmt instead.
 	UpdateSettingSuperMgmt(ctx context.Context, site string, s *SettingSuperMgmt) (*SettingSuperMgmt, error)
 
 	// ==== end of client methods for SettingSuperMgmt resource ====
@@ -940,9 +1423,13 @@ type InternalClient interface {
 	// ==== client methods for SettingSuperSdn resource ====
 
 	// GetSettingSuperSdn retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetSuperSdn instead.
 	GetSettingSuperSdn(ctx context.Context, site string) (*SettingSuperSdn, error)
 
 	// UpdateSettingSuperSdn updates a resource
+	//
+	// Deprecated: use Settings().UpdateSuperSdn instead.
 	UpdateSettingSuperSdn(ctx context.Context, site string, s *SettingSuperSdn) (*SettingSuperSdn, error)
 
 	// ==== end of client methods for SettingSuperSdn resource ====
@@ -950,9 +1437,13 @@ type InternalClient interface {
 	// ==== client methods for SettingSuperSmtp resource ====
 
 	// GetSettingSuperSmtp retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetSuperSmtp instead.
 	GetSettingSuperSmtp(ctx context.Context, site string) (*SettingSuperSmtp, error)
 
 	// UpdateSettingSuperSmtp updates a resource
+	//
+	// Deprecated: use Settings().UpdateSuperSmtp instead.
 	UpdateSettingSuperSmtp(ctx context.Context, site string, s *SettingSuperSmtp) (*SettingSuperSmtp, error)
 
 	// ==== end of client methods for SettingSuperSmtp resource ====
@@ -960,9 +1451,13 @@ type InternalClient interface {
 	// ==== client methods for SettingTeleport resource ====
 
 	// GetSettingTeleport retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetTeleport instead.
 	GetSettingTeleport(ctx context.Context, site string) (*SettingTeleport, error)
 
 	// UpdateSettingTeleport updates a resource
+	//
+	// Deprecated: use Settings().UpdateTeleport instead.
 	UpdateSettingTeleport(ctx context.Context, site string, s *SettingTeleport) (*SettingTeleport, error)
 
 	// ==== end of client methods for SettingTeleport resource ====
@@ -970,9 +1465,13 @@ type InternalClient interface {
 	// ==== client methods for SettingTrafficFlow resource ====
 
 	// GetSettingTrafficFlow retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetTrafficFlow instead.
 	GetSettingTrafficFlow(ctx context.Context, site string) (*SettingTrafficFlow, error)
 
 	// UpdateSettingTrafficFlow updates a resource
+	//
+	// Deprecated: use Settings().UpdateTrafficFlow instead.
 	UpdateSettingTrafficFlow(ctx context.Context, site string, s *SettingTrafficFlow) (*SettingTrafficFlow, error)
 
 	// ==== end of client methods for SettingTrafficFlow resource ====
@@ -980,9 +1479,13 @@ type InternalClient interface {
 	// ==== client methods for SettingUsg resource ====
 
 	// GetSettingUsg retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetUsg instead.
 	GetSettingUsg(ctx context.Context, site string) (*SettingUsg, error)
 
 	// UpdateSettingUsg updates a resource
+	//
+	// Deprecated: use Settings().UpdateUsg instead.
 	UpdateSettingUsg(ctx context.Context, site string, s *SettingUsg) (*SettingUsg, error)
 
 	// ==== end of client methods for SettingUsg resource ====
@@ -990,115 +1493,176 @@ type InternalClient interface {
 	// ==== client methods for SettingUsw resource ====
 
 	// GetSettingUsw retrieves the settings for a resource
+	//
+	// Deprecated: use Settings().GetUsw instead.
 	GetSettingUsw(ctx context.Context, site string) (*SettingUsw, error)
 
 	// UpdateSettingUsw updates a resource
+	//
+	// Deprecated: use Settings().UpdateUsw instead.
 	UpdateSettingUsw(ctx context.Context, site string, s *SettingUsw) (*SettingUsw, error)
 
 	// ==== end of client methods for SettingUsw resource ====
 
+	// Deprecated: use Sites().Create instead.
 	CreateSite(ctx context.Context, description string) ([]Site, error)
 
+	// Deprecated: use Sites().Delete instead.
 	DeleteSite(ctx context.Context, id string) ([]Site, error)
 
+	// Deprecated: use Sites().Get instead.
 	GetSite(ctx context.Context, id string) (*Site, error)
 
+	// Deprecated: use Sites().List instead.
 	ListSites(ctx context.Context) ([]Site, error)
 
+	// Deprecated: use Sites().Update instead.
 	UpdateSite(ctx context.Context, name string, description string) ([]Site, error)
 
 	// ==== client methods for SpatialRecord resource ====
 
 	// CreateSpatialRecord creates a resource
+	//
+	// Deprecated: use Maps().CreateSpatialRecord instead.
 	CreateSpatialRecord(ctx context.Context, site string, s *SpatialRecord) (*SpatialRecord, error)
 
 	// DeleteSpatialRecord deletes a resource
+	//
+	// Deprecated: use Maps().DeleteSpatialRecord instead.
 	DeleteSpatialRecord(ctx context.Context, site string, id string) error
 
 	// GetSpatialRecord retrieves a resource
+	//
+	// Deprecated: use Maps().GetSpatialRecord instead.
 	GetSpatialRecord(ctx context.Context, site string, id string) (*SpatialRecord, error)
 
 	// ListSpatialRecord lists the resources
+	//
+	// Deprecated: use Maps().ListSpatialRecord instead.
 	ListSpatialRecord(ctx context.Context, site string) ([]SpatialRecord, error)
 
 	// UpdateSpatialRecord updates a resource
+	//
+	// Deprecated: use Maps().UpdateSpatialRecord instead.
 	UpdateSpatialRecord(ctx context.Context, site string, s *SpatialRecord) (*SpatialRecord, error)
 
 	// ==== end of client methods for SpatialRecord resource ====
 
+	// Deprecated: use System().GetInfo instead.
 	GetSystemInfo(ctx context.Context, id string) (*SysInfo, error)
 
+	// Deprecated: use System().GetInformation instead.
 	GetSystemInformation() (*SysInfo, error)
 
 	// GetSystemInformationContext retrieves system information using the supplied context for cancellation/deadline.
+	//
+	// Deprecated: use System().GetInformationContext instead.
 	GetSystemInformationContext(ctx context.Context) (*SysInfo, error)
 
 	// ==== client methods for Tag resource ====
 
 	// CreateTag creates a resource
+	//
+	// Deprecated: use System().CreateTag instead.
 	CreateTag(ctx context.Context, site string, t *Tag) (*Tag, error)
 
 	// DeleteTag deletes a resource
+	//
+	// Deprecated: use System().DeleteTag instead.
 	DeleteTag(ctx context.Context, site string, id string) error
 
 	// GetTag retrieves a resource
+	//
+	// Deprecated: use System().GetTag instead.
 	GetTag(ctx context.Context, site string, id string) (*Tag, error)
 
 	// ListTag lists the resources
+	//
+	// Deprecated: use System().ListTag instead.
 	ListTag(ctx context.Context, site string) ([]Tag, error)
 
 	// UpdateTag updates a resource
+	//
+	// Deprecated: use System().UpdateTag instead.
 	UpdateTag(ctx context.Context, site string, t *Tag) (*Tag, error)
 
 	// ==== end of client methods for Tag resource ====
 
 	// GetTrafficFlows fetches traffic flows using the provided request payload.
+	//
+	// Deprecated: use TrafficFlows().Get instead.
 	GetTrafficFlows(ctx context.Context, site string, req *TrafficFlowsRequest) (*TrafficFlowsResponse, error)
 
 	// ==== client methods for User resource ====
 
+	// Deprecated: use Users().BlockByMAC instead.
 	BlockUserByMAC(ctx context.Context, site string, mac string) error
 
 	// CreateUser creates a resource
+	//
+	// Deprecated: use Users().Create instead.
 	CreateUser(ctx context.Context, site string, u *User) (*User, error)
 
 	// DeleteUser deletes a resource
+	//
+	// Deprecated: use Users().Delete instead.
 	DeleteUser(ctx context.Context, site string, id string) error
 
+	// Deprecated: use Users().DeleteByMAC instead.
 	DeleteUserByMAC(ctx context.Context, site string, mac string) error
 
 	// GetUser retrieves a resource
+	//
+	// Deprecated: use Users().Get instead.
 	GetUser(ctx context.Context, site string, id string) (*User, error)
 
+	// Deprecated: use Users().GetByMAC instead.
 	GetUserByMAC(ctx context.Context, site string, mac string) (*User, error)
 
+	// Deprecated: use Users().KickByMAC instead.
 	KickUserByMAC(ctx context.Context, site string, mac string) error
 
 	// ListUser lists the resources
+	//
+	// Deprecated: use Users().List instead.
 	ListUser(ctx context.Context, site string) ([]User, error)
 
+	// Deprecated: use Users().OverrideFingerprint instead.
 	OverrideUserFingerprint(ctx context.Context, site string, mac string, devIdOverride int) error
 
+	// Deprecated: use Users().UnblockByMAC instead.
 	UnblockUserByMAC(ctx context.Context, site string, mac string) error
 
 	// UpdateUser updates a resource
+	//
+	// Deprecated: use Users().Update instead.
 	UpdateUser(ctx context.Context, site string, u *User) (*User, error)
 
 	// ==== client methods for UserGroup resource ====
 
 	// CreateUserGroup creates a resource
+	//
+	// Deprecated: use Users().CreateGroup instead.
 	CreateUserGroup(ctx context.Context, site string, u *UserGroup) (*UserGroup, error)
 
 	// DeleteUserGroup deletes a resource
+	//
+	// Deprecated: use Users().DeleteGroup instead.
 	DeleteUserGroup(ctx context.Context, site string, id string) error
 
 	// GetUserGroup retrieves a resource
+	//
+	// Deprecated: use Users().GetGroup instead.
 	GetUserGroup(ctx context.Context, site string, id string) (*UserGroup, error)
 
 	// ListUserGroup lists the resources
+	//
+	// Deprecated: use Users().ListGroup instead.
 	ListUserGroup(ctx context.Context, site string) ([]UserGroup, error)
 
 	// UpdateUserGroup updates a resource
+	//
+	// Deprecated: use Users().UpdateGroup instead.
 	UpdateUserGroup(ctx context.Context, site string, u *UserGroup) (*UserGroup, error)
 
 	// ==== end of client methods for UserGroup resource ====
@@ -1108,18 +1672,28 @@ type InternalClient interface {
 	// ==== client methods for VirtualDevice resource ====
 
 	// CreateVirtualDevice creates a resource
+	//
+	// Deprecated: use Devices().CreateVirtualDevice instead.
 	CreateVirtualDevice(ctx context.Context, site string, v *VirtualDevice) (*VirtualDevice, error)
 
 	// DeleteVirtualDevice deletes a resource
+	//
+	// Deprecated: use Devices().DeleteVirtualDevice instead.
 	DeleteVirtualDevice(ctx context.Context, site string, id string) error
 
 	// GetVirtualDevice retrieves a resource
+	//
+	// Deprecated: use Devices().GetVirtualDevice instead.
 	GetVirtualDevice(ctx context.Context, site string, id string) (*VirtualDevice, error)
 
 	// ListVirtualDevice lists the resources
+	//
+	// Deprecated: use Devices().ListVirtualDevice instead.
 	ListVirtualDevice(ctx context.Context, site string) ([]VirtualDevice, error)
 
 	// UpdateVirtualDevice updates a resource
+	//
+	// Deprecated: use Devices().UpdateVirtualDevice instead.
 	UpdateVirtualDevice(ctx context.Context, site string, v *VirtualDevice) (*VirtualDevice, error)
 
 	// ==== end of client methods for VirtualDevice resource ====
@@ -1127,35 +1701,55 @@ type InternalClient interface {
 	// ==== client methods for WLAN resource ====
 
 	// CreateWLAN creates a resource
+	//
+	// Deprecated: use WLANs().Create instead.
 	CreateWLAN(ctx context.Context, site string, w *WLAN) (*WLAN, error)
 
 	// DeleteWLAN deletes a resource
+	//
+	// Deprecated: use WLANs().Delete instead.
 	DeleteWLAN(ctx context.Context, site string, id string) error
 
 	// GetWLAN retrieves a resource
+	//
+	// Deprecated: use WLANs().Get instead.
 	GetWLAN(ctx context.Context, site string, id string) (*WLAN, error)
 
 	// ListWLAN lists the resources
+	//
+	// Deprecated: use WLANs().List instead.
 	ListWLAN(ctx context.Context, site string) ([]WLAN, error)
 
 	// UpdateWLAN updates a resource
+	//
+	// Deprecated: use WLANs().Update instead.
 	UpdateWLAN(ctx context.Context, site string, w *WLAN) (*WLAN, error)
 
 	// ==== client methods for WLANGroup resource ====
 
 	// CreateWLANGroup creates a resource
+	//
+	// Deprecated: use WLANs().CreateGroup instead.
 	CreateWLANGroup(ctx context.Context, site string, w *WLANGroup) (*WLANGroup, error)
 
 	// DeleteWLANGroup deletes a resource
+	//
+	// Deprecated: use WLANs().DeleteGroup instead.
 	DeleteWLANGroup(ctx context.Context, site string, id string) error
 
 	// GetWLANGroup retrieves a resource
+	//
+	// Deprecated: use WLANs().GetGroup instead.
 	GetWLANGroup(ctx context.Context, site string, id string) (*WLANGroup, error)
 
 	// ListWLANGroup lists the resources
+	//
+	// Deprecated: use WLANs().ListGroup instead.
 	ListWLANGroup(ctx context.Context, site string) ([]WLANGroup, error)
 
 	// UpdateWLANGroup updates a resource
+	//
+	// Deprecated: use WLANs().UpdateGroup instead.
 	UpdateWLANGroup(ctx context.Context, site string, w *WLANGroup) (*WLANGroup, error)
 
 	// ==== end of client methods for WLANGroup resource ====
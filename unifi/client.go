@@ -59,6 +59,13 @@ Fields:
 	APIStyle:      Optionally forces the controller API style (new vs old) instead of probing the controller over the network. The zero value (APIStyleAuto) keeps the auto-detection behavior. Set it to skip the network probe for offline construction.
 	ValidationMode:The mode for validating request bodies. Can be "soft", "hard", or "disable".
 	SkipSystemInfo: Skips the eager GetSystemInformation() round-trip in NewClient. Zero value (false) keeps fail-fast; true defers error surfacing to the first API call.
+	Tracer:        Optional tracing backend; every controller call gets a span named for its resource, operation and site.
+	Meter:         Optional metrics backend for request counts, latencies and transport retries.
+	RequestLogging: Optional structured (log/slog) logging of every request and response, with optional redacted bodies.
+	Cache:         Optional read-through cache of GET responses with per-resource TTLs, request coalescing and invalidation on writes.
+	ReadOnly:      Rejects every request that could change the controller with a *ReadOnlyError, without sending it.
+	DryRun:        Records every request that could change the controller in a DryRunJournal instead of sending it.
+	Audit:         Optional audit trail of every change, with the object before and after it, written to a pluggable sink.
 */
 type ClientConfig struct {
 	URL    string `validate:"required,https_url"`
@@ -108,6 +115,35 @@ type ClientConfig struct {
 	// Each CustomValidator's Tag is used as the go-playground/validator tag name.
 	// See NewCustomRegexValidator for building regex-based validators.
 	CustomValidators []CustomValidator
+	// Tracer, when set, starts one span per controller call (Internal and
+	// Official API alike), named for the resource, operation and site rather
+	// than the raw URL, and ended with the status, error code and retry count.
+	Tracer Tracer
+	// Meter, when set, receives the request count, request duration and retry
+	// metrics (MetricRequests, MetricRequestDuration, MetricRetries).
+	Meter Meter
+	// RequestLogging, when set with a Logger, emits one structured slog event
+	// per request and per response (method, path, site, status, duration, ...),
+	// optionally with redacted bodies.
+	RequestLogging *RequestLogging
+	// Cache, when set, serves repeated GETs from a short-lived response cache,
+	// coalesces identical concurrent GETs and invalidates cached responses on
+	// writes to the same site and resource. See ResponseCache.
+	Cache *ResponseCache
+	// ReadOnly, when set, makes the client refuse every request that could
+	// change the controller — creates, updates, deletes and cmd/ commands, on
+	// the Internal and Official API alike — with a *ReadOnlyError matching
+	// ErrReadOnly, before anything is sent. Reads, including the POST form of
+	// stat/ endpoints, still work. It takes precedence over DryRun.
+	ReadOnly bool
+	// DryRun, when set, records every request that could change the controller
+	// in the journal instead of sending it, and reports it as successful. See
+	// DryRunJournal.
+	DryRun *DryRunJournal
+	// Audit, when set with a Sink, writes an AuditRecord of every request that
+	// could change the controller, with the object before and after the change.
+	// See Audit.
+	Audit *Audit
 }
 
 // client represents a UniFi client.
@@ -135,6 +171,29 @@ type client struct {
 	// a non-reentrant mutex and self-deadlock.
 	sysInfoMu sync.RWMutex
 	validator *validator
+	// telemetry is nil unless a Tracer or Meter is configured.
+	telemetry *telemetry
+	// requestLogger is nil unless RequestLogging is configured.
+	requestLogger *requestLogger
+	// cache is ClientConfig.Cache; cacheResults counts its outcomes when a
+	// Meter is configured.
+	cache        *ResponseCache
+	cacheResults Int64Counter
+	// readOnly and dryRun are ClientConfig.ReadOnly and ClientConfig.DryRun.
+	readOnly bool
+	dryRun   *DryRunJournal
+	// audit is nil unless ClientConfig.Audit is configured.
+	audit *auditor
+	// dpiCatalog caches GetDPICatalog once fetched; dpiCatalogMu guards it and
+	// serializes the fetch.
+	dpiCatalogMu sync.Mutex
+	dpiCatalog   *DPICatalog
+	// listOnlyLookups records the single-object endpoints (lookup* keys) the
+	// controller turned out to lack, so lookupOne lists straight away.
+	listOnlyLookups sync.Map
+	// narrowLookups records the single-object endpoints that have answered an
+	// object, so lookupOne trusts their not-found without listing.
+	narrowLookups sync.Map
 
 	// officialDisabled mirrors ClientConfig.DisableOfficialAPI: when set, the
 	// capability gate fails fast with ErrOfficialAPIDisabled and never probes.
@@ -381,6 +440,13 @@ func newClientFromConfig(config *ClientConfig, v *validator) (*client, error) {
 		interceptors:     interceptors,
 		errorHandler:     errorHandler,
 		validator:        v,
+		telemetry:        newTelemetry(cfg.Tracer, cfg.Meter),
+		requestLogger:    newRequestLogger(cfg.RequestLogging),
+		cache:            cfg.Cache,
+		cacheResults:     newCacheResults(cfg.Cache, cfg.Meter),
+		readOnly:         cfg.ReadOnly,
+		dryRun:           cfg.DryRun,
+		audit:            newAuditor(cfg.Audit),
 		log:              log,
 		officialDisabled: cfg.DisableOfficialAPI,
 	}, nil
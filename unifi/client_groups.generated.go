@@ -0,0 +1,3825 @@
+// Code generated from ace.jar fields *.json files
+// DO NOT EDIT.
+
+package unifi
+
+import (
+	"context"
+	"io"
+	"iter"
+	"time"
+)
+
+// DNSClient is the DNS resource group of the legacy ("Internal") UniFi
+// Network API surface.
+type DNSClient interface {
+	// CreateRecord creates a resource
+	CreateRecord(ctx context.Context, site string, d *DNSRecord) (*DNSRecord, error)
+	// DeleteRecord deletes a resource
+	DeleteRecord(ctx context.Context, site string, id string) error
+	// GetRecord retrieves a resource
+	GetRecord(ctx context.Context, site string, id string) (*DNSRecord, error)
+	// ListRecord lists the resources
+	ListRecord(ctx context.Context, site string) ([]DNSRecord, error)
+	// UpdateRecord updates a resource
+	UpdateRecord(ctx context.Context, site string, d *DNSRecord) (*DNSRecord, error)
+}
+
+// dnsClient forwards the DNS group to the flat client methods.
+type dnsClient struct{ c *client }
+
+var _ DNSClient = dnsClient{}
+
+// DNS returns the DNS resource group.
+func (c *client) DNS() DNSClient {
+	return dnsClient{c}
+}
+
+func (g dnsClient) CreateRecord(ctx context.Context, site string, d *DNSRecord) (*DNSRecord, error) {
+	return g.c.CreateDNSRecord(ctx, site, d)
+}
+
+func (g dnsClient) DeleteRecord(ctx context.Context, site string, id string) error {
+	return g.c.DeleteDNSRecord(ctx, site, id)
+}
+
+func (g dnsClient) GetRecord(ctx context.Context, site string, id string) (*DNSRecord, error) {
+	return g.c.GetDNSRecord(ctx, site, id)
+}
+
+func (g dnsClient) ListRecord(ctx context.Context, site string) ([]DNSRecord, error) {
+	return g.c.ListDNSRecord(ctx, site)
+}
+
+func (g dnsClient) UpdateRecord(ctx context.Context, site string, d *DNSRecord) (*DNSRecord, error) {
+	return g.c.UpdateDNSRecord(ctx, site, d)
+}
+
+// DNSClientMock is a func-field test double implementing DNSClient. A nil
+// field panics on call, surfacing an un-stubbed method in tests.
+type DNSClientMock struct {
+	CreateRecordFunc func(context.Context, string, *DNSRecord) (*DNSRecord, error)
+	DeleteRecordFunc func(context.Context, string, string) error
+	GetRecordFunc    func(context.Context, string, string) (*DNSRecord, error)
+	ListRecordFunc   func(context.Context, string) ([]DNSRecord, error)
+	UpdateRecordFunc func(context.Context, string, *DNSRecord) (*DNSRecord, error)
+}
+
+var _ DNSClient = (*DNSClientMock)(nil)
+
+func (mock *DNSClientMock) CreateRecord(ctx context.Context, site string, d *DNSRecord) (*DNSRecord, error) {
+	return mock.CreateRecordFunc(ctx, site, d)
+}
+
+func (mock *DNSClientMock) DeleteRecord(ctx context.Context, site string, id string) error {
+	return mock.DeleteRecordFunc(ctx, site, id)
+}
+
+func (mock *DNSClientMock) GetRecord(ctx context.Context, site string, id string) (*DNSRecord, error) {
+	return mock.GetRecordFunc(ctx, site, id)
+}
+
+func (mock *DNSClientMock) ListRecord(ctx context.Context, site string) ([]DNSRecord, error) {
+	return mock.ListRecordFunc(ctx, site)
+}
+
+func (mock *DNSClientMock) UpdateRecord(ctx context.Context, site string, d *DNSRecord) (*DNSRecord, error) {
+	return mock.UpdateRecordFunc(ctx, site, d)
+}
+
+// DPIClient is the DPI resource group of the legacy ("Internal") UniFi
+// Network API surface.
+type DPIClient interface {
+	// GetCatalog returns the names of the DPI applications and categories, from the Official API.
+	GetCatalog(ctx context.Context) (*DPICatalog, error)
+	// GetClientStats returns the DPI statistics of the given clients, or of every client when none is given.
+	GetClientStats(ctx context.Context, site string, by DPIGrouping, macs ...string) ([]ClientDPIStats, error)
+	// GetClientTopApplications returns the n applications the client exchanged the most traffic with, or all of them when n <= 0.
+	GetClientTopApplications(ctx context.Context, site string, mac string, n int) ([]DPIStat, error)
+	// GetSiteStats returns the site's DPI statistics, grouped by application or by category.
+	GetSiteStats(ctx context.Context, site string, by DPIGrouping) ([]DPIStat, error)
+}
+
+// dpiClient forwards the DPI group to the flat client methods.
+type dpiClient struct{ c *client }
+
+var _ DPIClient = dpiClient{}
+
+// DPI returns the DPI resource group.
+func (c *client) DPI() DPIClient {
+	return dpiClient{c}
+}
+
+func (g dpiClient) GetCatalog(ctx context.Context) (*DPICatalog, error) {
+	return g.c.GetDPICatalog(ctx)
+}
+
+func (g dpiClient) GetClientStats(ctx context.Context, site string, by DPIGrouping, macs ...string) ([]ClientDPIStats, error) {
+	return g.c.GetClientDPIStats(ctx, site, by, macs...)
+}
+
+func (g dpiClient) GetClientTopApplications(ctx context.Context, site string, mac string, n int) ([]DPIStat, error) {
+	return g.c.GetClientTopApplications(ctx, site, mac, n)
+}
+
+func (g dpiClient) GetSiteStats(ctx context.Context, site string, by DPIGrouping) ([]DPIStat, error) {
+	return g.c.GetSiteDPIStats(ctx, site, by)
+}
+
+// DPIClientMock is a func-field test double implementing DPIClient. A nil
+// field panics on call, surfacing an un-stubbed method in tests.
+type DPIClientMock struct {
+	GetCatalogFunc               func(context.Context) (*DPICatalog, error)
+	GetClientStatsFunc           func(context.Context, string, DPIGrouping, ...string) ([]ClientDPIStats, error)
+	GetClientTopApplicationsFunc func(context.Context, string, string, int) ([]DPIStat, error)
+	GetSiteStatsFunc             func(context.Context, string, DPIGrouping) ([]DPIStat, error)
+}
+
+var _ DPIClient = (*DPIClientMock)(nil)
+
+func (mock *DPIClientMock) GetCatalog(ctx context.Context) (*DPICatalog, error) {
+	return mock.GetCatalogFunc(ctx)
+}
+
+func (mock *DPIClientMock) GetClientStats(ctx context.Context, site string, by DPIGrouping, macs ...string) ([]ClientDPIStats, error) {
+	return mock.GetClientStatsFunc(ctx, site, by, macs...)
+}
+
+func (mock *DPIClientMock) GetClientTopApplications(ctx context.Context, site string, mac string, n int) ([]DPIStat, error) {
+	return mock.GetClientTopApplicationsFunc(ctx, site, mac, n)
+}
+
+func (mock *DPIClientMock) GetSiteStats(ctx context.Context, site string, by DPIGrouping) ([]DPIStat, error) {
+	return mock.GetSiteStatsFunc(ctx, site, by)
+}
+
+// DevicesClient is the Devices resource group of the legacy ("Internal") UniFi
+// Network API surface.
+type DevicesClient interface {
+	// Adopt adopts a device by MAC address.
+	Adopt(ctx context.Context, site string, mac string) error
+	// Create creates a resource
+	Create(ctx context.Context, site string, d *Device) (*Device, error)
+	// CreateVirtualDevice creates a resource
+	CreateVirtualDevice(ctx context.Context, site string, v *VirtualDevice) (*VirtualDevice, error)
+	// Delete deletes a resource
+	Delete(ctx context.Context, site string, id string) error
+	// DeleteVirtualDevice deletes a resource
+	DeleteVirtualDevice(ctx context.Context, site string, id string) error
+	// Forget forgets a device by MAC address.
+	Forget(ctx context.Context, site string, mac string) error
+	// Get retrieves a resource
+	Get(ctx context.Context, site string, id string) (*Device, error)
+	GetByMAC(ctx context.Context, site string, mac string) (*Device, error)
+	// GetVirtualDevice retrieves a resource
+	GetVirtualDevice(ctx context.Context, site string, id string) (*VirtualDevice, error)
+	// List lists the resources
+	List(ctx context.Context, site string) ([]Device, error)
+	// ListSeq streams the devices of a site, decoding the response element by element.
+	ListSeq(ctx context.Context, site string) iter.Seq2[Device, error]
+	// ListVirtualDevice lists the resources
+	ListVirtualDevice(ctx context.Context, site string) ([]VirtualDevice, error)
+	// Update updates a resource
+	Update(ctx context.Context, site string, d *Device) (*Device, error)
+	// UpdateVirtualDevice updates a resource
+	UpdateVirtualDevice(ctx context.Context, site string, v *VirtualDevice) (*VirtualDevice, error)
+}
+
+// devicesClient forwards the Devices group to the flat client methods.
+type devicesClient struct{ c *client }
+
+var _ DevicesClient = devicesClient{}
+
+// Devices returns the Devices resource group.
+func (c *client) Devices() DevicesClient {
+	return devicesClient{c}
+}
+
+func (g devicesClient) Adopt(ctx context.Context, site string, mac string) error {
+	return g.c.AdoptDevice(ctx, site, mac)
+}
+
+func (g devicesClient) Create(ctx context.Context, site string, d *Device) (*Device, error) {
+	return g.c.CreateDevice(ctx, site, d)
+}
+
+func (g devicesClient) CreateVirtualDevice(ctx context.Context, site string, v *VirtualDevice) (*VirtualDevice, error) {
+	return g.c.CreateVirtualDevice(ctx, site, v)
+}
+
+func (g devicesClient) Delete(ctx context.Context, site string, id string) error {
+	return g.c.DeleteDevice(ctx, site, id)
+}
+
+func (g devicesClient) DeleteVirtualDevice(ctx context.Context, site string, id string) error {
+	return g.c.DeleteVirtualDevice(ctx, site, id)
+}
+
+func (g devicesClient) Forget(ctx context.Context, site string, mac string) error {
+	return g.c.ForgetDevice(ctx, site, mac)
+}
+
+func (g devicesClient) Get(ctx context.Context, site string, id string) (*Device, error) {
+	return g.c.GetDevice(ctx, site, id)
+}
+
+func (g devicesClient) GetByMAC(ctx context.Context, site string, mac string) (*Device, error) {
+	return g.c.GetDeviceByMAC(ctx, site, mac)
+}
+
+func (g devicesClient) GetVirtualDevice(ctx context.Context, site string, id string) (*VirtualDevice, error) {
+	return g.c.GetVirtualDevice(ctx, site, id)
+}
+
+func (g devicesClient) List(ctx context.Context, site string) ([]Device, error) {
+	return g.c.ListDevice(ctx, site)
+}
+
+func (g devicesClient) ListSeq(ctx context.Context, site string) iter.Seq2[Device, error] {
+	return g.c.ListDeviceSeq(ctx, site)
+}
+
+func (g devicesClient) ListVirtualDevice(ctx context.Context, site string) ([]VirtualDevice, error) {
+	return g.c.ListVirtualDevice(ctx, site)
+}
+
+func (g devicesClient) Update(ctx context.Context, site string, d *Device) (*Device, error) {
+	return g.c.UpdateDevice(ctx, site, d)
+}
+
+func (g devicesClient) UpdateVirtualDevice(ctx context.Context, site string, v *VirtualDevice) (*VirtualDevice, error) {
+	return g.c.UpdateVirtualDevice(ctx, site, v)
+}
+
+// DevicesClientMock is a func-field test double implementing DevicesClient. A nil
+// field panics on call, surfacing an un-stubbed method in tests.
+type DevicesClientMock struct {
+	AdoptFunc               func(context.Context, string, string) error
+	CreateFunc              func(context.Context, string, *Device) (*Device, error)
+	CreateVirtualDeviceFunc func(context.Context, string, *VirtualDevice) (*VirtualDevice, error)
+	DeleteFunc              func(context.Context, string, string) error
+	DeleteVirtualDeviceFunc func(context.Context, string, string) error
+	ForgetFunc              func(context.Context, string, string) error
+	GetFunc                 func(context.Context, string, string) (*Device, error)
+	GetByMACFunc            func(context.Context, string, string) (*Device, error)
+	GetVirtualDeviceFunc    func(context.Context, string, string) (*VirtualDevice, error)
+	ListFunc                func(context.Context, string) ([]Device, error)
+	ListSeqFunc             func(context.Context, string) iter.Seq2[Device, error]
+	ListVirtualDeviceFunc   func(context.Context, string) ([]VirtualDevice, error)
+	UpdateFunc              func(context.Context, string, *Device) (*Device, error)
+	UpdateVirtualDeviceFunc func(context.Context, string, *VirtualDevice) (*VirtualDevice, error)
+}
+
+var _ DevicesClient = (*DevicesClientMock)(nil)
+
+func (mock *DevicesClientMock) Adopt(ctx context.Context, site string, mac string) error {
+	return mock.AdoptFunc(ctx, site, mac)
+}
+
+func (mock *DevicesClientMock) Create(ctx context.Context, site string, d *Device) (*Device, error) {
+	return mock.CreateFunc(ctx, site, d)
+}
+
+func (mock *DevicesClientMock) CreateVirtualDevice(ctx context.Context, site string, v *VirtualDevice) (*VirtualDevice, error) {
+	return mock.CreateVirtualDeviceFunc(ctx, site, v)
+}
+
+func (mock *DevicesClientMock) Delete(ctx context.Context, site string, id string) error {
+	return mock.DeleteFunc(ctx, site, id)
+}
+
+func (mock *DevicesClientMock) DeleteVirtualDevice(ctx context.Context, site string, id string) error {
+	return mock.DeleteVirtualDeviceFunc(ctx, site, id)
+}
+
+func (mock *DevicesClientMock) Forget(ctx context.Context, site string, mac string) error {
+	return mock.ForgetFunc(ctx, site, mac)
+}
+
+func (mock *DevicesClientMock) Get(ctx context.Context, site string, id string) (*Device, error) {
+	return mock.GetFunc(ctx, site, id)
+}
+
+func (mock *DevicesClientMock) GetByMAC(ctx context.Context, site string, mac string) (*Device, error) {
+	return mock.GetByMACFunc(ctx, site, mac)
+}
+
+func (mock *DevicesClientMock) GetVirtualDevice(ctx context.Context, site string, id string) (*VirtualDevice, error) {
+	return mock.GetVirtualDeviceFunc(ctx, site, id)
+}
+
+func (mock *DevicesClientMock) List(ctx context.Context, site string) ([]Device, error) {
+	return mock.ListFunc(ctx, site)
+}
+
+func (mock *DevicesClientMock) ListSeq(ctx context.Context, site string) iter.Seq2[Device, error] {
+	return mock.ListSeqFunc(ctx, site)
+}
+
+func (mock *DevicesClientMock) ListVirtualDevice(ctx context.Context, site string) ([]VirtualDevice, error) {
+	return mock.ListVirtualDeviceFunc(ctx, site)
+}
+
+func (mock *DevicesClientMock) Update(ctx context.Context, site string, d *Device) (*Device, error) {
+	return mock.UpdateFunc(ctx, site, d)
+}
+
+func (mock *DevicesClientMock) UpdateVirtualDevice(ctx context.Context, site string, v *VirtualDevice) (*VirtualDevice, error) {
+	return mock.UpdateVirtualDeviceFunc(ctx, site, v)
+}
+
+// FeaturesClient is the Features resource group of the legacy ("Internal") UniFi
+// Network API surface.
+type FeaturesClient interface {
+	// Get returns a specific feature by it's name. Name is case-insensitive.
+	Get(ctx context.Context, site string, name string) (*DescribedFeature, error)
+	// IsEnabled returns if a specific feature is enabled by it's name. Name is case-insensitive.
+	IsEnabled(ctx context.Context, site string, name string) (bool, error)
+	// List returns all features of the UniFi controller.
+	List(ctx context.Context, site string) ([]DescribedFeature, error)
+}
+
+// featuresClient forwards the Features group to the flat client methods.
+type featuresClient struct{ c *client }
+
+var _ FeaturesClient = featuresClient{}
+
+// Features returns the Features resource group.
+func (c *client) Features() FeaturesClient {
+	return featuresClient{c}
+}
+
+func (g featuresClient) Get(ctx context.Context, site string, name string) (*DescribedFeature, error) {
+	return g.c.GetFeature(ctx, site, name)
+}
+
+func (g featuresClient) IsEnabled(ctx context.Context, site string, name string) (bool, error) {
+	return g.c.IsFeatureEnabled(ctx, site, name)
+}
+
+func (g featuresClient) List(ctx context.Context, site string) ([]DescribedFeature, error) {
+	return g.c.ListFeatures(ctx, site)
+}
+
+// FeaturesClientMock is a func-field test double implementing FeaturesClient. A nil
+// field panics on call, surfacing an un-stubbed method in tests.
+type FeaturesClientMock struct {
+	GetFunc       func(context.Context, string, string) (*DescribedFeature, error)
+	IsEnabledFunc func(context.Context, string, string) (bool, error)
+	ListFunc      func(context.Context, string) ([]DescribedFeature, error)
+}
+
+var _ FeaturesClient = (*FeaturesClientMock)(nil)
+
+func (mock *FeaturesClientMock) Get(ctx context.Context, site string, name string) (*DescribedFeature, error) {
+	return mock.GetFunc(ctx, site, name)
+}
+
+func (mock *FeaturesClientMock) IsEnabled(ctx context.Context, site string, name string) (bool, error) {
+	return mock.IsEnabledFunc(ctx, site, name)
+}
+
+func (mock *FeaturesClientMock) List(ctx context.Context, site string) ([]DescribedFeature, error) {
+	return mock.ListFunc(ctx, site)
+}
+
+// FirewallClient is the Firewall resource group of the legacy ("Internal") UniFi
+// Network API surface.
+type FirewallClient interface {
+	// CreateContentFiltering creates a resource
+	CreateContentFiltering(ctx context.Context, site string, c *ContentFiltering) (*ContentFiltering, error)
+	// CreateGroup creates a resource
+	CreateGroup(ctx context.Context, site string, f *FirewallGroup) (*FirewallGroup, error)
+	// CreatePortForward creates a resource
+	CreatePortForward(ctx context.Context, site string, p *PortForward) (*PortForward, error)
+	// CreateRule creates a resource
+	CreateRule(ctx context.Context, site string, f *FirewallRule) (*FirewallRule, error)
+	// CreateZone creates a resource
+	CreateZone(ctx context.Context, site string, f *FirewallZone) (*FirewallZone, error)
+	// CreateZonePolicy creates a resource
+	CreateZonePolicy(ctx context.Context, site string, f *FirewallZonePolicy) (*FirewallZonePolicy, error)
+	// DeleteContentFiltering deletes a resource
+	DeleteContentFiltering(ctx context.Context, site string, id string) error
+	// DeleteGroup deletes a resource
+	DeleteGroup(ctx context.Context, site string, id string) error
+	// DeletePortForward deletes a resource
+	DeletePortForward(ctx context.Context, site string, id string) error
+	// DeleteRule deletes a resource
+	DeleteRule(ctx context.Context, site string, id string) error
+	// DeleteZone deletes a resource
+	DeleteZone(ctx context.Context, site string, id string) error
+	// DeleteZonePolicy deletes a resource
+	DeleteZonePolicy(ctx context.Context, site string, id string) error
+	// GetGroup retrieves a resource
+	GetGroup(ctx context.Context, site string, id string) (*FirewallGroup, error)
+	// GetPortForward retrieves a resource
+	GetPortForward(ctx context.Context, site string, id string) (*PortForward, error)
+	// GetRule retrieves a resource
+	GetRule(ctx context.Context, site string, id string) (*FirewallRule, error)
+	// GetZone retrieves a resource
+	GetZone(ctx context.Context, site string, id string) (*FirewallZone, error)
+	// GetZonePolicy retrieves a resource
+	GetZonePolicy(ctx context.Context, site string, id string) (*FirewallZonePolicy, error)
+	// ListContentFiltering lists the resources
+	ListContentFiltering(ctx context.Context, site string) ([]ContentFiltering, error)
+	// ListGroup lists the resources
+	ListGroup(ctx context.Context, site string) ([]FirewallGroup, error)
+	// ListPortForward lists the resources
+	ListPortForward(ctx context.Context, site string) ([]PortForward, error)
+	// ListRule lists the resources
+	ListRule(ctx context.Context, site string) ([]FirewallRule, error)
+	// ListZone lists the resources
+	ListZone(ctx context.Context, site string) ([]FirewallZone, error)
+	ListZoneMatrix(ctx context.Context, site string) ([]FirewallZoneMatrix, error)
+	// ListZonePolicy lists the resources
+	ListZonePolicy(ctx context.Context, site string) ([]FirewallZonePolicy, error)
+	ReorderRules(ctx context.Context, site string, ruleset string, reorder []FirewallRuleIndexUpdate) error
+	ReorderZonePolicies(ctx context.Context, site string, d *FirewallPolicyOrderUpdate) ([]FirewallZonePolicy, error)
+	// UpdateContentFiltering updates a resource
+	UpdateContentFiltering(ctx context.Context, site string, c *ContentFiltering) (*ContentFiltering, error)
+	// UpdateGroup updates a resource
+	UpdateGroup(ctx context.Context, site string, f *FirewallGroup) (*FirewallGroup, error)
+	// UpdatePortForward updates a resource
+	UpdatePortForward(ctx context.Context, site string, p *PortForward) (*PortForward, error)
+	// UpdateRule updates a resource
+	UpdateRule(ctx context.Context, site string, f *FirewallRule) (*FirewallRule, error)
+	// UpdateZone updates a resource
+	UpdateZone(ctx context.Context, site string, f *FirewallZone) (*FirewallZone, error)
+	// UpdateZonePolicy updates a resource
+	UpdateZonePolicy(ctx context.Context, site string, f *FirewallZonePolicy) (*FirewallZonePolicy, error)
+}
+
+// firewallClient forwards the Firewall group to the flat client methods.
+type firewallClient struct{ c *client }
+
+var _ FirewallClient = firewallClient{}
+
+// Firewall returns the Firewall resource group.
+func (c *client) Firewall() FirewallClient {
+	return firewallClient{c}
+}
+
+func (g firewallClient) CreateContentFiltering(ctx context.Context, site string, c *ContentFiltering) (*ContentFiltering, error) {
+	return g.c.CreateContentFiltering(ctx, site, c)
+}
+
+func (g firewallClient) CreateGroup(ctx context.Context, site string, f *FirewallGroup) (*FirewallGroup, error) {
+	return g.c.CreateFirewallGroup(ctx, site, f)
+}
+
+func (g firewallClient) CreatePortForward(ctx context.Context, site string, p *PortForward) (*PortForward, error) {
+	return g.c.CreatePortForward(ctx, site, p)
+}
+
+func (g firewallClient) CreateRule(ctx context.Context, site string, f *FirewallRule) (*FirewallRule, error) {
+	return g.c.CreateFirewallRule(ctx, site, f)
+}
+
+func (g firewallClient) CreateZone(ctx context.Context, site string, f *FirewallZone) (*FirewallZone, error) {
+	return g.c.CreateFirewallZone(ctx, site, f)
+}
+
+func (g firewallClient) CreateZonePolicy(ctx context.Context, site string, f *FirewallZonePolicy) (*FirewallZonePolicy, error) {
+	return g.c.CreateFirewallZonePolicy(ctx, site, f)
+}
+
+func (g firewallClient) DeleteContentFiltering(ctx context.Context, site string, id string) error {
+	return g.c.DeleteContentFiltering(ctx, site, id)
+}
+
+func (g firewallClient) DeleteGroup(ctx context.Context, site string, id string) error {
+	return g.c.DeleteFirewallGroup(ctx, site, id)
+}
+
+func (g firewallClient) DeletePortForward(ctx context.Context, site string, id string) error {
+	return g.c.DeletePortForward(ctx, site, id)
+}
+
+func (g firewallClient) DeleteRule(ctx context.Context, site string, id string) error {
+	return g.c.DeleteFirewallRule(ctx, site, id)
+}
+
+func (g firewallClient) DeleteZone(ctx context.Context, site string, id string) error {
+	return g.c.DeleteFirewallZone(ctx, site, id)
+}
+
+func (g firewallClient) DeleteZonePolicy(ctx context.Context, site string, id string) error {
+	return g.c.DeleteFirewallZonePolicy(ctx, site, id)
+}
+
+func (g firewallClient) GetGroup(ctx context.Context, site string, id string) (*FirewallGroup, error) {
+	return g.c.GetFirewallGroup(ctx, site, id)
+}
+
+func (g firewallClient) GetPortForward(ctx context.Context, site string, id string) (*PortForward, error) {
+	return g.c.GetPortForward(ctx, site, id)
+}
+
+func (g firewallClient) GetRule(ctx context.Context, site string, id string) (*FirewallRule, error) {
+	return g.c.GetFirewallRule(ctx, site, id)
+}
+
+func (g firewallClient) GetZone(ctx context.Context, site string, id string) (*FirewallZone, error) {
+	return g.c.GetFirewallZone(ctx, site, id)
+}
+
+func (g firewallClient) GetZonePolicy(ctx context.Context, site string, id string) (*FirewallZonePolicy, error) {
+	return g.c.GetFirewallZonePolicy(ctx, site, id)
+}
+
+func (g firewallClient) ListContentFiltering(ctx context.Context, site string) ([]ContentFiltering, error) {
+	return g.c.ListContentFiltering(ctx, site)
+}
+
+func (g firewallClient) ListGroup(ctx context.Context, site string) ([]FirewallGroup, error) {
+	return g.c.ListFirewallGroup(ctx, site)
+}
+
+func (g firewallClient) ListPortForward(ctx context.Context, site string) ([]PortForward, error) {
+	return g.c.ListPortForward(ctx, site)
+}
+
+func (g firewallClient) ListRule(ctx context.Context, site string) ([]FirewallRule, error) {
+	return g.c.ListFirewallRule(ctx, site)
+}
+
+func (g firewallClient) ListZone(ctx context.Context, site string) ([]FirewallZone, error) {
+	return g.c.ListFirewallZone(ctx, site)
+}
+
+func (g firewallClient) ListZoneMatrix(ctx context.Context, site string) ([]FirewallZoneMatrix, error) {
+	return g.c.ListFirewallZoneMatrix(ctx, site)
+}
+
+func (g firewallClient) ListZonePolicy(ctx context.Context, site string) ([]FirewallZonePolicy, error) {
+	return g.c.ListFirewallZonePolicy(ctx, site)
+}
+
+func (g firewallClient) ReorderRules(ctx context.Context, site string, ruleset string, reorder []FirewallRuleIndexUpdate) error {
+	return g.c.ReorderFirewallRules(ctx, site, ruleset, reorder)
+}
+
+func (g firewallClient) ReorderZonePolicies(ctx context.Context, site string, d *FirewallPolicyOrderUpdate) ([]FirewallZonePolicy, error) {
+	return g.c.ReorderFirewallPolicies(ctx, site, d)
+}
+
+func (g firewallClient) UpdateContentFiltering(ctx context.Context, site string, c *ContentFiltering) (*ContentFiltering, error) {
+	return g.c.UpdateContentFiltering(ctx, site, c)
+}
+
+func (g firewallClient) UpdateGroup(ctx context.Context, site string, f *FirewallGroup) (*FirewallGroup, error) {
+	return g.c.UpdateFirewallGroup(ctx, site, f)
+}
+
+func (g firewallClient) UpdatePortForward(ctx context.Context, site string, p *PortForward) (*PortForward, error) {
+	return g.c.UpdatePortForward(ctx, site, p)
+}
+
+func (g firewallClient) UpdateRule(ctx context.Context, site string, f *FirewallRule) (*FirewallRule, error) {
+	return g.c.UpdateFirewallRule(ctx, site, f)
+}
+
+func (g firewallClient) UpdateZone(ctx context.Context, site string, f *FirewallZone) (*FirewallZone, error) {
+	return g.c.UpdateFirewallZone(ctx, site, f)
+}
+
+func (g firewallClient) UpdateZonePolicy(ctx context.Context, site string, f *FirewallZonePolicy) (*FirewallZonePolicy, error) {
+	return g.c.UpdateFirewallZonePolicy(ctx, site, f)
+}
+
+// FirewallClientMock is a func-field test double implementing FirewallClient. A nil
+// field panics on call, surfacing an un-stubbed method in tests.
+type FirewallClientMock struct {
+	CreateContentFilteringFunc func(context.Context, string, *ContentFiltering) (*ContentFiltering, error)
+	CreateGroupFunc            func(context.Context, string, *FirewallGroup) (*FirewallGroup, error)
+	CreatePortForwardFunc      func(context.Context, string, *PortForward) (*PortForward, error)
+	CreateRuleFunc             func(context.Context, string, *FirewallRule) (*FirewallRule, error)
+	CreateZoneFunc             func(context.Context, string, *FirewallZone) (*FirewallZone, error)
+	CreateZonePolicyFunc       func(context.Context, string, *FirewallZonePolicy) (*FirewallZonePolicy, error)
+	DeleteContentFilteringFunc func(context.Context, string, string) error
+	DeleteGroupFunc            func(context.Context, string, string) error
+	DeletePortForwardFunc      func(context.Context, string, string) error
+	DeleteRuleFunc             func(context.Context, string, string) error
+	DeleteZoneFunc             func(context.Context, string, string) error
+	DeleteZonePolicyFunc       func(context.Context, string, string) error
+	GetGroupFunc               func(context.Context, string, string) (*FirewallGroup, error)
+	GetPortForwardFunc         func(context.Context, string, string) (*PortForward, error)
+	GetRuleFunc                func(context.Context, string, string) (*FirewallRule, error)
+	GetZoneFunc                func(context.Context, string, string) (*FirewallZone, error)
+	GetZonePolicyFunc          func(context.Context, string, string) (*FirewallZonePolicy, error)
+	ListContentFilteringFunc   func(context.Context, string) ([]ContentFiltering, error)
+	ListGroupFunc              func(context.Context, string) ([]FirewallGroup, error)
+	ListPortForwardFunc        func(context.Context, string) ([]PortForward, error)
+	ListRuleFunc               func(context.Context, string) ([]FirewallRule, error)
+	ListZoneFunc               func(context.Context, string) ([]FirewallZone, error)
+	ListZoneMatrixFunc         func(context.Context, string) ([]FirewallZoneMatrix, error)
+	ListZonePolicyFunc         func(context.Context, string) ([]FirewallZonePolicy, error)
+	ReorderRulesFunc           func(context.Context, string, string, []FirewallRuleIndexUpdate) error
+	ReorderZonePoliciesFunc    func(context.Context, string, *FirewallPolicyOrderUpdate) ([]FirewallZonePolicy, error)
+	UpdateContentFilteringFunc func(context.Context, string, *ContentFiltering) (*ContentFiltering, error)
+	UpdateGroupFunc            func(context.Context, string, *FirewallGroup) (*FirewallGroup, error)
+	UpdatePortForwardFunc      func(context.Context, string, *PortForward) (*PortForward, error)
+	UpdateRuleFunc             func(context.Context, string, *FirewallRule) (*FirewallRule, error)
+	UpdateZoneFunc             func(context.Context, string, *FirewallZone) (*FirewallZone, error)
+	UpdateZonePolicyFunc       func(context.Context, string, *FirewallZonePolicy) (*FirewallZonePolicy, error)
+}
+
+var _ FirewallClient = (*FirewallClientMock)(nil)
+
+func (mock *FirewallClientMock) CreateContentFiltering(ctx context.Context, site string, c *ContentFiltering) (*ContentFiltering, error) {
+	return mock.CreateContentFilteringFunc(ctx, site, c)
+}
+
+func (mock *FirewallClientMock) CreateGroup(ctx context.Context, site string, f *FirewallGroup) (*FirewallGroup, error) {
+	return mock.CreateGroupFunc(ctx, site, f)
+}
+
+func (mock *FirewallClientMock) CreatePortForward(ctx context.Context, site string, p *PortForward) (*PortForward, error) {
+	return mock.CreatePortForwardFunc(ctx, site, p)
+}
+
+func (mock *FirewallClientMock) CreateRule(ctx context.Context, site string, f *FirewallRule) (*FirewallRule, error) {
+	return mock.CreateRuleFunc(ctx, site, f)
+}
+
+func (mock *FirewallClientMock) CreateZone(ctx context.Context, site string, f *FirewallZone) (*FirewallZone, error) {
+	return mock.CreateZoneFunc(ctx, site, f)
+}
+
+func (mock *FirewallClientMock) CreateZonePolicy(ctx context.Context, site string, f *FirewallZonePolicy) (*FirewallZonePolicy, error) {
+	return mock.CreateZonePolicyFunc(ctx, site, f)
+}
+
+func (mock *FirewallClientMock) DeleteContentFiltering(ctx context.Context, site string, id string) error {
+	return mock.DeleteContentFilteringFunc(ctx, site, id)
+}
+
+func (mock *FirewallClientMock) DeleteGroup(ctx context.Context, site string, id string) error {
+	return mock.DeleteGroupFunc(ctx, site, id)
+}
+
+func (mock *FirewallClientMock) DeletePortForward(ctx context.Context, site string, id string) error {
+	return mock.DeletePortForwardFunc(ctx, site, id)
+}
+
+func (mock *FirewallClientMock) DeleteRule(ctx context.Context, site string, id string) error {
+	return mock.DeleteRuleFunc(ctx, site, id)
+}
+
+func (mock *FirewallClientMock) DeleteZone(ctx context.Context, site string, id string) error {
+	return mock.DeleteZoneFunc(ctx, site, id)
+}
+
+func (mock *FirewallClientMock) DeleteZonePolicy(ctx context.Context, site string, id string) error {
+	return mock.DeleteZonePolicyFunc(ctx, site, id)
+}
+
+func (mock *FirewallClientMock) GetGroup(ctx context.Context, site string, id string) (*FirewallGroup, error) {
+	return mock.GetGroupFunc(ctx, site, id)
+}
+
+func (mock *FirewallClientMock) GetPortForward(ctx context.Context, site string, id string) (*PortForward, error) {
+	return mock.GetPortForwardFunc(ctx, site, id)
+}
+
+func (mock *FirewallClientMock) GetRule(ctx context.Context, site string, id string) (*FirewallRule, error) {
+	return mock.GetRuleFunc(ctx, site, id)
+}
+
+func (mock *FirewallClientMock) GetZone(ctx context.Context, site string, id string) (*FirewallZone, error) {
+	return mock.GetZoneFunc(ctx, site, id)
+}
+
+func (mock *FirewallClientMock) GetZonePolicy(ctx context.Context, site string, id string) (*FirewallZonePolicy, error) {
+	return mock.GetZonePolicyFunc(ctx, site, id)
+}
+
+func (mock *FirewallClientMock) ListContentFiltering(ctx context.Context, site string) ([]ContentFiltering, error) {
+	return mock.ListContentFilteringFunc(ctx, site)
+}
+
+func (mock *FirewallClientMock) ListGroup(ctx context.Context, site string) ([]FirewallGroup, error) {
+	return mock.ListGroupFunc(ctx, site)
+}
+
+func (mock *FirewallClientMock) ListPortForward(ctx context.Context, site string) ([]PortForward, error) {
+	return mock.ListPortForwardFunc(ctx, site)
+}
+
+func (mock *FirewallClientMock) ListRule(ctx context.Context, site string) ([]FirewallRule, error) {
+	return mock.ListRuleFunc(ctx, site)
+}
+
+func (mock *FirewallClientMock) ListZone(ctx context.Context, site string) ([]FirewallZone, error) {
+	return mock.ListZoneFunc(ctx, site)
+}
+
+func (mock *FirewallClientMock) ListZoneMatrix(ctx context.Context, site string) ([]FirewallZoneMatrix, error) {
+	return mock.ListZoneMatrixFunc(ctx, site)
+}
+
+func (mock *FirewallClientMock) ListZonePolicy(ctx context.Context, site string) ([]FirewallZonePolicy, error) {
+	return mock.ListZonePolicyFunc(ctx, site)
+}
+
+func (mock *FirewallClientMock) ReorderRules(ctx context.Context, site string, ruleset string, reorder []FirewallRuleIndexUpdate) error {
+	return mock.ReorderRulesFunc(ctx, site, ruleset, reorder)
+}
+
+func (mock *FirewallClientMock) ReorderZonePolicies(ctx context.Context, site string, d *FirewallPolicyOrderUpdate) ([]FirewallZonePolicy, error) {
+	return mock.ReorderZonePoliciesFunc(ctx, site, d)
+}
+
+func (mock *FirewallClientMock) UpdateContentFiltering(ctx context.Context, site string, c *ContentFiltering) (*ContentFiltering, error) {
+	return mock.UpdateContentFilteringFunc(ctx, site, c)
+}
+
+func (mock *FirewallClientMock) UpdateGroup(ctx context.Context, site string, f *FirewallGroup) (*FirewallGroup, error) {
+	return mock.UpdateGroupFunc(ctx, site, f)
+}
+
+func (mock *FirewallClientMock) UpdatePortForward(ctx context.Context, site string, p *PortForward) (*PortForward, error) {
+	return mock.UpdatePortForwardFunc(ctx, site, p)
+}
+
+func (mock *FirewallClientMock) UpdateRule(ctx context.Context, site string, f *FirewallRule) (*FirewallRule, error) {
+	return mock.UpdateRuleFunc(ctx, site, f)
+}
+
+func (mock *FirewallClientMock) UpdateZone(ctx context.Context, site string, f *FirewallZone) (*FirewallZone, error) {
+	return mock.UpdateZoneFunc(ctx, site, f)
+}
+
+func (mock *FirewallClientMock) UpdateZonePolicy(ctx context.Context, site string, f *FirewallZonePolicy) (*FirewallZonePolicy, error) {
+	return mock.UpdateZonePolicyFunc(ctx, site, f)
+}
+
+// HotspotClient is the Hotspot resource group of the legacy ("Internal") UniFi
+// Network API surface.
+type HotspotClient interface {
+	// CreateHotspot2Conf creates a resource
+	CreateHotspot2Conf(ctx context.Context, site string, h *Hotspot2Conf) (*Hotspot2Conf, error)
+	// CreateOperator creates a resource
+	CreateOperator(ctx context.Context, site string, h *HotspotOp) (*HotspotOp, error)
+	// CreatePackage creates a resource
+	CreatePackage(ctx context.Context, site string, h *HotspotPackage) (*HotspotPackage, error)
+	// DeleteHotspot2Conf deletes a resource
+	DeleteHotspot2Conf(ctx context.Context, site string, id string) error
+	// DeleteOperator deletes a resource
+	DeleteOperator(ctx context.Context, site string, id string) error
+	// DeletePackage deletes a resource
+	DeletePackage(ctx context.Context, site string, id string) error
+	// DeletePortalFile deletes a Hotspot Portal file from the controller.
+	DeletePortalFile(ctx context.Context, site string, id string) error
+	// GetHotspot2Conf retrieves a resource
+	GetHotspot2Conf(ctx context.Context, site string, id string) (*Hotspot2Conf, error)
+	// GetOperator retrieves a resource
+	GetOperator(ctx context.Context, site string, id string) (*HotspotOp, error)
+	// GetPackage retrieves a resource
+	GetPackage(ctx context.Context, site string, id string) (*HotspotPackage, error)
+	// GetPortalFile returns a specific Hotspot Portal file by it's ID.
+	GetPortalFile(ctx context.Context, site string, id string) (*PortalFile, error)
+	// ListHotspot2Conf lists the resources
+	ListHotspot2Conf(ctx context.Context, site string) ([]Hotspot2Conf, error)
+	// ListOperator lists the resources
+	ListOperator(ctx context.Context, site string) ([]HotspotOp, error)
+	// ListPackage lists the resources
+	ListPackage(ctx context.Context, site string) ([]HotspotPackage, error)
+	// ListPortalFiles lists all Hotspot Portal files on the controller.
+	ListPortalFiles(ctx context.Context, site string) ([]PortalFile, error)
+	// UpdateHotspot2Conf updates a resource
+	UpdateHotspot2Conf(ctx context.Context, site string, h *Hotspot2Conf) (*Hotspot2Conf, error)
+	// UpdateOperator updates a resource
+	UpdateOperator(ctx context.Context, site string, h *HotspotOp) (*HotspotOp, error)
+	// UpdatePackage updates a resource
+	UpdatePackage(ctx context.Context, site string, h *HotspotPackage) (*HotspotPackage, error)
+	// UploadPortalFile uploads a Hotspot Portal file to the controller.
+	UploadPortalFile(ctx context.Context, site string, filepath string) (*PortalFile, error)
+	// UploadPortalFileFromReader uploads a Hotspot Portal file using io.Reader to the controller.
+	UploadPortalFileFromReader(ctx context.Context, site string, reader io.Reader, filename string) (*PortalFile, error)
+}
+
+// hotspotClient forwards the Hotspot group to the flat client methods.
+type hotspotClient struct{ c *client }
+
+var _ HotspotClient = hotspotClient{}
+
+// Hotspot returns the Hotspot resource group.
+func (c *client) Hotspot() HotspotClient {
+	return hotspotClient{c}
+}
+
+func (g hotspotClient) CreateHotspot2Conf(ctx context.Context, site string, h *Hotspot2Conf) (*Hotspot2Conf, error) {
+	return g.c.CreateHotspot2Conf(ctx, site, h)
+}
+
+func (g hotspotClient) CreateOperator(ctx context.Context, site string, h *HotspotOp) (*HotspotOp, error) {
+	return g.c.CreateHotspotOp(ctx, site, h)
+}
+
+func (g hotspotClient) CreatePackage(ctx context.Context, site string, h *HotspotPackage) (*HotspotPackage, error) {
+	return g.c.CreateHotspotPackage(ctx, site, h)
+}
+
+func (g hotspotClient) DeleteHotspot2Conf(ctx context.Context, site string, id string) error {
+	return g.c.DeleteHotspot2Conf(ctx, site, id)
+}
+
+func (g hotspotClient) DeleteOperator(ctx context.Context, site string, id string) error {
+	return g.c.DeleteHotspotOp(ctx, site, id)
+}
+
+func (g hotspotClient) DeletePackage(ctx context.Context, site string, id string) error {
+	return g.c.DeleteHotspotPackage(ctx, site, id)
+}
+
+func (g hotspotClient) DeletePortalFile(ctx context.Context, site string, id string) error {
+	return g.c.DeletePortalFile(ctx, site, id)
+}
+
+func (g hotspotClient) GetHotspot2Conf(ctx context.Context, site string, id string) (*Hotspot2Conf, error) {
+	return g.c.GetHotspot2Conf(ctx, site, id)
+}
+
+func (g hotspotClient) GetOperator(ctx context.Context, site string, id string) (*HotspotOp, error) {
+	return g.c.GetHotspotOp(ctx, site, id)
+}
+
+func (g hotspotClient) GetPackage(ctx context.Context, site string, id string) (*HotspotPackage, error) {
+	return g.c.GetHotspotPackage(ctx, site, id)
+}
+
+func (g hotspotClient) GetPortalFile(ctx context.Context, site string, id string) (*PortalFile, error) {
+	return g.c.GetPortalFile(ctx, site, id)
+}
+
+func (g hotspotClient) ListHotspot2Conf(ctx context.Context, site string) ([]Hotspot2Conf, error) {
+	return g.c.ListHotspot2Conf(ctx, site)
+}
+
+func (g hotspotClient) ListOperator(ctx context.Context, site string) ([]HotspotOp, error) {
+	return g.c.ListHotspotOp(ctx, site)
+}
+
+func (g hotspotClient) ListPackage(ctx context.Context, site string) ([]HotspotPackage, error) {
+	return g.c.ListHotspotPackage(ctx, site)
+}
+
+func (g hotspotClient) ListPortalFiles(ctx context.Context, site string) ([]PortalFile, error) {
+	return g.c.ListPortalFiles(ctx, site)
+}
+
+func (g hotspotClient) UpdateHotspot2Conf(ctx context.Context, site string, h *Hotspot2Conf) (*Hotspot2Conf, error) {
+	return g.c.UpdateHotspot2Conf(ctx, site, h)
+}
+
+func (g hotspotClient) UpdateOperator(ctx context.Context, site string, h *HotspotOp) (*HotspotOp, error) {
+	return g.c.UpdateHotspotOp(ctx, site, h)
+}
+
+func (g hotspotClient) UpdatePackage(ctx context.Context, site string, h *HotspotPackage) (*HotspotPackage, error) {
+	return g.c.UpdateHotspotPackage(ctx, site, h)
+}
+
+func (g hotspotClient) UploadPortalFile(ctx context.Context, site string, filepath string) (*PortalFile, error) {
+	return g.c.UploadPortalFile(ctx, site, filepath)
+}
+
+func (g hotspotClient) UploadPortalFileFromReader(ctx context.Context, site string, reader io.Reader, filename string) (*PortalFile, error) {
+	return g.c.UploadPortalFileFromReader(ctx, site, reader, filename)
+}
+
+// HotspotClientMock is a func-field test double implementing HotspotClient. A nil
+// field panics on call, surfacing an un-stubbed method in tests.
+type HotspotClientMock struct {
+	CreateHotspot2ConfFunc         func(context.Context, string, *Hotspot2Conf) (*Hotspot2Conf, error)
+	CreateOperatorFunc             func(context.Context, string, *HotspotOp) (*HotspotOp, error)
+	CreatePackageFunc              func(context.Context, string, *HotspotPackage) (*HotspotPackage, error)
+	DeleteHotspot2ConfFunc         func(context.Context, string, string) error
+	DeleteOperatorFunc             func(context.Context, string, string) error
+	DeletePackageFunc              func(context.Context, string, string) error
+	DeletePortalFileFunc           func(context.Context, string, string) error
+	GetHotspot2ConfFunc            func(context.Context, string, string) (*Hotspot2Conf, error)
+	GetOperatorFunc                func(context.Context, string, string) (*HotspotOp, error)
+	GetPackageFunc                 func(context.Context, string, string) (*HotspotPackage, error)
+	GetPortalFileFunc              func(context.Context, string, string) (*PortalFile, error)
+	ListHotspot2ConfFunc           func(context.Context, string) ([]Hotspot2Conf, error)
+	ListOperatorFunc               func(context.Context, string) ([]HotspotOp, error)
+	ListPackageFunc                func(context.Context, string) ([]HotspotPackage, error)
+	ListPortalFilesFunc            func(context.Context, string) ([]PortalFile, error)
+	UpdateHotspot2ConfFunc         func(context.Context, string, *Hotspot2Conf) (*Hotspot2Conf, error)
+	UpdateOperatorFunc             func(context.Context, string, *HotspotOp) (*HotspotOp, error)
+	UpdatePackageFunc              func(context.Context, string, *HotspotPackage) (*HotspotPackage, error)
+	UploadPortalFileFunc           func(context.Context, string, string) (*PortalFile, error)
+	UploadPortalFileFromReaderFunc func(context.Context, string, io.Reader, string) (*PortalFile, error)
+}
+
+var _ HotspotClient = (*HotspotClientMock)(nil)
+
+func (mock *HotspotClientMock) CreateHotspot2Conf(ctx context.Context, site string, h *Hotspot2Conf) (*Hotspot2Conf, error) {
+	return mock.CreateHotspot2ConfFunc(ctx, site, h)
+}
+
+func (mock *HotspotClientMock) CreateOperator(ctx context.Context, site string, h *HotspotOp) (*HotspotOp, error) {
+	return mock.CreateOperatorFunc(ctx, site, h)
+}
+
+func (mock *HotspotClientMock) CreatePackage(ctx context.Context, site string, h *HotspotPackage) (*HotspotPackage, error) {
+	return mock.CreatePackageFunc(ctx, site, h)
+}
+
+func (mock *HotspotClientMock) DeleteHotspot2Conf(ctx context.Context, site string, id string) error {
+	return mock.DeleteHotspot2ConfFunc(ctx, site, id)
+}
+
+func (mock *HotspotClientMock) DeleteOperator(ctx context.Context, site string, id string) error {
+	return mock.DeleteOperatorFunc(ctx, site, id)
+}
+
+func (mock *HotspotClientMock) DeletePackage(ctx context.Context, site string, id string) error {
+	return mock.DeletePackageFunc(ctx, site, id)
+}
+
+func (mock *HotspotClientMock) DeletePortalFile(ctx context.Context, site string, id string) error {
+	return mock.DeletePortalFileFunc(ctx, site, id)
+}
+
+func (mock *HotspotClientMock) GetHotspot2Conf(ctx context.Context, site string, id string) (*Hotspot2Conf, error) {
+	return mock.GetHotspot2ConfFunc(ctx, site, id)
+}
+
+func (mock *HotspotClientMock) GetOperator(ctx context.Context, site string, id string) (*HotspotOp, error) {
+	return mock.GetOperatorFunc(ctx, site, id)
+}
+
+func (mock *HotspotClientMock) GetPackage(ctx context.Context, site string, id string) (*HotspotPackage, error) {
+	return mock.GetPackageFunc(ctx, site, id)
+}
+
+func (mock *HotspotClientMock) GetPortalFile(ctx context.Context, site string, id string) (*PortalFile, error) {
+	return mock.GetPortalFileFunc(ctx, site, id)
+}
+
+func (mock *HotspotClientMock) ListHotspot2Conf(ctx context.Context, site string) ([]Hotspot2Conf, error) {
+	return mock.ListHotspot2ConfFunc(ctx, site)
+}
+
+func (mock *HotspotClientMock) ListOperator(ctx context.Context, site string) ([]HotspotOp, error) {
+	return mock.ListOperatorFunc(ctx, site)
+}
+
+func (mock *HotspotClientMock) ListPackage(ctx context.Context, site string) ([]HotspotPackage, error) {
+	return mock.ListPackageFunc(ctx, site)
+}
+
+func (mock *HotspotClientMock) ListPortalFiles(ctx context.Context, site string) ([]PortalFile, error) {
+	return mock.ListPortalFilesFunc(ctx, site)
+}
+
+func (mock *HotspotClientMock) UpdateHotspot2Conf(ctx context.Context, site string, h *Hotspot2Conf) (*Hotspot2Conf, error) {
+	return mock.UpdateHotspot2ConfFunc(ctx, site, h)
+}
+
+func (mock *HotspotClientMock) UpdateOperator(ctx context.Context, site string, h *HotspotOp) (*HotspotOp, error) {
+	return mock.UpdateOperatorFunc(ctx, site, h)
+}
+
+func (mock *HotspotClientMock) UpdatePackage(ctx context.Context, site string, h *HotspotPackage) (*HotspotPackage, error) {
+	return mock.UpdatePackageFunc(ctx, site, h)
+}
+
+func (mock *HotspotClientMock) UploadPortalFile(ctx context.Context, site string, filepath string) (*PortalFile, error) {
+	return mock.UploadPortalFileFunc(ctx, site, filepath)
+}
+
+func (mock *HotspotClientMock) UploadPortalFileFromReader(ctx context.Context, site string, reader io.Reader, filename string) (*PortalFile, error) {
+	return mock.UploadPortalFileFromReaderFunc(ctx, site, reader, filename)
+}
+
+// MapsClient is the Maps resource group of the legacy ("Internal") UniFi
+// Network API surface.
+type MapsClient interface {
+	// Create creates a resource
+	Create(ctx context.Context, site string, m *Map) (*Map, error)
+	// CreateChannelPlan creates a resource
+	CreateChannelPlan(ctx context.Context, site string, c *ChannelPlan) (*ChannelPlan, error)
+	// CreateHeatMap creates a resource
+	CreateHeatMap(ctx context.Context, site string, h *HeatMap) (*HeatMap, error)
+	// CreateHeatMapPoint creates a resource
+	CreateHeatMapPoint(ctx context.Context, site string, h *HeatMapPoint) (*HeatMapPoint, error)
+	// CreateSpatialRecord creates a resource
+	CreateSpatialRecord(ctx context.Context, site string, s *SpatialRecord) (*SpatialRecord, error)
+	// Delete deletes a resource
+	Delete(ctx context.Context, site string, id string) error
+	// DeleteChannelPlan deletes a resource
+	DeleteChannelPlan(ctx context.Context, site string, id string) error
+	// DeleteHeatMap deletes a resource
+	DeleteHeatMap(ctx context.Context, site string, id string) error
+	// DeleteHeatMapPoint deletes a resource
+	DeleteHeatMapPoint(ctx context.Context, site string, id string) error
+	// DeleteSpatialRecord deletes a resource
+	DeleteSpatialRecord(ctx context.Context, site string, id string) error
+	// Get retrieves a resource
+	Get(ctx context.Context, site string, id string) (*Map, error)
+	// GetChannelPlan retrieves a resource
+	GetChannelPlan(ctx context.Context, site string, id string) (*ChannelPlan, error)
+	// GetChannelPlanInput gathers the APs, their positions and neighbors, and the country of the site, as the input of PlanChannels.
+	GetChannelPlanInput(ctx context.Context, site string) (*ChannelPlanInput, error)
+	// GetHeatMap retrieves a resource
+	GetHeatMap(ctx context.Context, site string, id string) (*HeatMap, error)
+	// GetHeatMapPoint retrieves a resource
+	GetHeatMapPoint(ctx context.Context, site string, id string) (*HeatMapPoint, error)
+	// GetSpatialRecord retrieves a resource
+	GetSpatialRecord(ctx context.Context, site string, id string) (*SpatialRecord, error)
+	// List lists the resources
+	List(ctx context.Context, site string) ([]Map, error)
+	// ListChannelPlan lists the resources
+	ListChannelPlan(ctx context.Context, site string) ([]ChannelPlan, error)
+	// ListHeatMap lists the resources
+	ListHeatMap(ctx context.Context, site string) ([]HeatMap, error)
+	// ListHeatMapPoint lists the resources
+	ListHeatMapPoint(ctx context.Context, site string) ([]HeatMapPoint, error)
+	// ListSpatialRecord lists the resources
+	ListSpatialRecord(ctx context.Context, site string) ([]SpatialRecord, error)
+	// Update updates a resource
+	Update(ctx context.Context, site string, m *Map) (*Map, error)
+	// UpdateChannelPlan updates a resource
+	UpdateChannelPlan(ctx context.Context, site string, c *ChannelPlan) (*ChannelPlan, error)
+	// UpdateHeatMap updates a resource
+	UpdateHeatMap(ctx context.Context, site string, h *HeatMap) (*HeatMap, error)
+	// UpdateHeatMapPoint updates a resource
+	UpdateHeatMapPoint(ctx context.Context, site string, h *HeatMapPoint) (*HeatMapPoint, error)
+	// UpdateSpatialRecord updates a resource
+	UpdateSpatialRecord(ctx context.Context, site string, s *SpatialRecord) (*SpatialRecord, error)
+}
+
+// mapsClient forwards the Maps group to the flat client methods.
+type mapsClient struct{ c *client }
+
+var _ MapsClient = mapsClient{}
+
+// Maps returns the Maps resource group.
+func (c *client) Maps() MapsClient {
+	return mapsClient{c}
+}
+
+func (g mapsClient) Create(ctx context.Context, site string, m *Map) (*Map, error) {
+	return g.c.CreateMap(ctx, site, m)
+}
+
+func (g mapsClient) CreateChannelPlan(ctx context.Context, site string, c *ChannelPlan) (*ChannelPlan, error) {
+	return g.c.CreateChannelPlan(ctx, site, c)
+}
+
+func (g mapsClient) CreateHeatMap(ctx context.Context, site string, h *HeatMap) (*HeatMap, error) {
+	return g.c.CreateHeatMap(ctx, site, h)
+}
+
+func (g mapsClient) CreateHeatMapPoint(ctx context.Context, site string, h *HeatMapPoint) (*HeatMapPoint, error) {
+	return g.c.CreateHeatMapPoint(ctx, site, h)
+}
+
+func (g mapsClient) CreateSpatialRecord(ctx context.Context, site string, s *SpatialRecord) (*SpatialRecord, error) {
+	return g.c.CreateSpatialRecord(ctx, site, s)
+}
+
+func (g mapsClient) Delete(ctx context.Context, site string, id string) error {
+	return g.c.DeleteMap(ctx, site, id)
+}
+
+func (g mapsClient) DeleteChannelPlan(ctx context.Context, site string, id string) error {
+	return g.c.DeleteChannelPlan(ctx, site, id)
+}
+
+func (g mapsClient) DeleteHeatMap(ctx context.Context, site string, id string) error {
+	return g.c.DeleteHeatMap(ctx, site, id)
+}
+
+func (g mapsClient) DeleteHeatMapPoint(ctx context.Context, site string, id string) error {
+	return g.c.DeleteHeatMapPoint(ctx, site, id)
+}
+
+func (g mapsClient) DeleteSpatialRecord(ctx context.Context, site string, id string) error {
+	return g.c.DeleteSpatialRecord(ctx, site, id)
+}
+
+func (g mapsClient) Get(ctx context.Context, site string, id string) (*Map, error) {
+	return g.c.GetMap(ctx, site, id)
+}
+
+func (g mapsClient) GetChannelPlan(ctx context.Context, site string, id string) (*ChannelPlan, error) {
+	return g.c.GetChannelPlan(ctx, site, id)
+}
+
+func (g mapsClient) GetChannelPlanInput(ctx context.Context, site string) (*ChannelPlanInput, error) {
+	return g.c.GetChannelPlanInput(ctx, site)
+}
+
+func (g mapsClient) GetHeatMap(ctx context.Context, site string, id string) (*HeatMap, error) {
+	return g.c.GetHeatMap(ctx, site, id)
+}
+
+func (g mapsClient) GetHeatMapPoint(ctx context.Context, site string, id string) (*HeatMapPoint, error) {
+	return g.c.GetHeatMapPoint(ctx, site, id)
+}
+
+func (g mapsClient) GetSpatialRecord(ctx context.Context, site string, id string) (*SpatialRecord, error) {
+	return g.c.GetSpatialRecord(ctx, site, id)
+}
+
+func (g mapsClient) List(ctx context.Context, site string) ([]Map, error) {
+	return g.c.ListMap(ctx, site)
+}
+
+func (g mapsClient) ListChannelPlan(ctx context.Context, site string) ([]ChannelPlan, error) {
+	return g.c.ListChannelPlan(ctx, site)
+}
+
+func (g mapsClient) ListHeatMap(ctx context.Context, site string) ([]HeatMap, error) {
+	return g.c.ListHeatMap(ctx, site)
+}
+
+func (g mapsClient) ListHeatMapPoint(ctx context.Context, site string) ([]HeatMapPoint, error) {
+	return g.c.ListHeatMapPoint(ctx, site)
+}
+
+func (g mapsClient) ListSpatialRecord(ctx context.Context, site string) ([]SpatialRecord, error) {
+	return g.c.ListSpatialRecord(ctx, site)
+}
+
+func (g mapsClient) Update(ctx context.Context, site string, m *Map) (*Map, error) {
+	return g.c.UpdateMap(ctx, site, m)
+}
+
+func (g mapsClient) UpdateChannelPlan(ctx context.Context, site string, c *ChannelPlan) (*ChannelPlan, error) {
+	return g.c.UpdateChannelPlan(ctx, site, c)
+}
+
+func (g mapsClient) UpdateHeatMap(ctx context.Context, site string, h *HeatMap) (*HeatMap, error) {
+	return g.c.UpdateHeatMap(ctx, site, h)
+}
+
+func (g mapsClient) UpdateHeatMapPoint(ctx context.Context, site string, h *HeatMapPoint) (*HeatMapPoint, error) {
+	return g.c.UpdateHeatMapPoint(ctx, site, h)
+}
+
+func (g mapsClient) UpdateSpatialRecord(ctx context.Context, site string, s *SpatialRecord) (*SpatialRecord, error) {
+	return g.c.UpdateSpatialRecord(ctx, site, s)
+}
+
+// MapsClientMock is a func-field test double implementing MapsClient. A nil
+// field panics on call, surfacing an un-stubbed method in tests.
+type MapsClientMock struct {
+	CreateFunc              func(context.Context, string, *Map) (*Map, error)
+	CreateChannelPlanFunc   func(context.Context, string, *ChannelPlan) (*ChannelPlan, error)
+	CreateHeatMapFunc       func(context.Context, string, *HeatMap) (*HeatMap, error)
+	CreateHeatMapPointFunc  func(context.Context, string, *HeatMapPoint) (*HeatMapPoint, error)
+	CreateSpatialRecordFunc func(context.Context, string, *SpatialRecord) (*SpatialRecord, error)
+	DeleteFunc              func(context.Context, string, string) error
+	DeleteChannelPlanFunc   func(context.Context, string, string) error
+	DeleteHeatMapFunc       func(context.Context, string, string) error
+	DeleteHeatMapPointFunc  func(context.Context, string, string) error
+	DeleteSpatialRecordFunc func(context.Context, string, string) error
+	GetFunc                 func(context.Context, string, string) (*Map, error)
+	GetChannelPlanFunc      func(context.Context, string, string) (*ChannelPlan, error)
+	GetChannelPlanInputFunc func(context.Context, string) (*ChannelPlanInput, error)
+	GetHeatMapFunc          func(context.Context, string, string) (*HeatMap, error)
+	GetHeatMapPointFunc     func(context.Context, string, string) (*HeatMapPoint, error)
+	GetSpatialRecordFunc    func(context.Context, string, string) (*SpatialRecord, error)
+	ListFunc                func(context.Context, string) ([]Map, error)
+	ListChannelPlanFunc     func(context.Context, string) ([]ChannelPlan, error)
+	ListHeatMapFunc         func(context.Context, string) ([]HeatMap, error)
+	ListHeatMapPointFunc    func(context.Context, string) ([]HeatMapPoint, error)
+	ListSpatialRecordFunc   func(context.Context, string) ([]SpatialRecord, error)
+	UpdateFunc              func(context.Context, string, *Map) (*Map, error)
+	UpdateChannelPlanFunc   func(context.Context, string, *ChannelPlan) (*ChannelPlan, error)
+	UpdateHeatMapFunc       func(context.Context, string, *HeatMap) (*HeatMap, error)
+	UpdateHeatMapPointFunc  func(context.Context, string, *HeatMapPoint) (*HeatMapPoint, error)
+	UpdateSpatialRecordFunc func(context.Context, string, *SpatialRecord) (*SpatialRecord, error)
+}
+
+var _ MapsClient = (*MapsClientMock)(nil)
+
+func (mock *MapsClientMock) Create(ctx context.Context, site string, m *Map) (*Map, error) {
+	return mock.CreateFunc(ctx, site, m)
+}
+
+func (mock *MapsClientMock) CreateChannelPlan(ctx context.Context, site string, c *ChannelPlan) (*ChannelPlan, error) {
+	return mock.CreateChannelPlanFunc(ctx, site, c)
+}
+
+func (mock *MapsClientMock) CreateHeatMap(ctx context.Context, site string, h *HeatMap) (*HeatMap, error) {
+	return mock.CreateHeatMapFunc(ctx, site, h)
+}
+
+func (mock *MapsClientMock) CreateHeatMapPoint(ctx context.Context, site string, h *HeatMapPoint) (*HeatMapPoint, error) {
+	return mock.CreateHeatMapPointFunc(ctx, site, h)
+}
+
+func (mock *MapsClientMock) CreateSpatialRecord(ctx context.Context, site string, s *SpatialRecord) (*SpatialRecord, error) {
+	return mock.CreateSpatialRecordFunc(ctx, site, s)
+}
+
+func (mock *MapsClientMock) Delete(ctx context.Context, site string, id string) error {
+	return mock.DeleteFunc(ctx, site, id)
+}
+
+func (mock *MapsClientMock) DeleteChannelPlan(ctx context.Context, site string, id string) error {
+	return mock.DeleteChannelPlanFunc(ctx, site, id)
+}
+
+func (mock *MapsClientMock) DeleteHeatMap(ctx context.Context, site string, id string) error {
+	return mock.DeleteHeatMapFunc(ctx, site, id)
+}
+
+func (mock *MapsClientMock) DeleteHeatMapPoint(ctx context.Context, site string, id string) error {
+	return mock.DeleteHeatMapPointFunc(ctx, site, id)
+}
+
+func (mock *MapsClientMock) DeleteSpatialRecord(ctx context.Context, site string, id string) error {
+	return mock.DeleteSpatialRecordFunc(ctx, site, id)
+}
+
+func (mock *MapsClientMock) Get(ctx context.Context, site string, id string) (*Map, error) {
+	return mock.GetFunc(ctx, site, id)
+}
+
+func (mock *MapsClientMock) GetChannelPlan(ctx context.Context, site string, id string) (*ChannelPlan, error) {
+	return mock.GetChannelPlanFunc(ctx, site, id)
+}
+
+func (mock *MapsClientMock) GetChannelPlanInput(ctx context.Context, site string) (*ChannelPlanInput, error) {
+	return mock.GetChannelPlanInputFunc(ctx, site)
+}
+
+func (mock *MapsClientMock) GetHeatMap(ctx context.Context, site string, id string) (*HeatMap, error) {
+	return mock.GetHeatMapFunc(ctx, site, id)
+}
+
+func (mock *MapsClientMock) GetHeatMapPoint(ctx context.Context, site string, id string) (*HeatMapPoint, error) {
+	return mock.GetHeatMapPointFunc(ctx, site, id)
+}
+
+func (mock *MapsClientMock) GetSpatialRecord(ctx context.Context, site string, id string) (*SpatialRecord, error) {
+	return mock.GetSpatialRecordFunc(ctx, site, id)
+}
+
+func (mock *MapsClientMock) List(ctx context.Context, site string) ([]Map, error) {
+	return mock.ListFunc(ctx, site)
+}
+
+func (mock *MapsClientMock) ListChannelPlan(ctx context.Context, site string) ([]ChannelPlan, error) {
+	return mock.ListChannelPlanFunc(ctx, site)
+}
+
+func (mock *MapsClientMock) ListHeatMap(ctx context.Context, site string) ([]HeatMap, error) {
+	return mock.ListHeatMapFunc(ctx, site)
+}
+
+func (mock *MapsClientMock) ListHeatMapPoint(ctx context.Context, site string) ([]HeatMapPoint, error) {
+	return mock.ListHeatMapPointFunc(ctx, site)
+}
+
+func (mock *MapsClientMock) ListSpatialRecord(ctx context.Context, site string) ([]SpatialRecord, error) {
+	return mock.ListSpatialRecordFunc(ctx, site)
+}
+
+func (mock *MapsClientMock) Update(ctx context.Context, site string, m *Map) (*Map, error) {
+	return mock.UpdateFunc(ctx, site, m)
+}
+
+func (mock *MapsClientMock) UpdateChannelPlan(ctx context.Context, site string, c *ChannelPlan) (*ChannelPlan, error) {
+	return mock.UpdateChannelPlanFunc(ctx, site, c)
+}
+
+func (mock *MapsClientMock) UpdateHeatMap(ctx context.Context, site string, h *HeatMap) (*HeatMap, error) {
+	return mock.UpdateHeatMapFunc(ctx, site, h)
+}
+
+func (mock *MapsClientMock) UpdateHeatMapPoint(ctx context.Context, site string, h *HeatMapPoint) (*HeatMapPoint, error) {
+	return mock.UpdateHeatMapPointFunc(ctx, site, h)
+}
+
+func (mock *MapsClientMock) UpdateSpatialRecord(ctx context.Context, site string, s *SpatialRecord) (*SpatialRecord, error) {
+	return mock.UpdateSpatialRecordFunc(ctx, site, s)
+}
+
+// NetworksClient is the Networks resource group of the legacy ("Internal") UniFi
+// Network API surface.
+type NetworksClient interface {
+	// Create creates a resource
+	Create(ctx context.Context, site string, n *Network) (*Network, error)
+	// CreateDHCPOption creates a resource
+	CreateDHCPOption(ctx context.Context, site string, d *DHCPOption) (*DHCPOption, error)
+	// CreateDynamicDNS creates a resource
+	CreateDynamicDNS(ctx context.Context, site string, d *DynamicDNS) (*DynamicDNS, error)
+	// CreatePortProfile creates a resource
+	CreatePortProfile(ctx context.Context, site string, p *PortProfile) (*PortProfile, error)
+	// CreateRoute creates a resource
+	CreateRoute(ctx context.Context, site string, r *Routing) (*Routing, error)
+	// Delete deletes a resource
+	Delete(ctx context.Context, site string, id string) error
+	// DeleteDHCPOption deletes a resource
+	DeleteDHCPOption(ctx context.Context, site string, id string) error
+	// DeleteDynamicDNS deletes a resource
+	DeleteDynamicDNS(ctx context.Context, site string, id string) error
+	// DeletePortProfile deletes a resource
+	DeletePortProfile(ctx context.Context, site string, id string) error
+	// DeleteRoute deletes a resource
+	DeleteRoute(ctx context.Context, site string, id string) error
+	// Get retrieves a resource
+	Get(ctx context.Context, site string, id string) (*Network, error)
+	// GetDHCPOption retrieves a resource
+	GetDHCPOption(ctx context.Context, site string, id string) (*DHCPOption, error)
+	// GetDynamicDNS retrieves a resource
+	GetDynamicDNS(ctx context.Context, site string, id string) (*DynamicDNS, error)
+	// GetPortProfile retrieves a resource
+	GetPortProfile(ctx context.Context, site string, id string) (*PortProfile, error)
+	// GetRoute retrieves a resource
+	GetRoute(ctx context.Context, site string, id string) (*Routing, error)
+	// List lists the resources
+	List(ctx context.Context, site string) ([]Network, error)
+	// ListDHCPOption lists the resources
+	ListDHCPOption(ctx context.Context, site string) ([]DHCPOption, error)
+	// ListDynamicDNS lists the resources
+	ListDynamicDNS(ctx context.Context, site string) ([]DynamicDNS, error)
+	// ListPortProfile lists the resources
+	ListPortProfile(ctx context.Context, site string) ([]PortProfile, error)
+	// ListRoute lists the resources
+	ListRoute(ctx context.Context, site string) ([]Routing, error)
+	// Update updates a resource
+	Update(ctx context.Context, site string, n *Network) (*Network, error)
+	// UpdateDHCPOption updates a resource
+	UpdateDHCPOption(ctx context.Context, site string, d *DHCPOption) (*DHCPOption, error)
+	// UpdateDynamicDNS updates a resource
+	UpdateDynamicDNS(ctx context.Context, site string, d *DynamicDNS) (*DynamicDNS, error)
+	// UpdatePortProfile updates a resource
+	UpdatePortProfile(ctx context.Context, site string, p *PortProfile) (*PortProfile, error)
+	// UpdateRoute updates a resource
+	UpdateRoute(ctx context.Context, site string, r *Routing) (*Routing, error)
+}
+
+// networksClient forwards the Networks group to the flat client methods.
+type networksClient struct{ c *client }
+
+var _ NetworksClient = networksClient{}
+
+// Networks returns the Networks resource group.
+func (c *client) Networks() NetworksClient {
+	return networksClient{c}
+}
+
+func (g networksClient) Create(ctx context.Context, site string, n *Network) (*Network, error) {
+	return g.c.CreateNetwork(ctx, site, n)
+}
+
+func (g networksClient) CreateDHCPOption(ctx context.Context, site string, d *DHCPOption) (*DHCPOption, error) {
+	return g.c.CreateDHCPOption(ctx, site, d)
+}
+
+func (g networksClient) CreateDynamicDNS(ctx context.Context, site string, d *DynamicDNS) (*DynamicDNS, error) {
+	return g.c.CreateDynamicDNS(ctx, site, d)
+}
+
+func (g networksClient) CreatePortProfile(ctx context.Context, site string, p *PortProfile) (*PortProfile, error) {
+	return g.c.CreatePortProfile(ctx, site, p)
+}
+
+func (g networksClient) CreateRoute(ctx context.Context, site string, r *Routing) (*Routing, error) {
+	return g.c.CreateRouting(ctx, site, r)
+}
+
+func (g networksClient) Delete(ctx context.Context, site string, id string) error {
+	return g.c.DeleteNetwork(ctx, site, id)
+}
+
+func (g networksClient) DeleteDHCPOption(ctx context.Context, site string, id string) error {
+	return g.c.DeleteDHCPOption(ctx, site, id)
+}
+
+func (g networksClient) DeleteDynamicDNS(ctx context.Context, site string, id string) error {
+	return g.c.DeleteDynamicDNS(ctx, site, id)
+}
+
+func (g networksClient) DeletePortProfile(ctx context.Context, site string, id string) error {
+	return g.c.DeletePortProfile(ctx, site, id)
+}
+
+func (g networksClient) DeleteRoute(ctx context.Context, site string, id string) error {
+	return g.c.DeleteRouting(ctx, site, id)
+}
+
+func (g networksClient) Get(ctx context.Context, site string, id string) (*Network, error) {
+	return g.c.GetNetwork(ctx, site, id)
+}
+
+func (g networksClient) GetDHCPOption(ctx context.Context, site string, id string) (*DHCPOption, error) {
+	return g.c.GetDHCPOption(ctx, site, id)
+}
+
+func (g networksClient) GetDynamicDNS(ctx context.Context, site string, id string) (*DynamicDNS, error) {
+	return g.c.GetDynamicDNS(ctx, site, id)
+}
+
+func (g networksClient) GetPortProfile(ctx context.Context, site string, id string) (*PortProfile, error) {
+	return g.c.GetPortProfile(ctx, site, id)
+}
+
+func (g networksClient) GetRoute(ctx context.Context, site string, id string) (*Routing, error) {
+	return g.c.GetRouting(ctx, site, id)
+}
+
+func (g networksClient) List(ctx context.Context, site string) ([]Network, error) {
+	return g.c.ListNetwork(ctx, site)
+}
+
+func (g networksClient) ListDHCPOption(ctx context.Context, site string) ([]DHCPOption, error) {
+	return g.c.ListDHCPOption(ctx, site)
+}
+
+func (g networksClient) ListDynamicDNS(ctx context.Context, site string) ([]DynamicDNS, error) {
+	return g.c.ListDynamicDNS(ctx, site)
+}
+
+func (g networksClient) ListPortProfile(ctx context.Context, site string) ([]PortProfile, error) {
+	return g.c.ListPortProfile(ctx, site)
+}
+
+func (g networksClient) ListRoute(ctx context.Context, site string) ([]Routing, error) {
+	return g.c.ListRouting(ctx, site)
+}
+
+func (g networksClient) Update(ctx context.Context, site string, n *Network) (*Network, error) {
+	return g.c.UpdateNetwork(ctx, site, n)
+}
+
+func (g networksClient) UpdateDHCPOption(ctx context.Context, site string, d *DHCPOption) (*DHCPOption, error) {
+	return g.c.UpdateDHCPOption(ctx, site, d)
+}
+
+func (g networksClient) UpdateDynamicDNS(ctx context.Context, site string, d *DynamicDNS) (*DynamicDNS, error) {
+	return g.c.UpdateDynamicDNS(ctx, site, d)
+}
+
+func (g networksClient) UpdatePortProfile(ctx context.Context, site string, p *PortProfile) (*PortProfile, error) {
+	return g.c.UpdatePortProfile(ctx, site, p)
+}
+
+func (g networksClient) UpdateRoute(ctx context.Context, site string, r *Routing) (*Routing, error) {
+	return g.c.UpdateRouting(ctx, site, r)
+}
+
+// NetworksClientMock is a func-field test double implementing NetworksClient. A nil
+// field panics on call, surfacing an un-stubbed method in tests.
+type NetworksClientMock struct {
+	CreateFunc            func(context.Context, string, *Network) (*Network, error)
+	CreateDHCPOptionFunc  func(context.Context, string, *DHCPOption) (*DHCPOption, error)
+	CreateDynamicDNSFunc  func(context.Context, string, *DynamicDNS) (*DynamicDNS, error)
+	CreatePortProfileFunc func(context.Context, string, *PortProfile) (*PortProfile, error)
+	CreateRouteFunc       func(context.Context, string, *Routing) (*Routing, error)
+	DeleteFunc            func(context.Context, string, string) error
+	DeleteDHCPOptionFunc  func(context.Context, string, string) error
+	DeleteDynamicDNSFunc  func(context.Context, string, string) error
+	DeletePortProfileFunc func(context.Context, string, string) error
+	DeleteRouteFunc       func(context.Context, string, string) error
+	GetFunc               func(context.Context, string, string) (*Network, error)
+	GetDHCPOptionFunc     func(context.Context, string, string) (*DHCPOption, error)
+	GetDynamicDNSFunc     func(context.Context, string, string) (*DynamicDNS, error)
+	GetPortProfileFunc    func(context.Context, string, string) (*PortProfile, error)
+	GetRouteFunc          func(context.Context, string, string) (*Routing, error)
+	ListFunc              func(context.Context, string) ([]Network, error)
+	ListDHCPOptionFunc    func(context.Context, string) ([]DHCPOption, error)
+	ListDynamicDNSFunc    func(context.Context, string) ([]DynamicDNS, error)
+	ListPortProfileFunc   func(context.Context, string) ([]PortProfile, error)
+	ListRouteFunc         func(context.Context, string) ([]Routing, error)
+	UpdateFunc            func(context.Context, string, *Network) (*Network, error)
+	UpdateDHCPOptionFunc  func(context.Context, string, *DHCPOption) (*DHCPOption, error)
+	UpdateDynamicDNSFunc  func(context.Context, string, *DynamicDNS) (*DynamicDNS, error)
+	UpdatePortProfileFunc func(context.Context, string, *PortProfile) (*PortProfile, error)
+	UpdateRouteFunc       func(context.Context, string, *Routing) (*Routing, error)
+}
+
+var _ NetworksClient = (*NetworksClientMock)(nil)
+
+func (mock *NetworksClientMock) Create(ctx context.Context, site string, n *Network) (*Network, error) {
+	return mock.CreateFunc(ctx, site, n)
+}
+
+func (mock *NetworksClientMock) CreateDHCPOption(ctx context.Context, site string, d *DHCPOption) (*DHCPOption, error) {
+	return mock.CreateDHCPOptionFunc(ctx, site, d)
+}
+
+func (mock *NetworksClientMock) CreateDynamicDNS(ctx context.Context, site string, d *DynamicDNS) (*DynamicDNS, error) {
+	return mock.CreateDynamicDNSFunc(ctx, site, d)
+}
+
+func (mock *NetworksClientMock) CreatePortProfile(ctx context.Context, site string, p *PortProfile) (*PortProfile, error) {
+	return mock.CreatePortProfileFunc(ctx, site, p)
+}
+
+func (mock *NetworksClientMock) CreateRoute(ctx context.Context, site string, r *Routing) (*Routing, error) {
+	return mock.CreateRouteFunc(ctx, site, r)
+}
+
+func (mock *NetworksClientMock) Delete(ctx context.Context, site string, id string) error {
+	return mock.DeleteFunc(ctx, site, id)
+}
+
+func (mock *NetworksClientMock) DeleteDHCPOption(ctx context.Context, site string, id string) error {
+	return mock.DeleteDHCPOptionFunc(ctx, site, id)
+}
+
+func (mock *NetworksClientMock) DeleteDynamicDNS(ctx context.Context, site string, id string) error {
+	return mock.DeleteDynamicDNSFunc(ctx, site, id)
+}
+
+func (mock *NetworksClientMock) DeletePortProfile(ctx context.Context, site string, id string) error {
+	return mock.DeletePortProfileFunc(ctx, site, id)
+}
+
+func (mock *NetworksClientMock) DeleteRoute(ctx context.Context, site string, id string) error {
+	return mock.DeleteRouteFunc(ctx, site, id)
+}
+
+func (mock *NetworksClientMock) Get(ctx context.Context, site string, id string) (*Network, error) {
+	return mock.GetFunc(ctx, site, id)
+}
+
+func (mock *NetworksClientMock) GetDHCPOption(ctx context.Context, site string, id string) (*DHCPOption, error) {
+	return mock.GetDHCPOptionFunc(ctx, site, id)
+}
+
+func (mock *NetworksClientMock) GetDynamicDNS(ctx context.Context, site string, id string) (*DynamicDNS, error) {
+	return mock.GetDynamicDNSFunc(ctx, site, id)
+}
+
+func (mock *NetworksClientMock) GetPortProfile(ctx context.Context, site string, id string) (*PortProfile, error) {
+	return mock.GetPortProfileFunc(ctx, site, id)
+}
+
+func (mock *NetworksClientMock) GetRoute(ctx context.Context, site string, id string) (*Routing, error) {
+	return mock.GetRouteFunc(ctx, site, id)
+}
+
+func (mock *NetworksClientMock) List(ctx context.Context, site string) ([]Network, error) {
+	return mock.ListFunc(ctx, site)
+}
+
+func (mock *NetworksClientMock) ListDHCPOption(ctx context.Context, site string) ([]DHCPOption, error) {
+	return mock.ListDHCPOptionFunc(ctx, site)
+}
+
+func (mock *NetworksClientMock) ListDynamicDNS(ctx context.Context, site string) ([]DynamicDNS, error) {
+	return mock.ListDynamicDNSFunc(ctx, site)
+}
+
+func (mock *NetworksClientMock) ListPortProfile(ctx context.Context, site string) ([]PortProfile, error) {
+	return mock.ListPortProfileFunc(ctx, site)
+}
+
+func (mock *NetworksClientMock) ListRoute(ctx context.Context, site string) ([]Routing, error) {
+	return mock.ListRouteFunc(ctx, site)
+}
+
+func (mock *NetworksClientMock) Update(ctx context.Context, site string, n *Network) (*Network, error) {
+	return mock.UpdateFunc(ctx, site, n)
+}
+
+func (mock *NetworksClientMock) UpdateDHCPOption(ctx context.Context, site string, d *DHCPOption) (*DHCPOption, error) {
+	return mock.UpdateDHCPOptionFunc(ctx, site, d)
+}
+
+func (mock *NetworksClientMock) UpdateDynamicDNS(ctx context.Context, site string, d *DynamicDNS) (*DynamicDNS, error) {
+	return mock.UpdateDynamicDNSFunc(ctx, site, d)
+}
+
+func (mock *NetworksClientMock) UpdatePortProfile(ctx context.Context, site string, p *PortProfile) (*PortProfile, error) {
+	return mock.UpdatePortProfileFunc(ctx, site, p)
+}
+
+func (mock *NetworksClientMock) UpdateRoute(ctx context.Context, site string, r *Routing) (*Routing, error) {
+	return mock.UpdateRouteFunc(ctx, site, r)
+}
+
+// RADIUSClient is the RADIUS resource group of the legacy ("Internal") UniFi
+// Network API surface.
+type RADIUSClient interface {
+	// CreateAccount creates a resource
+	CreateAccount(ctx context.Context, site string, a *Account) (*Account, error)
+	// CreateProfile creates a resource
+	CreateProfile(ctx context.Context, site string, r *RADIUSProfile) (*RADIUSProfile, error)
+	// DeleteAccount deletes a resource
+	DeleteAccount(ctx context.Context, site string, id string) error
+	// DeleteProfile deletes a resource
+	DeleteProfile(ctx context.Context, site string, id string) error
+	// GetAccount retrieves a resource
+	GetAccount(ctx context.Context, site string, id string) (*Account, error)
+	// GetProfile retrieves a resource
+	GetProfile(ctx context.Context, site string, id string) (*RADIUSProfile, error)
+	// ListAccount lists the resources
+	ListAccount(ctx context.Context, site string) ([]Account, error)
+	// ListProfile lists the resources
+	ListProfile(ctx context.Context, site string) ([]RADIUSProfile, error)
+	// UpdateAccount updates a resource
+	UpdateAccount(ctx context.Context, site string, a *Account) (*Account, error)
+	// UpdateProfile updates a resource
+	UpdateProfile(ctx context.Context, site string, r *RADIUSProfile) (*RADIUSProfile, error)
+}
+
+// radiusClient forwards the RADIUS group to the flat client methods.
+type radiusClient struct{ c *client }
+
+var _ RADIUSClient = radiusClient{}
+
+// RADIUS returns the RADIUS resource group.
+func (c *client) RADIUS() RADIUSClient {
+	return radiusClient{c}
+}
+
+func (g radiusClient) CreateAccount(ctx context.Context, site string, a *Account) (*Account, error) {
+	return g.c.CreateAccount(ctx, site, a)
+}
+
+func (g radiusClient) CreateProfile(ctx context.Context, site string, r *RADIUSProfile) (*RADIUSProfile, error) {
+	return g.c.CreateRADIUSProfile(ctx, site, r)
+}
+
+func (g radiusClient) DeleteAccount(ctx context.Context, site string, id string) error {
+	return g.c.DeleteAccount(ctx, site, id)
+}
+
+func (g radiusClient) DeleteProfile(ctx context.Context, site string, id string) error {
+	return g.c.DeleteRADIUSProfile(ctx, site, id)
+}
+
+func (g radiusClient) GetAccount(ctx context.Context, site string, id string) (*Account, error) {
+	return g.c.GetAccount(ctx, site, id)
+}
+
+func (g radiusClient) GetProfile(ctx context.Context, site string, id string) (*RADIUSProfile, error) {
+	return g.c.GetRADIUSProfile(ctx, site, id)
+}
+
+func (g radiusClient) ListAccount(ctx context.Context, site string) ([]Account, error) {
+	return g.c.ListAccount(ctx, site)
+}
+
+func (g radiusClient) ListProfile(ctx context.Context, site string) ([]RADIUSProfile, error) {
+	return g.c.ListRADIUSProfile(ctx, site)
+}
+
+func (g radiusClient) UpdateAccount(ctx context.Context, site string, a *Account) (*Account, error) {
+	return g.c.UpdateAccount(ctx, site, a)
+}
+
+func (g radiusClient) UpdateProfile(ctx context.Context, site string, r *RADIUSProfile) (*RADIUSProfile, error) {
+	return g.c.UpdateRADIUSProfile(ctx, site, r)
+}
+
+// RADIUSClientMock is a func-field test double implementing RADIUSClient. A nil
+// field panics on call, surfacing an un-stubbed method in tests.
+type RADIUSClientMock struct {
+	CreateAccountFunc func(context.Context, string, *Account) (*Account, error)
+	CreateProfileFunc func(context.Context, string, *RADIUSProfile) (*RADIUSProfile, error)
+	DeleteAccountFunc func(context.Context, string, string) error
+	DeleteProfileFunc func(context.Context, string, string) error
+	GetAccountFunc    func(context.Context, string, string) (*Account, error)
+	GetProfileFunc    func(context.Context, string, string) (*RADIUSProfile, error)
+	ListAccountFunc   func(context.Context, string) ([]Account, error)
+	ListProfileFunc   func(context.Context, string) ([]RADIUSProfile, error)
+	UpdateAccountFunc func(context.Context, string, *Account) (*Account, error)
+	UpdateProfileFunc func(context.Context, string, *RADIUSProfile) (*RADIUSProfile, error)
+}
+
+var _ RADIUSClient = (*RADIUSClientMock)(nil)
+
+func (mock *RADIUSClientMock) CreateAccount(ctx context.Context, site string, a *Account) (*Account, error) {
+	return mock.CreateAccountFunc(ctx, site, a)
+}
+
+func (mock *RADIUSClientMock) CreateProfile(ctx context.Context, site string, r *RADIUSProfile) (*RADIUSProfile, error) {
+	return mock.CreateProfileFunc(ctx, site, r)
+}
+
+func (mock *RADIUSClientMock) DeleteAccount(ctx context.Context, site string, id string) error {
+	return mock.DeleteAccountFunc(ctx, site, id)
+}
+
+func (mock *RADIUSClientMock) DeleteProfile(ctx context.Context, site string, id string) error {
+	return mock.DeleteProfileFunc(ctx, site, id)
+}
+
+func (mock *RADIUSClientMock) GetAccount(ctx context.Context, site string, id string) (*Account, error) {
+	return mock.GetAccountFunc(ctx, site, id)
+}
+
+func (mock *RADIUSClientMock) GetProfile(ctx context.Context, site string, id string) (*RADIUSProfile, error) {
+	return mock.GetProfileFunc(ctx, site, id)
+}
+
+func (mock *RADIUSClientMock) ListAccount(ctx context.Context, site string) ([]Account, error) {
+	return mock.ListAccountFunc(ctx, site)
+}
+
+func (mock *RADIUSClientMock) ListProfile(ctx context.Context, site string) ([]RADIUSProfile, error) {
+	return mock.ListProfileFunc(ctx, site)
+}
+
+func (mock *RADIUSClientMock) UpdateAccount(ctx context.Context, site string, a *Account) (*Account, error) {
+	return mock.UpdateAccountFunc(ctx, site, a)
+}
+
+func (mock *RADIUSClientMock) UpdateProfile(ctx context.Context, site string, r *RADIUSProfile) (*RADIUSProfile, error) {
+	return mock.UpdateProfileFunc(ctx, site, r)
+}
+
+// ReportsClient is the Reports resource group of the legacy ("Internal") UniFi
+// Network API surface.
+type ReportsClient interface {
+	// Get returns the historical report of kind over [from, to] at interval granularity.
+	Get(ctx context.Context, site string, interval ReportInterval, kind ReportKind, from time.Time, to time.Time, opts ...ReportOption) (*Report, error)
+}
+
+// reportsClient forwards the Reports group to the flat client methods.
+type reportsClient struct{ c *client }
+
+var _ ReportsClient = reportsClient{}
+
+// Reports returns the Reports resource group.
+func (c *client) Reports() ReportsClient {
+	return reportsClient{c}
+}
+
+func (g reportsClient) Get(ctx context.Context, site string, interval ReportInterval, kind ReportKind, from time.Time, to time.Time, opts ...ReportOption) (*Report, error) {
+	return g.c.GetReport(ctx, site, interval, kind, from, to, opts...)
+}
+
+// ReportsClientMock is a func-field test double implementing ReportsClient. A nil
+// field panics on call, surfacing an un-stubbed method in tests.
+type ReportsClientMock struct {
+	GetFunc func(context.Context, string, ReportInterval, ReportKind, time.Time, time.Time, ...ReportOption) (*Report, error)
+}
+
+var _ ReportsClient = (*ReportsClientMock)(nil)
+
+func (mock *ReportsClientMock) Get(ctx context.Context, site string, interval ReportInterval, kind ReportKind, from time.Time, to time.Time, opts ...ReportOption) (*Report, error) {
+	return mock.GetFunc(ctx, site, interval, kind, from, to, opts...)
+}
+
+// RogueAPsClient is the RogueAPs resource group of the legacy ("Internal") UniFi
+// Network API surface.
+type RogueAPsClient interface {
+	// List returns the neighboring APs the site's APs heard in the last withinHours hours, or in the controller's default window when withinHours <= 0.
+	List(ctx context.Context, site string, withinHours int) ([]RogueAP, error)
+	// ListKnown returns the neighboring APs marked as known.
+	ListKnown(ctx context.Context, site string) ([]KnownRogueAP, error)
+	// ListSSIDImpersonators returns the neighboring APs broadcasting one of the site's WLAN names from a BSSID that is not one of its devices.
+	ListSSIDImpersonators(ctx context.Context, site string, withinHours int) ([]RogueAP, error)
+	// MarkKnown marks the neighboring AP as known.
+	MarkKnown(ctx context.Context, site string, ap *RogueAP) (*KnownRogueAP, error)
+	// UnmarkKnown removes the known AP with the given ID, so it is flagged again.
+	UnmarkKnown(ctx context.Context, site string, id string) error
+}
+
+// rogueAPsClient forwards the RogueAPs group to the flat client methods.
+type rogueAPsClient struct{ c *client }
+
+var _ RogueAPsClient = rogueAPsClient{}
+
+// RogueAPs returns the RogueAPs resource group.
+func (c *client) RogueAPs() RogueAPsClient {
+	return rogueAPsClient{c}
+}
+
+func (g rogueAPsClient) List(ctx context.Context, site string, withinHours int) ([]RogueAP, error) {
+	return g.c.ListRogueAPs(ctx, site, withinHours)
+}
+
+func (g rogueAPsClient) ListKnown(ctx context.Context, site string) ([]KnownRogueAP, error) {
+	return g.c.ListKnownRogueAPs(ctx, site)
+}
+
+func (g rogueAPsClient) ListSSIDImpersonators(ctx context.Context, site string, withinHours int) ([]RogueAP, error) {
+	return g.c.ListSSIDImpersonators(ctx, site, withinHours)
+}
+
+func (g rogueAPsClient) MarkKnown(ctx context.Context, site string, ap *RogueAP) (*KnownRogueAP, error) {
+	return g.c.MarkRogueAPKnown(ctx, site, ap)
+}
+
+func (g rogueAPsClient) UnmarkKnown(ctx context.Context, site string, id string) error {
+	return g.c.UnmarkRogueAPKnown(ctx, site, id)
+}
+
+// RogueAPsClientMock is a func-field test double implementing RogueAPsClient. A nil
+// field panics on call, surfacing an un-stubbed method in tests.
+type RogueAPsClientMock struct {
+	ListFunc                  func(context.Context, string, int) ([]RogueAP, error)
+	ListKnownFunc             func(context.Context, string) ([]KnownRogueAP, error)
+	ListSSIDImpersonatorsFunc func(context.Context, string, int) ([]RogueAP, error)
+	MarkKnownFunc             func(context.Context, string, *RogueAP) (*KnownRogueAP, error)
+	UnmarkKnownFunc           func(context.Context, string, string) error
+}
+
+var _ RogueAPsClient = (*RogueAPsClientMock)(nil)
+
+func (mock *RogueAPsClientMock) List(ctx context.Context, site string, withinHours int) ([]RogueAP, error) {
+	return mock.ListFunc(ctx, site, withinHours)
+}
+
+func (mock *RogueAPsClientMock) ListKnown(ctx context.Context, site string) ([]KnownRogueAP, error) {
+	return mock.ListKnownFunc(ctx, site)
+}
+
+func (mock *RogueAPsClientMock) ListSSIDImpersonators(ctx context.Context, site string, withinHours int) ([]RogueAP, error) {
+	return mock.ListSSIDImpersonatorsFunc(ctx, site, withinHours)
+}
+
+func (mock *RogueAPsClientMock) MarkKnown(ctx context.Context, site string, ap *RogueAP) (*KnownRogueAP, error) {
+	return mock.MarkKnownFunc(ctx, site, ap)
+}
+
+func (mock *RogueAPsClientMock) UnmarkKnown(ctx context.Context, site string, id string) error {
+	return mock.UnmarkKnownFunc(ctx, site, id)
+}
+
+// SettingsClient is the Settings resource group of the legacy ("Internal") UniFi
+// Network API surface.
+type SettingsClient interface {
+	Get(ctx context.Context, site string, key string) (*Setting, any, error)
+	// GetAutoSpeedtest retrieves the settings for a resource
+	GetAutoSpeedtest(ctx context.Context, site string) (*SettingAutoSpeedtest, error)
+	// GetBaresip retrieves the settings for a resource
+	GetBaresip(ctx context.Context, site string) (*SettingBaresip, error)
+	// GetBroadcast retrieves the settings for a resource
+	GetBroadcast(ctx context.Context, site string) (*SettingBroadcast, error)
+	// GetConnectivity retrieves the settings for a resource
+	GetConnectivity(ctx context.Context, site string) (*SettingConnectivity, error)
+	// GetCountry retrieves the settings for a resource
+	GetCountry(ctx context.Context, site string) (*SettingCountry, error)
+	// GetDashboard retrieves the settings for a resource
+	GetDashboard(ctx context.Context, site string) (*SettingDashboard, error)
+	// GetDoh retrieves the settings for a resource
+	GetDoh(ctx context.Context, site string) (*SettingDoh, error)
+	// GetDpi retrieves the settings for a resource
+	GetDpi(ctx context.Context, site string) (*SettingDpi, error)
+	// GetElementAdopt retrieves the settings for a resource
+	GetElementAdopt(ctx context.Context, site string) (*SettingElementAdopt, error)
+	// GetEtherLighting retrieves the settings for a resource
+	GetEtherLighting(ctx context.Context, site string) (*SettingEtherLighting, error)
+	// GetEvaluationScore retrieves the settings for a resource
+	GetEvaluationScore(ctx context.Context, site string) (*SettingEvaluationScore, error)
+	// GetGlobalAp retrieves the settings for a resource
+	GetGlobalAp(ctx context.Context, site string) (*SettingGlobalAp, error)
+	// GetGlobalNat retrieves the settings for a resource
+	GetGlobalNat(ctx context.Context, site string) (*SettingGlobalNat, error)
+	// GetGlobalSwitch retrieves the settings for a resource
+	GetGlobalSwitch(ctx context.Context, site string) (*SettingGlobalSwitch, error)
+	// GetGuestAccess retrieves the settings for a resource
+	GetGuestAccess(ctx context.Context, site string) (*SettingGuestAccess, error)
+	// GetIps retrieves the settings for a resource
+	GetIps(ctx context.Context, site string) (*SettingIps, error)
+	// GetLcm retrieves the settings for a resource
+	GetLcm(ctx context.Context, site string) (*SettingLcm, error)
+	// GetLocale retrieves the settings for a resource
+	GetLocale(ctx context.Context, site string) (*SettingLocale, error)
+	// GetMagicSiteToSiteVpn retrieves the settings for a resource
+	GetMagicSiteToSiteVpn(ctx context.Context, site string) (*SettingMagicSiteToSiteVpn, error)
+	// GetMdns retrieves the settings for a resource
+	GetMdns(ctx context.Context, site string) (*SettingMdns, error)
+	// GetMgmt retrieves the settings for a resource
+	GetMgmt(ctx context.Context, site string) (*SettingMgmt, error)
+	// GetNetflow retrieves the settings for a resource
+	GetNetflow(ctx context.Context, site string) (*SettingNetflow, error)
+	// GetNetworkOptimization retrieves the settings for a resource
+	GetNetworkOptimization(ctx context.Context, site string) (*SettingNetworkOptimization, error)
+	// GetNtp retrieves the settings for a resource
+	GetNtp(ctx context.Context, site string) (*SettingNtp, error)
+	// GetPorta retrieves the settings for a resource
+	GetPorta(ctx context.Context, site string) (*SettingPorta, error)
+	// GetRadioAi retrieves the settings for a resource
+	GetRadioAi(ctx context.Context, site string) (*SettingRadioAi, error)
+	// GetRadius retrieves the settings for a resource
+	GetRadius(ctx context.Context, site string) (*SettingRadius, error)
+	// GetRoamingAssistant retrieves the settings for a resource
+	GetRoamingAssistant(ctx context.Context, site string) (*SettingRoamingAssistant, error)
+	// GetRsyslogd retrieves the settings for a resource
+	GetRsyslogd(ctx context.Context, site string) (*SettingRsyslogd, error)
+	// GetSnmp retrieves the settings for a resource
+	GetSnmp(ctx context.Context, site string) (*SettingSnmp, error)
+	// GetSslInspection retrieves the settings for a resource
+	GetSslInspection(ctx context.Context, site string) (*SettingSslInspection, error)
+	// GetSuperCloudaccess retrieves the settings for a resource
+	GetSuperCloudaccess(ctx context.Context, site string) (*SettingSuperCloudaccess, error)
+	// GetSuperEvents retrieves the settings for a resource
+	GetSuperEvents(ctx context.Context, site string) (*SettingSuperEvents, error)
+	// GetSuperFwupdate retrieves the settings for a resource
+	GetSuperFwupdate(ctx context.Context, site string) (*SettingSuperFwupdate, error)
+	// GetSuperIdentity retrieves the settings for a resource
+	GetSuperIdentity(ctx context.Context, site string) (*SettingSuperIdentity, error)
+	// GetSuperMail retrieves the settings for a resource
+	GetSuperMail(ctx context.Context, site string) (*SettingSuperMail, error)
+	// GetSuperMgmt retrieves the settings for a resource
+	GetSuperMgmt(ctx context.Context, site string) (*SettingSuperMgmt, error)
+	// GetSuperSdn retrieves the settings for a resource
+	GetSuperSdn(ctx context.Context, site string) (*SettingSuperSdn, error)
+	// GetSuperSmtp retrieves the settings for a resource
+	GetSuperSmtp(ctx context.Context, site string) (*SettingSuperSmtp, error)
+	// GetTeleport retrieves the settings for a resource
+	GetTeleport(ctx context.Context, site string) (*SettingTeleport, error)
+	// GetTrafficFlow retrieves the settings for a resource
+	GetTrafficFlow(ctx context.Context, site string) (*SettingTrafficFlow, error)
+	// GetUsg retrieves the settings for a resource
+	GetUsg(ctx context.Context, site string) (*SettingUsg, error)
+	// GetUsw retrieves the settings for a resource
+	GetUsw(ctx context.Context, site string) (*SettingUsw, error)
+	Set(ctx context.Context, site string, key string, reqBody any) (any, error)
+	// UpdateAutoSpeedtest updates a resource
+	UpdateAutoSpeedtest(ctx context.Context, site string, s *SettingAutoSpeedtest) (*SettingAutoSpeedtest, error)
+	// UpdateBaresip updates a resource
+	UpdateBaresip(ctx context.Context, site string, s *SettingBaresip) (*SettingBaresip, error)
+	// UpdateBroadcast updates a resource
+	UpdateBroadcast(ctx context.Context, site string, s *SettingBroadcast) (*SettingBroadcast, error)
+	// UpdateConnectivity updates a resource
+	UpdateConnectivity(ctx context.Context, site string, s *SettingConnectivity) (*SettingConnectivity, error)
+	// UpdateCountry updates a resource
+	UpdateCountry(ctx context.Context, site string, s *SettingCountry) (*SettingCountry, error)
+	// UpdateDashboard updates a resource
+	UpdateDashboard(ctx context.Context, site string, s *SettingDashboard) (*SettingDashboard, error)
+	// UpdateDoh updates a resource
+	UpdateDoh(ctx context.Context, site string, s *SettingDoh) (*SettingDoh, error)
+	// UpdateDpi updates a resource
+	UpdateDpi(ctx context.Context, site string, s *SettingDpi) (*SettingDpi, error)
+	// UpdateElementAdopt updates a resource
+	UpdateElementAdopt(ctx context.Context, site string, s *SettingElementAdopt) (*SettingElementAdopt, error)
+	// UpdateEtherLighting updates a resource
+	UpdateEtherLighting(ctx context.Context, site string, s *SettingEtherLighting) (*SettingEtherLighting, error)
+	// UpdateEvaluationScore updates a resource
+	UpdateEvaluationScore(ctx context.Context, site string, s *SettingEvaluationScore) (*SettingEvaluationScore, error)
+	// UpdateGlobalAp updates a resource
+	UpdateGlobalAp(ctx context.Context, site string, s *SettingGlobalAp) (*SettingGlobalAp, error)
+	// UpdateGlobalNat updates a resource
+	UpdateGlobalNat(ctx context.Context, site string, s *SettingGlobalNat) (*SettingGlobalNat, error)
+	// UpdateGlobalSwitch updates a resource
+	UpdateGlobalSwitch(ctx context.Context, site string, s *SettingGlobalSwitch) (*SettingGlobalSwitch, error)
+	// UpdateGuestAccess updates a resource
+	UpdateGuestAccess(ctx context.Context, site string, s *SettingGuestAccess) (*SettingGuestAccess, error)
+	// UpdateIps updates a resource
+	UpdateIps(ctx context.Context, site string, s *SettingIps) (*SettingIps, error)
+	// UpdateLcm updates a resource
+	UpdateLcm(ctx context.Context, site string, s *SettingLcm) (*SettingLcm, error)
+	// UpdateLocale updates a resource
+	UpdateLocale(ctx context.Context, site string, s *SettingLocale) (*SettingLocale, error)
+	// UpdateMagicSiteToSiteVpn updates a resource
+	UpdateMagicSiteToSiteVpn(ctx context.Context, site string, s *SettingMagicSiteToSiteVpn) (*SettingMagicSiteToSiteVpn, error)
+	// UpdateMdns updates a resource
+	UpdateMdns(ctx context.Context, site string, s *SettingMdns) (*SettingMdns, error)
+	// UpdateMgmt updates a resource
+	UpdateMgmt(ctx context.Context, site string, s *SettingMgmt) (*SettingMgmt, error)
+	// UpdateNetflow updates a resource
+	UpdateNetflow(ctx context.Context, site string, s *SettingNetflow) (*SettingNetflow, error)
+	// UpdateNetworkOptimization updates a resource
+	UpdateNetworkOptimization(ctx context.Context, site string, s *SettingNetworkOptimization) (*SettingNetworkOptimization, error)
+	// UpdateNtp updates a resource
+	UpdateNtp(ctx context.Context, site string, s *SettingNtp) (*SettingNtp, error)
+	// UpdatePorta updates a resource
+	UpdatePorta(ctx context.Context, site string, s *SettingPorta) (*SettingPorta, error)
+	// UpdateRadioAi updates a resource
+	UpdateRadioAi(ctx context.Context, site string, s *SettingRadioAi) (*SettingRadioAi, error)
+	// UpdateRadius updates a resource
+	UpdateRadius(ctx context.Context, site string, s *SettingRadius) (*SettingRadius, error)
+	// UpdateRoamingAssistant updates a resource
+	UpdateRoamingAssistant(ctx context.Context, site string, s *SettingRoamingAssistant) (*SettingRoamingAssistant, error)
+	// UpdateRsyslogd updates a resource
+	UpdateRsyslogd(ctx context.Context, site string, s *SettingRsyslogd) (*SettingRsyslogd, error)
+	// UpdateSnmp updates a resource
+	UpdateSnmp(ctx context.Context, site string, s *SettingSnmp) (*SettingSnmp, error)
+	// UpdateSslInspection updates a resource
+	UpdateSslInspection(ctx context.Context, site string, s *SettingSslInspection) (*SettingSslInspection, error)
+	// UpdateSuperCloudaccess updates a resource
+	UpdateSuperCloudaccess(ctx context.Context, site string, s *SettingSuperCloudaccess) (*SettingSuperCloudaccess, error)
+	// UpdateSuperEvents updates a resource
+	UpdateSuperEvents(ctx context.Context, site string, s *SettingSuperEvents) (*SettingSuperEvents, error)
+	// UpdateSuperFwupdate updates a resource
+	UpdateSuperFwupdate(ctx context.Context, site string, s *SettingSuperFwupdate) (*SettingSuperFwupdate, error)
+	// UpdateSuperIdentity updates a resource
+	UpdateSuperIdentity(ctx context.Context, site string, s *SettingSuperIdentity) (*SettingSuperIdentity, error)
+	// UpdateSuperMail updates a resource
+	UpdateSuperMail(ctx context.Context, site string, s *SettingSuperMail) (*SettingSuperMail, error)
+	// UpdateSuperMgmt updates a resource
+	UpdateSuperMgmt(ctx context.Context, site string, s *SettingSuperMgmt) (*SettingSuperMgmt, error)
+	// UpdateSuperSdn updates a resource
+	UpdateSuperSdn(ctx context.Context, site string, s *SettingSuperSdn) (*SettingSuperSdn, error)
+	// UpdateSuperSmtp updates a resource
+	UpdateSuperSmtp(ctx context.Context, site string, s *SettingSuperSmtp) (*SettingSuperSmtp, error)
+	// UpdateTeleport updates a resource
+	UpdateTeleport(ctx context.Context, site string, s *SettingTeleport) (*SettingTeleport, error)
+	// UpdateTrafficFlow updates a resource
+	UpdateTrafficFlow(ctx context.Context, site string, s *SettingTrafficFlow) (*SettingTrafficFlow, error)
+	// UpdateUsg updates a resource
+	UpdateUsg(ctx context.Context, site string, s *SettingUsg) (*SettingUsg, error)
+	// UpdateUsw updates a resource
+	UpdateUsw(ctx context.Context, site string, s *SettingUsw) (*SettingUsw, error)
+}
+
+// settingsClient forwards the Settings group to the flat client methods.
+type settingsClient struct{ c *client }
+
+var _ SettingsClient = settingsClient{}
+
+// Settings returns the Settings resource group.
+func (c *client) Settings() SettingsClient {
+	return settingsClient{c}
+}
+
+func (g settingsClient) Get(ctx context.Context, site string, key string) (*Setting, any, error) {
+	return g.c.GetSetting(ctx, site, key)
+}
+
+func (g settingsClient) GetAutoSpeedtest(ctx context.Context, site string) (*SettingAutoSpeedtest, error) {
+	return g.c.GetSettingAutoSpeedtest(ctx, site)
+}
+
+func (g settingsClient) GetBaresip(ctx context.Context, site string) (*SettingBaresip, error) {
+	return g.c.GetSettingBaresip(ctx, site)
+}
+
+func (g settingsClient) GetBroadcast(ctx context.Context, site string) (*SettingBroadcast, error) {
+	return g.c.GetSettingBroadcast(ctx, site)
+}
+
+func (g settingsClient) GetConnectivity(ctx context.Context, site string) (*SettingConnectivity, error) {
+	return g.c.GetSettingConnectivity(ctx, site)
+}
+
+func (g settingsClient) GetCountry(ctx context.Context, site string) (*SettingCountry, error) {
+	return g.c.GetSettingCountry(ctx, site)
+}
+
+func (g settingsClient) GetDashboard(ctx context.Context, site string) (*SettingDashboard, error) {
+	return g.c.GetSettingDashboard(ctx, site)
+}
+
+func (g settingsClient) GetDoh(ctx context.Context, site string) (*SettingDoh, error) {
+	return g.c.GetSettingDoh(ctx, site)
+}
+
+func (g settingsClient) GetDpi(ctx context.Context, site string) (*SettingDpi, error) {
+	return g.c.GetSettingDpi(ctx, site)
+}
+
+func (g settingsClient) GetElementAdopt(ctx context.Context, site string) (*SettingElementAdopt, error) {
+	return g.c.GetSettingElementAdopt(ctx, site)
+}
+
+func (g settingsClient) GetEtherLighting(ctx context.Context, site string) (*SettingEtherLighting, error) {
+	return g.c.GetSettingEtherLighting(ctx, site)
+}
+
+func (g settingsClient) GetEvaluationScore(ctx context.Context, site string) (*SettingEvaluationScore, error) {
+	return g.c.GetSettingEvaluationScore(ctx, site)
+}
+
+func (g settingsClient) GetGlobalAp(ctx context.Context, site string) (*SettingGlobalAp, error) {
+	return g.c.GetSettingGlobalAp(ctx, site)
+}
+
+func (g settingsClient) GetGlobalNat(ctx context.Context, site string) (*SettingGlobalNat, error) {
+	return g.c.GetSettingGlobalNat(ctx, site)
+}
+
+func (g settingsClient) GetGlobalSwitch(ctx context.Context, site string) (*SettingGlobalSwitch, error) {
+	return g.c.GetSettingGlobalSwitch(ctx, site)
+}
+
+func (g settingsClient) GetGuestAccess(ctx context.Context, site string) (*SettingGuestAccess, error) {
+	return g.c.GetSettingGuestAccess(ctx, site)
+}
+
+func (g settingsClient) GetIps(ctx context.Context, site string) (*SettingIps, error) {
+	return g.c.GetSettingIps(ctx, site)
+}
+
+func (g settingsClient) GetLcm(ctx context.Context, site string) (*SettingLcm, error) {
+	return g.c.GetSettingLcm(ctx, site)
+}
+
+func (g settingsClient) GetLocale(ctx context.Context, site string) (*SettingLocale, error) {
+	return g.c.GetSettingLocale(ctx, site)
+}
+
+func (g settingsClient) GetMagicSiteToSiteVpn(ctx context.Context, site string) (*SettingMagicSiteToSiteVpn, error) {
+	return g.c.GetSettingMagicSiteToSiteVpn(ctx, site)
+}
+
+func (g settingsClient) GetMdns(ctx context.Context, site string) (*SettingMdns, error) {
+	return g.c.GetSettingMdns(ctx, site)
+}
+
+func (g settingsClient) GetMgmt(ctx context.Context, site string) (*SettingMgmt, error) {
+	return g.c.GetSettingMgmt(ctx, site)
+}
+
+func (g settingsClient) GetNetflow(ctx context.Context, site string) (*SettingNetflow, error) {
+	return g.c.GetSettingNetflow(ctx, site)
+}
+
+func (g settingsClient) GetNetworkOptimization(ctx context.Context, site string) (*SettingNetworkOptimization, error) {
+	return g.c.GetSettingNetworkOptimization(ctx, site)
+}
+
+func (g settingsClient) GetNtp(ctx context.Context, site string) (*SettingNtp, error) {
+	return g.c.GetSettingNtp(ctx, site)
+}
+
+func (g settingsClient) GetPorta(ctx context.Context, site string) (*SettingPorta, error) {
+	return g.c.GetSettingPorta(ctx, site)
+}
+
+func (g settingsClient) GetRadioAi(ctx context.Context, site string) (*SettingRadioAi, error) {
+	return g.c.GetSettingRadioAi(ctx, site)
+}
+
+func (g settingsClient) GetRadius(ctx context.Context, site string) (*SettingRadius, error) {
+	return g.c.GetSettingRadius(ctx, site)
+}
+
+func (g settingsClient) GetRoamingAssistant(ctx context.Context, site string) (*SettingRoamingAssistant, error) {
+	return g.c.GetSettingRoamingAssistant(ctx, site)
+}
+
+func (g settingsClient) GetRsyslogd(ctx context.Context, site string) (*SettingRsyslogd, error) {
+	return g.c.GetSettingRsyslogd(ctx, site)
+}
+
+func (g settingsClient) GetSnmp(ctx context.Context, site string) (*SettingSnmp, error) {
+	return g.c.GetSettingSnmp(ctx, site)
+}
+
+func (g settingsClient) GetSslInspection(ctx context.Context, site string) (*SettingSslInspection, error) {
+	return g.c.GetSettingSslInspection(ctx, site)
+}
+
+func (g settingsClient) GetSuperCloudaccess(ctx context.Context, site string) (*SettingSuperCloudaccess, error) {
+	return g.c.GetSettingSuperCloudaccess(ctx, site)
+}
+
+func (g settingsClient) GetSuperEvents(ctx context.Context, site string) (*SettingSuperEvents, error) {
+	return g.c.GetSettingSuperEvents(ctx, site)
+}
+
+func (g settingsClient) GetSuperFwupdate(ctx context.Context, site string) (*SettingSuperFwupdate, error) {
+	return g.c.GetSettingSuperFwupdate(ctx, site)
+}
+
+func (g settingsClient) GetSuperIdentity(ctx context.Context, site string) (*SettingSuperIdentity, error) {
+	return g.c.GetSettingSuperIdentity(ctx, site)
+}
+
+func (g settingsClient) GetSuperMail(ctx context.Context, site string) (*SettingSuperMail, error) {
+	return g.c.GetSettingSuperMail(ctx, site)
+}
+
+func (g settingsClient) GetSuperMgmt(ctx context.Context, site string) (*SettingSuperMgmt, error) {
+	return g.c.GetSettingSuperMgmt(ctx, site)
+}
+
+func (g settingsClient) GetSuperSdn(ctx context.Context, site string) (*SettingSuperSdn, error) {
+	return g.c.GetSettingSuperSdn(ctx, site)
+}
+
+func (g settingsClient) GetSuperSmtp(ctx context.Context, site string) (*SettingSuperSmtp, error) {
+	return g.c.GetSettingSuperSmtp(ctx, site)
+}
+
+func (g settingsClient) GetTeleport(ctx context.Context, site string) (*SettingTeleport, error) {
+	return g.c.GetSettingTeleport(ctx, site)
+}
+
+func (g settingsClient) GetTrafficFlow(ctx context.Context, site string) (*SettingTrafficFlow, error) {
+	return g.c.GetSettingTrafficFlow(ctx, site)
+}
+
+func (g settingsClient) GetUsg(ctx context.Context, site string) (*SettingUsg, error) {
+	return g.c.GetSettingUsg(ctx, site)
+}
+
+func (g settingsClient) GetUsw(ctx context.Context, site string) (*SettingUsw, error) {
+	return g.c.GetSettingUsw(ctx, site)
+}
+
+func (g settingsClient) Set(ctx context.Context, site string, key string, reqBody any) (any, error) {
+	return g.c.SetSetting(ctx, site, key, reqBody)
+}
+
+func (g settingsClient) UpdateAutoSpeedtest(ctx context.Context, site string, s *SettingAutoSpeedtest) (*SettingAutoSpeedtest, error) {
+	return g.c.UpdateSettingAutoSpeedtest(ctx, site, s)
+}
+
+func (g settingsClient) UpdateBaresip(ctx context.Context, site string, s *SettingBaresip) (*SettingBaresip, error) {
+	return g.c.UpdateSettingBaresip(ctx, site, s)
+}
+
+func (g settingsClient) UpdateBroadcast(ctx context.Context, site string, s *SettingBroadcast) (*SettingBroadcast, error) {
+	return g.c.UpdateSettingBroadcast(ctx, site, s)
+}
+
+func (g settingsClient) UpdateConnectivity(ctx context.Context, site string, s *SettingConnectivity) (*SettingConnectivity, error) {
+	return g.c.UpdateSettingConnectivity(ctx, site, s)
+}
+
+func (g settingsClient) UpdateCountry(ctx context.Context, site string, s *SettingCountry) (*SettingCountry, error) {
+	return g.c.UpdateSettingCountry(ctx, site, s)
+}
+
+func (g settingsClient) UpdateDashboard(ctx context.Context, site string, s *SettingDashboard) (*SettingDashboard, error) {
+	return g.c.UpdateSettingDashboard(ctx, site, s)
+}
+
+func (g settingsClient) UpdateDoh(ctx context.Context, site string, s *SettingDoh) (*SettingDoh, error) {
+	return g.c.UpdateSettingDoh(ctx, site, s)
+}
+
+func (g settingsClient) UpdateDpi(ctx context.Context, site string, s *SettingDpi) (*SettingDpi, error) {
+	return g.c.UpdateSettingDpi(ctx, site, s)
+}
+
+func (g settingsClient) UpdateElementAdopt(ctx context.Context, site string, s *SettingElementAdopt) (*SettingElementAdopt, error) {
+	return g.c.UpdateSettingElementAdopt(ctx, site, s)
+}
+
+func (g settingsClient) UpdateEtherLighting(ctx context.Context, site string, s *SettingEtherLighting) (*SettingEtherLighting, error) {
+	return g.c.UpdateSettingEtherLighting(ctx, site, s)
+}
+
+func (g settingsClient) UpdateEvaluationScore(ctx context.Context, site string, s *SettingEvaluationScore) (*SettingEvaluationScore, error) {
+	return g.c.UpdateSettingEvaluationScore(ctx, site, s)
+}
+
+func (g settingsClient) UpdateGlobalAp(ctx context.Context, site string, s *SettingGlobalAp) (*SettingGlobalAp, error) {
+	return g.c.UpdateSettingGlobalAp(ctx, site, s)
+}
+
+func (g settingsClient) UpdateGlobalNat(ctx context.Context, site string, s *SettingGlobalNat) (*SettingGlobalNat, error) {
+	return g.c.UpdateSettingGlobalNat(ctx, site, s)
+}
+
+func (g settingsClient) UpdateGlobalSwitch(ctx context.Context, site string, s *SettingGlobalSwitch) (*SettingGlobalSwitch, error) {
+	return g.c.UpdateSettingGlobalSwitch(ctx, site, s)
+}
+
+func (g settingsClient) UpdateGuestAccess(ctx context.Context, site string, s *SettingGuestAccess) (*SettingGuestAccess, error) {
+	return g.c.UpdateSettingGuestAccess(ctx, site, s)
+}
+
+func (g settingsClient) UpdateIps(ctx context.Context, site string, s *SettingIps) (*SettingIps, error) {
+	return g.c.UpdateSettingIps(ctx, site, s)
+}
+
+func (g settingsClient) UpdateLcm(ctx context.Context, site string, s *SettingLcm) (*SettingLcm, error) {
+	return g.c.UpdateSettingLcm(ctx, site, s)
+}
+
+func (g settingsClient) UpdateLocale(ctx context.Context, site string, s *SettingLocale) (*SettingLocale, error) {
+	return g.c.UpdateSettingLocale(ctx, site, s)
+}
+
+func (g settingsClient) UpdateMagicSiteToSiteVpn(ctx context.Context, site string, s *SettingMagicSiteToSiteVpn) (*SettingMagicSiteToSiteVpn, error) {
+	return g.c.UpdateSettingMagicSiteToSiteVpn(ctx, site, s)
+}
+
+func (g settingsClient) UpdateMdns(ctx context.Context, site string, s *SettingMdns) (*SettingMdns, error) {
+	return g.c.UpdateSettingMdns(ctx, site, s)
+}
+
+func (g settingsClient) UpdateMgmt(ctx context.Context, site string, s *SettingMgmt) (*SettingMgmt, error) {
+	return g.c.UpdateSettingMgmt(ctx, site, s)
+}
+
+func (g settingsClient) UpdateNetflow(ctx context.Context, site string, s *SettingNetflow) (*SettingNetflow, error) {
+	return g.c.UpdateSettingNetflow(ctx, site, s)
+}
+
+func (g settingsClient) UpdateNetworkOptimization(ctx context.Context, site string, s *SettingNetworkOptimization) (*SettingNetworkOptimization, error) {
+	return g.c.UpdateSettingNetworkOptimization(ctx, site, s)
+}
+
+func (g settingsClient) UpdateNtp(ctx context.Context, site string, s *SettingNtp) (*SettingNtp, error) {
+	return g.c.UpdateSettingNtp(ctx, site, s)
+}
+
+func (g settingsClient) UpdatePorta(ctx context.Context, site string, s *SettingPorta) (*SettingPorta, error) {
+	return g.c.UpdateSettingPorta(ctx, site, s)
+}
+
+func (g settingsClient) UpdateRadioAi(ctx context.Context, site string, s *SettingRadioAi) (*SettingRadioAi, error) {
+	return g.c.UpdateSettingRadioAi(ctx, site, s)
+}
+
+func (g settingsClient) UpdateRadius(ctx context.Context, site string, s *SettingRadius) (*SettingRadius, error) {
+	return g.c.UpdateSettingRadius(ctx, site, s)
+}
+
+func (g settingsClient) UpdateRoamingAssistant(ctx context.Context, site string, s *SettingRoamingAssistant) (*SettingRoamingAssistant, error) {
+	return g.c.UpdateSettingRoamingAssistant(ctx, site, s)
+}
+
+func (g settingsClient) UpdateRsyslogd(ctx context.Context, site string, s *SettingRsyslogd) (*SettingRsyslogd, error) {
+	return g.c.UpdateSettingRsyslogd(ctx, site, s)
+}
+
+func (g settingsClient) UpdateSnmp(ctx context.Context, site string, s *SettingSnmp) (*SettingSnmp, error) {
+	return g.c.UpdateSettingSnmp(ctx, site, s)
+}
+
+func (g settingsClient) UpdateSslInspection(ctx context.Context, site string, s *SettingSslInspection) (*SettingSslInspection, error) {
+	return g.c.UpdateSettingSslInspection(ctx, site, s)
+}
+
+func (g settingsClient) UpdateSuperCloudaccess(ctx context.Context, site string, s *SettingSuperCloudaccess) (*SettingSuperCloudaccess, error) {
+	return g.c.UpdateSettingSuperCloudaccess(ctx, site, s)
+}
+
+func (g settingsClient) UpdateSuperEvents(ctx context.Context, site string, s *SettingSuperEvents) (*SettingSuperEvents, error) {
+	return g.c.UpdateSettingSuperEvents(ctx, site, s)
+}
+
+func (g settingsClient) UpdateSuperFwupdate(ctx context.Context, site string, s *SettingSuperFwupdate) (*SettingSuperFwupdate, error) {
+	return g.c.UpdateSettingSuperFwupdate(ctx, site, s)
+}
+
+func (g settingsClient) UpdateSuperIdentity(ctx context.Context, site string, s *SettingSuperIdentity) (*SettingSuperIdentity, error) {
+	return g.c.UpdateSettingSuperIdentity(ctx, site, s)
+}
+
+func (g settingsClient) UpdateSuperMail(ctx context.Context, site string, s *SettingSuperMail) (*SettingSuperMail, error) {
+	return g.c.UpdateSettingSuperMail(ctx, site, s)
+}
+
+func (g settingsClient) UpdateSuperMgmt(ctx context.Context, site string, s *SettingSuperMgmt) (*SettingSuperMgmt, error) {
+	return g.c.UpdateSettingSuperMgmt(ctx, site, s)
+}
+
+func (g settingsClient) UpdateSuperSdn(ctx context.Context, site string, s *SettingSuperSdn) (*SettingSuperSdn, error) {
+	return g.c.UpdateSettingSuperSdn(ctx, site, s)
+}
+
+func (g settingsClient) UpdateSuperSmtp(ctx context.Context, site string, s *SettingSuperSmtp) (*SettingSuperSmtp, error) {
+	return g.c.UpdateSettingSuperSmtp(ctx, site, s)
+}
+
+func (g settingsClient) UpdateTeleport(ctx context.Context, site string, s *SettingTeleport) (*SettingTeleport, error) {
+	return g.c.UpdateSettingTeleport(ctx, site, s)
+}
+
+func (g settingsClient) UpdateTrafficFlow(ctx context.Context, site string, s *SettingTrafficFlow) (*SettingTrafficFlow, error) {
+	return g.c.UpdateSettingTrafficFlow(ctx, site, s)
+}
+
+func (g settingsClient) UpdateUsg(ctx context.Context, site string, s *SettingUsg) (*SettingUsg, error) {
+	return g.c.UpdateSettingUsg(ctx, site, s)
+}
+
+func (g settingsClient) UpdateUsw(ctx context.Context, site string, s *SettingUsw) (*SettingUsw, error) {
+	return g.c.UpdateSettingUsw(ctx, site, s)
+}
+
+// SettingsClientMock is a func-field test double implementing SettingsClient. A nil
+// field panics on call, surfacing an un-stubbed method in tests.
+type SettingsClientMock struct {
+	GetFunc                       func(context.Context, string, string) (*Setting, any, error)
+	GetAutoSpeedtestFunc          func(context.Context, string) (*SettingAutoSpeedtest, error)
+	GetBaresipFunc                func(context.Context, string) (*SettingBaresip, error)
+	GetBroadcastFunc              func(context.Context, string) (*SettingBroadcast, error)
+	GetConnectivityFunc           func(context.Context, string) (*SettingConnectivity, error)
+	GetCountryFunc                func(context.Context, string) (*SettingCountry, error)
+	GetDashboardFunc              func(context.Context, string) (*SettingDashboard, error)
+	GetDohFunc                    func(context.Context, string) (*SettingDoh, error)
+	GetDpiFunc                    func(context.Context, string) (*SettingDpi, error)
+	GetElementAdoptFunc           func(context.Context, string) (*SettingElementAdopt, error)
+	GetEtherLightingFunc          func(context.Context, string) (*SettingEtherLighting, error)
+	GetEvaluationScoreFunc        func(context.Context, string) (*SettingEvaluationScore, error)
+	GetGlobalApFunc               func(context.Context, string) (*SettingGlobalAp, error)
+	GetGlobalNatFunc              func(context.Context, string) (*SettingGlobalNat, error)
+	GetGlobalSwitchFunc           func(context.Context, string) (*SettingGlobalSwitch, error)
+	GetGuestAccessFunc            func(context.Context, string) (*SettingGuestAccess, error)
+	GetIpsFunc                    func(context.Context, string) (*SettingIps, error)
+	GetLcmFunc                    func(context.Context, string) (*SettingLcm, error)
+	GetLocaleFunc                 func(context.Context, string) (*SettingLocale, error)
+	GetMagicSiteToSiteVpnFunc     func(context.Context, string) (*SettingMagicSiteToSiteVpn, error)
+	GetMdnsFunc                   func(context.Context, string) (*SettingMdns, error)
+	GetMgmtFunc                   func(context.Context, string) (*SettingMgmt, error)
+	GetNetflowFunc                func(context.Context, string) (*SettingNetflow, error)
+	GetNetworkOptimizationFunc    func(context.Context, string) (*SettingNetworkOptimization, error)
+	GetNtpFunc                    func(context.Context, string) (*SettingNtp, error)
+	GetPortaFunc                  func(context.Context, string) (*SettingPorta, error)
+	GetRadioAiFunc                func(context.Context, string) (*SettingRadioAi, error)
+	GetRadiusFunc                 func(context.Context, string) (*SettingRadius, error)
+	GetRoamingAssistantFunc       func(context.Context, string) (*SettingRoamingAssistant, error)
+	GetRsyslogdFunc               func(context.Context, string) (*SettingRsyslogd, error)
+	GetSnmpFunc                   func(context.Context, string) (*SettingSnmp, error)
+	GetSslInspectionFunc          func(context.Context, string) (*SettingSslInspection, error)
+	GetSuperCloudaccessFunc       func(context.Context, string) (*SettingSuperCloudaccess, error)
+	GetSuperEventsFunc            func(context.Context, string) (*SettingSuperEvents, error)
+	GetSuperFwupdateFunc          func(context.Context, string) (*SettingSuperFwupdate, error)
+	GetSuperIdentityFunc          func(context.Context, string) (*SettingSuperIdentity, error)
+	GetSuperMailFunc              func(context.Context, string) (*SettingSuperMail, error)
+	GetSuperMgmtFunc              func(context.Context, string) (*SettingSuperMgmt, error)
+	GetSuperSdnFunc               func(context.Context, string) (*SettingSuperSdn, error)
+	GetSuperSmtpFunc              func(context.Context, string) (*SettingSuperSmtp, error)
+	GetTeleportFunc               func(context.Context, string) (*SettingTeleport, error)
+	GetTrafficFlowFunc            func(context.Context, string) (*SettingTrafficFlow, error)
+	GetUsgFunc                    func(context.Context, string) (*SettingUsg, error)
+	GetUswFunc                    func(context.Context, string) (*SettingUsw, error)
+	SetFunc                       func(context.Context, string, string, any) (any, error)
+	UpdateAutoSpeedtestFunc       func(context.Context, string, *SettingAutoSpeedtest) (*SettingAutoSpeedtest, error)
+	UpdateBaresipFunc             func(context.Context, string, *SettingBaresip) (*SettingBaresip, error)
+	UpdateBroadcastFunc           func(context.Context, string, *SettingBroadcast) (*SettingBroadcast, error)
+	UpdateConnectivityFunc        func(context.Context, string, *SettingConnectivity) (*SettingConnectivity, error)
+	UpdateCountryFunc             func(context.Context, string, *SettingCountry) (*SettingCountry, error)
+	UpdateDashboardFunc           func(context.Context, string, *SettingDashboard) (*SettingDashboard, error)
+	UpdateDohFunc                 func(context.Context, string, *SettingDoh) (*SettingDoh, error)
+	UpdateDpiFunc                 func(context.Context, string, *SettingDpi) (*SettingDpi, error)
+	UpdateElementAdoptFunc        func(context.Context, string, *SettingElementAdopt) (*SettingElementAdopt, error)
+	UpdateEtherLightingFunc       func(context.Context, string, *SettingEtherLighting) (*SettingEtherLighting, error)
+	UpdateEvaluationScoreFunc     func(context.Context, string, *SettingEvaluationScore) (*SettingEvaluationScore, error)
+	UpdateGlobalApFunc            func(context.Context, string, *SettingGlobalAp) (*SettingGlobalAp, error)
+	UpdateGlobalNatFunc           func(context.Context, string, *SettingGlobalNat) (*SettingGlobalNat, error)
+	UpdateGlobalSwitchFunc        func(context.Context, string, *SettingGlobalSwitch) (*SettingGlobalSwitch, error)
+	UpdateGuestAccessFunc         func(context.Context, string, *SettingGuestAccess) (*SettingGuestAccess, error)
+	UpdateIpsFunc                 func(context.Context, string, *SettingIps) (*SettingIps, error)
+	UpdateLcmFunc                 func(context.Context, string, *SettingLcm) (*SettingLcm, error)
+	UpdateLocaleFunc              func(context.Context, string, *SettingLocale) (*SettingLocale, error)
+	UpdateMagicSiteToSiteVpnFunc  func(context.Context, string, *SettingMagicSiteToSiteVpn) (*SettingMagicSiteToSiteVpn, error)
+	UpdateMdnsFunc                func(context.Context, string, *SettingMdns) (*SettingMdns, error)
+	UpdateMgmtFunc                func(context.Context, string, *SettingMgmt) (*SettingMgmt, error)
+	UpdateNetflowFunc             func(context.Context, string, *SettingNetflow) (*SettingNetflow, error)
+	UpdateNetworkOptimizationFunc func(context.Context, string, *SettingNetworkOptimization) (*SettingNetworkOptimization, error)
+	UpdateNtpFunc                 func(context.Context, string, *SettingNtp) (*SettingNtp, error)
+	UpdatePortaFunc               func(context.Context, string, *SettingPorta) (*SettingPorta, error)
+	UpdateRadioAiFunc             func(context.Context, string, *SettingRadioAi) (*SettingRadioAi, error)
+	UpdateRadiusFunc              func(context.Context, string, *SettingRadius) (*SettingRadius, error)
+	UpdateRoamingAssistantFunc    func(context.Context, string, *SettingRoamingAssistant) (*SettingRoamingAssistant, error)
+	UpdateRsyslogdFunc            func(context.Context, string, *SettingRsyslogd) (*SettingRsyslogd, error)
+	UpdateSnmpFunc                func(context.Context, string, *SettingSnmp) (*SettingSnmp, error)
+	UpdateSslInspectionFunc       func(context.Context, string, *SettingSslInspection) (*SettingSslInspection, error)
+	UpdateSuperCloudaccessFunc    func(context.Context, string, *SettingSuperCloudaccess) (*SettingSuperCloudaccess, error)
+	UpdateSuperEventsFunc         func(context.Context, string, *SettingSuperEvents) (*SettingSuperEvents, error)
+	UpdateSuperFwupdateFunc       func(context.Context, string, *SettingSuperFwupdate) (*SettingSuperFwupdate, error)
+	UpdateSuperIdentityFunc       func(context.Context, string, *SettingSuperIdentity) (*SettingSuperIdentity, error)
+	UpdateSuperMailFunc           func(context.Context, string, *SettingSuperMail) (*SettingSuperMail, error)
+	UpdateSuperMgmtFunc           func(context.Context, string, *SettingSuperMgmt) (*SettingSuperMgmt, error)
+	UpdateSuperSdnFunc            func(context.Context, string, *SettingSuperSdn) (*SettingSuperSdn, error)
+	UpdateSuperSmtpFunc           func(context.Context, string, *SettingSuperSmtp) (*SettingSuperSmtp, error)
+	UpdateTeleportFunc            func(context.Context, string, *SettingTeleport) (*SettingTeleport, error)
+	UpdateTrafficFlowFunc         func(context.Context, string, *SettingTrafficFlow) (*SettingTrafficFlow, error)
+	UpdateUsgFunc                 func(context.Context, string, *SettingUsg) (*SettingUsg, error)
+	UpdateUswFunc                 func(context.Context, string, *SettingUsw) (*SettingUsw, error)
+}
+
+var _ SettingsClient = (*SettingsClientMock)(nil)
+
+func (mock *SettingsClientMock) Get(ctx context.Context, site string, key string) (*Setting, any, error) {
+	return mock.GetFunc(ctx, site, key)
+}
+
+func (mock *SettingsClientMock) GetAutoSpeedtest(ctx context.Context, site string) (*SettingAutoSpeedtest, error) {
+	return mock.GetAutoSpeedtestFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetBaresip(ctx context.Context, site string) (*SettingBaresip, error) {
+	return mock.GetBaresipFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetBroadcast(ctx context.Context, site string) (*SettingBroadcast, error) {
+	return mock.GetBroadcastFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetConnectivity(ctx context.Context, site string) (*SettingConnectivity, error) {
+	return mock.GetConnectivityFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetCountry(ctx context.Context, site string) (*SettingCountry, error) {
+	return mock.GetCountryFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetDashboard(ctx context.Context, site string) (*SettingDashboard, error) {
+	return mock.GetDashboardFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetDoh(ctx context.Context, site string) (*SettingDoh, error) {
+	return mock.GetDohFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetDpi(ctx context.Context, site string) (*SettingDpi, error) {
+	return mock.GetDpiFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetElementAdopt(ctx context.Context, site string) (*SettingElementAdopt, error) {
+	return mock.GetElementAdoptFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetEtherLighting(ctx context.Context, site string) (*SettingEtherLighting, error) {
+	return mock.GetEtherLightingFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetEvaluationScore(ctx context.Context, site string) (*SettingEvaluationScore, error) {
+	return mock.GetEvaluationScoreFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetGlobalAp(ctx context.Context, site string) (*SettingGlobalAp, error) {
+	return mock.GetGlobalApFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetGlobalNat(ctx context.Context, site string) (*SettingGlobalNat, error) {
+	return mock.GetGlobalNatFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetGlobalSwitch(ctx context.Context, site string) (*SettingGlobalSwitch, error) {
+	return mock.GetGlobalSwitchFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetGuestAccess(ctx context.Context, site string) (*SettingGuestAccess, error) {
+	return mock.GetGuestAccessFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetIps(ctx context.Context, site string) (*SettingIps, error) {
+	return mock.GetIpsFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetLcm(ctx context.Context, site string) (*SettingLcm, error) {
+	return mock.GetLcmFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetLocale(ctx context.Context, site string) (*SettingLocale, error) {
+	return mock.GetLocaleFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetMagicSiteToSiteVpn(ctx context.Context, site string) (*SettingMagicSiteToSiteVpn, error) {
+	return mock.GetMagicSiteToSiteVpnFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetMdns(ctx context.Context, site string) (*SettingMdns, error) {
+	return mock.GetMdnsFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetMgmt(ctx context.Context, site string) (*SettingMgmt, error) {
+	return mock.GetMgmtFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetNetflow(ctx context.Context, site string) (*SettingNetflow, error) {
+	return mock.GetNetflowFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetNetworkOptimization(ctx context.Context, site string) (*SettingNetworkOptimization, error) {
+	return mock.GetNetworkOptimizationFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetNtp(ctx context.Context, site string) (*SettingNtp, error) {
+	return mock.GetNtpFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetPorta(ctx context.Context, site string) (*SettingPorta, error) {
+	return mock.GetPortaFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetRadioAi(ctx context.Context, site string) (*SettingRadioAi, error) {
+	return mock.GetRadioAiFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetRadius(ctx context.Context, site string) (*SettingRadius, error) {
+	return mock.GetRadiusFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetRoamingAssistant(ctx context.Context, site string) (*SettingRoamingAssistant, error) {
+	return mock.GetRoamingAssistantFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetRsyslogd(ctx context.Context, site string) (*SettingRsyslogd, error) {
+	return mock.GetRsyslogdFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetSnmp(ctx context.Context, site string) (*SettingSnmp, error) {
+	return mock.GetSnmpFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetSslInspection(ctx context.Context, site string) (*SettingSslInspection, error) {
+	return mock.GetSslInspectionFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetSuperCloudaccess(ctx context.Context, site string) (*SettingSuperCloudaccess, error) {
+	return mock.GetSuperCloudaccessFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetSuperEvents(ctx context.Context, site string) (*SettingSuperEvents, error) {
+	return mock.GetSuperEventsFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetSuperFwupdate(ctx context.Context, site string) (*SettingSuperFwupdate, error) {
+	return mock.GetSuperFwupdateFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetSuperIdentity(ctx context.Context, site string) (*SettingSuperIdentity, error) {
+	return mock.GetSuperIdentityFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetSuperMail(ctx context.Context, site string) (*SettingSuperMail, error) {
+	return mock.GetSuperMailFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetSuperMgmt(ctx context.Context, site string) (*SettingSuperMgmt, error) {
+	return mock.GetSuperMgmtFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetSuperSdn(ctx context.Context, site string) (*SettingSuperSdn, error) {
+	return mock.GetSuperSdnFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetSuperSmtp(ctx context.Context, site string) (*SettingSuperSmtp, error) {
+	return mock.GetSuperSmtpFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetTeleport(ctx context.Context, site string) (*SettingTeleport, error) {
+	return mock.GetTeleportFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetTrafficFlow(ctx context.Context, site string) (*SettingTrafficFlow, error) {
+	return mock.GetTrafficFlowFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetUsg(ctx context.Context, site string) (*SettingUsg, error) {
+	return mock.GetUsgFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) GetUsw(ctx context.Context, site string) (*SettingUsw, error) {
+	return mock.GetUswFunc(ctx, site)
+}
+
+func (mock *SettingsClientMock) Set(ctx context.Context, site string, key string, reqBody any) (any, error) {
+	return mock.SetFunc(ctx, site, key, reqBody)
+}
+
+func (mock *SettingsClientMock) UpdateAutoSpeedtest(ctx context.Context, site string, s *SettingAutoSpeedtest) (*SettingAutoSpeedtest, error) {
+	return mock.UpdateAutoSpeedtestFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateBaresip(ctx context.Context, site string, s *SettingBaresip) (*SettingBaresip, error) {
+	return mock.UpdateBaresipFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateBroadcast(ctx context.Context, site string, s *SettingBroadcast) (*SettingBroadcast, error) {
+	return mock.UpdateBroadcastFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateConnectivity(ctx context.Context, site string, s *SettingConnectivity) (*SettingConnectivity, error) {
+	return mock.UpdateConnectivityFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateCountry(ctx context.Context, site string, s *SettingCountry) (*SettingCountry, error) {
+	return mock.UpdateCountryFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateDashboard(ctx context.Context, site string, s *SettingDashboard) (*SettingDashboard, error) {
+	return mock.UpdateDashboardFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateDoh(ctx context.Context, site string, s *SettingDoh) (*SettingDoh, error) {
+	return mock.UpdateDohFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateDpi(ctx context.Context, site string, s *SettingDpi) (*SettingDpi, error) {
+	return mock.UpdateDpiFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateElementAdopt(ctx context.Context, site string, s *SettingElementAdopt) (*SettingElementAdopt, error) {
+	return mock.UpdateElementAdoptFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateEtherLighting(ctx context.Context, site string, s *SettingEtherLighting) (*SettingEtherLighting, error) {
+	return mock.UpdateEtherLightingFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateEvaluationScore(ctx context.Context, site string, s *SettingEvaluationScore) (*SettingEvaluationScore, error) {
+	return mock.UpdateEvaluationScoreFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateGlobalAp(ctx context.Context, site string, s *SettingGlobalAp) (*SettingGlobalAp, error) {
+	return mock.UpdateGlobalApFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateGlobalNat(ctx context.Context, site string, s *SettingGlobalNat) (*SettingGlobalNat, error) {
+	return mock.UpdateGlobalNatFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateGlobalSwitch(ctx context.Context, site string, s *SettingGlobalSwitch) (*SettingGlobalSwitch, error) {
+	return mock.UpdateGlobalSwitchFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateGuestAccess(ctx context.Context, site string, s *SettingGuestAccess) (*SettingGuestAccess, error) {
+	return mock.UpdateGuestAccessFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateIps(ctx context.Context, site string, s *SettingIps) (*SettingIps, error) {
+	return mock.UpdateIpsFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateLcm(ctx context.Context, site string, s *SettingLcm) (*SettingLcm, error) {
+	return mock.UpdateLcmFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateLocale(ctx context.Context, site string, s *SettingLocale) (*SettingLocale, error) {
+	return mock.UpdateLocaleFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateMagicSiteToSiteVpn(ctx context.Context, site string, s *SettingMagicSiteToSiteVpn) (*SettingMagicSiteToSiteVpn, error) {
+	return mock.UpdateMagicSiteToSiteVpnFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateMdns(ctx context.Context, site string, s *SettingMdns) (*SettingMdns, error) {
+	return mock.UpdateMdnsFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateMgmt(ctx context.Context, site string, s *SettingMgmt) (*SettingMgmt, error) {
+	return mock.UpdateMgmtFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateNetflow(ctx context.Context, site string, s *SettingNetflow) (*SettingNetflow, error) {
+	return mock.UpdateNetflowFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateNetworkOptimization(ctx context.Context, site string, s *SettingNetworkOptimization) (*SettingNetworkOptimization, error) {
+	return mock.UpdateNetworkOptimizationFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateNtp(ctx context.Context, site string, s *SettingNtp) (*SettingNtp, error) {
+	return mock.UpdateNtpFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdatePorta(ctx context.Context, site string, s *SettingPorta) (*SettingPorta, error) {
+	return mock.UpdatePortaFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateRadioAi(ctx context.Context, site string, s *SettingRadioAi) (*SettingRadioAi, error) {
+	return mock.UpdateRadioAiFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateRadius(ctx context.Context, site string, s *SettingRadius) (*SettingRadius, error) {
+	return mock.UpdateRadiusFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateRoamingAssistant(ctx context.Context, site string, s *SettingRoamingAssistant) (*SettingRoamingAssistant, error) {
+	return mock.UpdateRoamingAssistantFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateRsyslogd(ctx context.Context, site string, s *SettingRsyslogd) (*SettingRsyslogd, error) {
+	return mock.UpdateRsyslogdFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateSnmp(ctx context.Context, site string, s *SettingSnmp) (*SettingSnmp, error) {
+	return mock.UpdateSnmpFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateSslInspection(ctx context.Context, site string, s *SettingSslInspection) (*SettingSslInspection, error) {
+	return mock.UpdateSslInspectionFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateSuperCloudaccess(ctx context.Context, site string, s *SettingSuperCloudaccess) (*SettingSuperCloudaccess, error) {
+	return mock.UpdateSuperCloudaccessFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateSuperEvents(ctx context.Context, site string, s *SettingSuperEvents) (*SettingSuperEvents, error) {
+	return mock.UpdateSuperEventsFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateSuperFwupdate(ctx context.Context, site string, s *SettingSuperFwupdate) (*SettingSuperFwupdate, error) {
+	return mock.UpdateSuperFwupdateFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateSuperIdentity(ctx context.Context, site string, s *SettingSuperIdentity) (*SettingSuperIdentity, error) {
+	return mock.UpdateSuperIdentityFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateSuperMail(ctx context.Context, site string, s *SettingSuperMail) (*SettingSuperMail, error) {
+	return mock.UpdateSuperMailFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateSuperMgmt(ctx context.Context, site string, s *SettingSuperMgmt) (*SettingSuperMgmt, error) {
+	return mock.UpdateSuperMgmtFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateSuperSdn(ctx context.Context, site string, s *SettingSuperSdn) (*SettingSuperSdn, error) {
+	return mock.UpdateSuperSdnFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateSuperSmtp(ctx context.Context, site string, s *SettingSuperSmtp) (*SettingSuperSmtp, error) {
+	return mock.UpdateSuperSmtpFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateTeleport(ctx context.Context, site string, s *SettingTeleport) (*SettingTeleport, error) {
+	return mock.UpdateTeleportFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateTrafficFlow(ctx context.Context, site string, s *SettingTrafficFlow) (*SettingTrafficFlow, error) {
+	return mock.UpdateTrafficFlowFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateUsg(ctx context.Context, site string, s *SettingUsg) (*SettingUsg, error) {
+	return mock.UpdateUsgFunc(ctx, site, s)
+}
+
+func (mock *SettingsClientMock) UpdateUsw(ctx context.Context, site string, s *SettingUsw) (*SettingUsw, error) {
+	return mock.UpdateUswFunc(ctx, site, s)
+}
+
+// SitesClient is the Sites resource group of the legacy ("Internal") UniFi
+// Network API surface.
+type SitesClient interface {
+	Create(ctx context.Context, description string) ([]Site, error)
+	Delete(ctx context.Context, id string) ([]Site, error)
+	Get(ctx context.Context, id string) (*Site, error)
+	// GetAllHealth returns the health of every site, fetching at most concurrency sites at once.
+	GetAllHealth(ctx context.Context, concurrency int) ([]SiteHealthResult, error)
+	// GetHealth returns the health of the site's subsystems.
+	GetHealth(ctx context.Context, site string) (*SiteHealth, error)
+	List(ctx context.Context) ([]Site, error)
+	Update(ctx context.Context, name string, description string) ([]Site, error)
+}
+
+// sitesClient forwards the Sites group to the flat client methods.
+type sitesClient struct{ c *client }
+
+var _ SitesClient = sitesClient{}
+
+// Sites returns the Sites resource group.
+func (c *client) Sites() SitesClient {
+	return sitesClient{c}
+}
+
+func (g sitesClient) Create(ctx context.Context, description string) ([]Site, error) {
+	return g.c.CreateSite(ctx, description)
+}
+
+func (g sitesClient) Delete(ctx context.Context, id string) ([]Site, error) {
+	return g.c.DeleteSite(ctx, id)
+}
+
+func (g sitesClient) Get(ctx context.Context, id string) (*Site, error) {
+	return g.c.GetSite(ctx, id)
+}
+
+func (g sitesClient) GetAllHealth(ctx context.Context, concurrency int) ([]SiteHealthResult, error) {
+	return g.c.GetAllSitesHealth(ctx, concurrency)
+}
+
+func (g sitesClient) GetHealth(ctx context.Context, site string) (*SiteHealth, error) {
+	return g.c.GetSiteHealth(ctx, site)
+}
+
+func (g sitesClient) List(ctx context.Context) ([]Site, error) {
+	return g.c.ListSites(ctx)
+}
+
+func (g sitesClient) Update(ctx context.Context, name string, description string) ([]Site, error) {
+	return g.c.UpdateSite(ctx, name, description)
+}
+
+// SitesClientMock is a func-field test double implementing SitesClient. A nil
+// field panics on call, surfacing an un-stubbed method in tests.
+type SitesClientMock struct {
+	CreateFunc       func(context.Context, string) ([]Site, error)
+	DeleteFunc       func(context.Context, string) ([]Site, error)
+	GetFunc          func(context.Context, string) (*Site, error)
+	GetAllHealthFunc func(context.Context, int) ([]SiteHealthResult, error)
+	GetHealthFunc    func(context.Context, string) (*SiteHealth, error)
+	ListFunc         func(context.Context) ([]Site, error)
+	UpdateFunc       func(context.Context, string, string) ([]Site, error)
+}
+
+var _ SitesClient = (*SitesClientMock)(nil)
+
+func (mock *SitesClientMock) Create(ctx context.Context, description string) ([]Site, error) {
+	return mock.CreateFunc(ctx, description)
+}
+
+func (mock *SitesClientMock) Delete(ctx context.Context, id string) ([]Site, error) {
+	return mock.DeleteFunc(ctx, id)
+}
+
+func (mock *SitesClientMock) Get(ctx context.Context, id string) (*Site, error) {
+	return mock.GetFunc(ctx, id)
+}
+
+func (mock *SitesClientMock) GetAllHealth(ctx context.Context, concurrency int) ([]SiteHealthResult, error) {
+	return mock.GetAllHealthFunc(ctx, concurrency)
+}
+
+func (mock *SitesClientMock) GetHealth(ctx context.Context, site string) (*SiteHealth, error) {
+	return mock.GetHealthFunc(ctx, site)
+}
+
+func (mock *SitesClientMock) List(ctx context.Context) ([]Site, error) {
+	return mock.ListFunc(ctx)
+}
+
+func (mock *SitesClientMock) Update(ctx context.Context, name string, description string) ([]Site, error) {
+	return mock.UpdateFunc(ctx, name, description)
+}
+
+// SpectrumScansClient is the SpectrumScans resource group of the legacy ("Internal") UniFi
+// Network API surface.
+type SpectrumScansClient interface {
+	// Get returns the state and last result of the AP's spectrum scan.
+	Get(ctx context.Context, site string, apMAC string) (*SpectrumScanResult, error)
+	// Start starts a spectrum scan on the AP with the given MAC; its radios stop serving clients for a few minutes.
+	Start(ctx context.Context, site string, apMAC string) error
+	// Wait polls the AP's spectrum scan every pollInterval until it is no longer running, and returns its result.
+	Wait(ctx context.Context, site string, apMAC string, pollInterval time.Duration) (*SpectrumScanResult, error)
+}
+
+// spectrumScansClient forwards the SpectrumScans group to the flat client methods.
+type spectrumScansClient struct{ c *client }
+
+var _ SpectrumScansClient = spectrumScansClient{}
+
+// SpectrumScans returns the SpectrumScans resource group.
+func (c *client) SpectrumScans() SpectrumScansClient {
+	return spectrumScansClient{c}
+}
+
+func (g spectrumScansClient) Get(ctx context.Context, site string, apMAC string) (*SpectrumScanResult, error) {
+	return g.c.GetSpectrumScan(ctx, site, apMAC)
+}
+
+func (g spectrumScansClient) Start(ctx context.Context, site string, apMAC string) error {
+	return g.c.StartSpectrumScan(ctx, site, apMAC)
+}
+
+func (g spectrumScansClient) Wait(ctx context.Context, site string, apMAC string, pollInterval time.Duration) (*SpectrumScanResult, error) {
+	return g.c.WaitSpectrumScan(ctx, site, apMAC, pollInterval)
+}
+
+// SpectrumScansClientMock is a func-field test double implementing SpectrumScansClient. A nil
+// field panics on call, surfacing an un-stubbed method in tests.
+type SpectrumScansClientMock struct {
+	GetFunc   func(context.Context, string, string) (*SpectrumScanResult, error)
+	StartFunc func(context.Context, string, string) error
+	WaitFunc  func(context.Context, string, string, time.Duration) (*SpectrumScanResult, error)
+}
+
+var _ SpectrumScansClient = (*SpectrumScansClientMock)(nil)
+
+func (mock *SpectrumScansClientMock) Get(ctx context.Context, site string, apMAC string) (*SpectrumScanResult, error) {
+	return mock.GetFunc(ctx, site, apMAC)
+}
+
+func (mock *SpectrumScansClientMock) Start(ctx context.Context, site string, apMAC string) error {
+	return mock.StartFunc(ctx, site, apMAC)
+}
+
+func (mock *SpectrumScansClientMock) Wait(ctx context.Context, site string, apMAC string, pollInterval time.Duration) (*SpectrumScanResult, error) {
+	return mock.WaitFunc(ctx, site, apMAC, pollInterval)
+}
+
+// SpeedTestsClient is the SpeedTests resource group of the legacy ("Internal") UniFi
+// Network API surface.
+type SpeedTestsClient interface {
+	// GetStatus returns the progress and last result of the gateway's speed test.
+	GetStatus(ctx context.Context, site string) (*SpeedTestStatus, error)
+	// ListResults returns the speed tests archived over [from, to], oldest first.
+	ListResults(ctx context.Context, site string, from time.Time, to time.Time) ([]SpeedTestResult, error)
+	// Run starts a speed test on the site's gateway; it saturates the WAN link for up to a minute.
+	Run(ctx context.Context, site string) error
+}
+
+// speedTestsClient forwards the SpeedTests group to the flat client methods.
+type speedTestsClient struct{ c *client }
+
+var _ SpeedTestsClient = speedTestsClient{}
+
+// SpeedTests returns the SpeedTests resource group.
+func (c *client) SpeedTests() SpeedTestsClient {
+	return speedTestsClient{c}
+}
+
+func (g speedTestsClient) GetStatus(ctx context.Context, site string) (*SpeedTestStatus, error) {
+	return g.c.GetSpeedTestStatus(ctx, site)
+}
+
+func (g speedTestsClient) ListResults(ctx context.Context, site string, from time.Time, to time.Time) ([]SpeedTestResult, error) {
+	return g.c.ListSpeedTestResults(ctx, site, from, to)
+}
+
+func (g speedTestsClient) Run(ctx context.Context, site string) error {
+	return g.c.RunSpeedTest(ctx, site)
+}
+
+// SpeedTestsClientMock is a func-field test double implementing SpeedTestsClient. A nil
+// field panics on call, surfacing an un-stubbed method in tests.
+type SpeedTestsClientMock struct {
+	GetStatusFunc   func(context.Context, string) (*SpeedTestStatus, error)
+	ListResultsFunc func(context.Context, string, time.Time, time.Time) ([]SpeedTestResult, error)
+	RunFunc         func(context.Context, string) error
+}
+
+var _ SpeedTestsClient = (*SpeedTestsClientMock)(nil)
+
+func (mock *SpeedTestsClientMock) GetStatus(ctx context.Context, site string) (*SpeedTestStatus, error) {
+	return mock.GetStatusFunc(ctx, site)
+}
+
+func (mock *SpeedTestsClientMock) ListResults(ctx context.Context, site string, from time.Time, to time.Time) ([]SpeedTestResult, error) {
+	return mock.ListResultsFunc(ctx, site, from, to)
+}
+
+func (mock *SpeedTestsClientMock) Run(ctx context.Context, site string) error {
+	return mock.RunFunc(ctx, site)
+}
+
+// SystemClient is the System resource group of the legacy ("Internal") UniFi
+// Network API surface.
+type SystemClient interface {
+	// CreateBroadcastGroup creates a resource
+	CreateBroadcastGroup(ctx context.Context, site string, b *BroadcastGroup) (*BroadcastGroup, error)
+	// CreateDashboard creates a resource
+	CreateDashboard(ctx context.Context, site string, d *Dashboard) (*Dashboard, error)
+	// CreateMediaFile creates a resource
+	CreateMediaFile(ctx context.Context, site string, m *MediaFile) (*MediaFile, error)
+	// CreateScheduleTask creates a resource
+	CreateScheduleTask(ctx context.Context, site string, s *ScheduleTask) (*ScheduleTask, error)
+	// CreateTag creates a resource
+	CreateTag(ctx context.Context, site string, t *Tag) (*Tag, error)
+	// DeleteBroadcastGroup deletes a resource
+	DeleteBroadcastGroup(ctx context.Context, site string, id string) error
+	// DeleteDashboard deletes a resource
+	DeleteDashboard(ctx context.Context, site string, id string) error
+	// DeleteMediaFile deletes a resource
+	DeleteMediaFile(ctx context.Context, site string, id string) error
+	// DeleteScheduleTask deletes a resource
+	DeleteScheduleTask(ctx context.Context, site string, id string) error
+	// DeleteTag deletes a resource
+	DeleteTag(ctx context.Context, site string, id string) error
+	// GetBroadcastGroup retrieves a resource
+	GetBroadcastGroup(ctx context.Context, site string, id string) (*BroadcastGroup, error)
+	// GetDashboard retrieves a resource
+	GetDashboard(ctx context.Context, site string, id string) (*Dashboard, error)
+	GetInfo(ctx context.Context, id string) (*SysInfo, error)
+	GetInformation() (*SysInfo, error)
+	// GetInformationContext retrieves system information using the supplied context for cancellation/deadline.
+	GetInformationContext(ctx context.Context) (*SysInfo, error)
+	// GetMediaFile retrieves a resource
+	GetMediaFile(ctx context.Context, site string, id string) (*MediaFile, error)
+	// GetScheduleTask retrieves a resource
+	GetScheduleTask(ctx context.Context, site string, id string) (*ScheduleTask, error)
+	// GetTag retrieves a resource
+	GetTag(ctx context.Context, site string, id string) (*Tag, error)
+	// ListBroadcastGroup lists the resources
+	ListBroadcastGroup(ctx context.Context, site string) ([]BroadcastGroup, error)
+	// ListDashboard lists the resources
+	ListDashboard(ctx context.Context, site string) ([]Dashboard, error)
+	// ListMediaFile lists the resources
+	ListMediaFile(ctx context.Context, site string) ([]MediaFile, error)
+	// ListScheduleTask lists the resources
+	ListScheduleTask(ctx context.Context, site string) ([]ScheduleTask, error)
+	// ListTag lists the resources
+	ListTag(ctx context.Context, site string) ([]Tag, error)
+	// UpdateBroadcastGroup updates a resource
+	UpdateBroadcastGroup(ctx context.Context, site string, b *BroadcastGroup) (*BroadcastGroup, error)
+	// UpdateDashboard updates a resource
+	UpdateDashboard(ctx context.Context, site string, d *Dashboard) (*Dashboard, error)
+	// UpdateMediaFile updates a resource
+	UpdateMediaFile(ctx context.Context, site string, m *MediaFile) (*MediaFile, error)
+	// UpdateScheduleTask updates a resource
+	UpdateScheduleTask(ctx context.Context, site string, s *ScheduleTask) (*ScheduleTask, error)
+	// UpdateTag updates a resource
+	UpdateTag(ctx context.Context, site string, t *Tag) (*Tag, error)
+}
+
+// systemClient forwards the System group to the flat client methods.
+type systemClient struct{ c *client }
+
+var _ SystemClient = systemClient{}
+
+// System returns the System resource group.
+func (c *client) System() SystemClient {
+	return systemClient{c}
+}
+
+func (g systemClient) CreateBroadcastGroup(ctx context.Context, site string, b *BroadcastGroup) (*BroadcastGroup, error) {
+	return g.c.CreateBroadcastGroup(ctx, site, b)
+}
+
+func (g systemClient) CreateDashboard(ctx context.Context, site string, d *Dashboard) (*Dashboard, error) {
+	return g.c.CreateDashboard(ctx, site, d)
+}
+
+func (g systemClient) CreateMediaFile(ctx context.Context, site string, m *MediaFile) (*MediaFile, error) {
+	return g.c.CreateMediaFile(ctx, site, m)
+}
+
+func (g systemClient) CreateScheduleTask(ctx context.Context, site string, s *ScheduleTask) (*ScheduleTask, error) {
+	return g.c.CreateScheduleTask(ctx, site, s)
+}
+
+func (g systemClient) CreateTag(ctx context.Context, site string, t *Tag) (*Tag, error) {
+	return g.c.CreateTag(ctx, site, t)
+}
+
+func (g systemClient) DeleteBroadcastGroup(ctx context.Context, site string, id string) error {
+	return g.c.DeleteBroadcastGroup(ctx, site, id)
+}
+
+func (g systemClient) DeleteDashboard(ctx context.Context, site string, id string) error {
+	return g.c.DeleteDashboard(ctx, site, id)
+}
+
+func (g systemClient) DeleteMediaFile(ctx context.Context, site string, id string) error {
+	return g.c.DeleteMediaFile(ctx, site, id)
+}
+
+func (g systemClient) DeleteScheduleTask(ctx context.Context, site string, id string) error {
+	return g.c.DeleteScheduleTask(ctx, site, id)
+}
+
+func (g systemClient) DeleteTag(ctx context.Context, site string, id string) error {
+	return g.c.DeleteTag(ctx, site, id)
+}
+
+func (g systemClient) GetBroadcastGroup(ctx context.Context, site string, id string) (*BroadcastGroup, error) {
+	return g.c.GetBroadcastGroup(ctx, site, id)
+}
+
+func (g systemClient) GetDashboard(ctx context.Context, site string, id string) (*Dashboard, error) {
+	return g.c.GetDashboard(ctx, site, id)
+}
+
+func (g systemClient) GetInfo(ctx context.Context, id string) (*SysInfo, error) {
+	return g.c.GetSystemInfo(ctx, id)
+}
+
+func (g systemClient) GetInformation() (*SysInfo, error) {
+	return g.c.GetSystemInformation()
+}
+
+func (g systemClient) GetInformationContext(ctx context.Context) (*SysInfo, error) {
+	return g.c.GetSystemInformationContext(ctx)
+}
+
+func (g systemClient) GetMediaFile(ctx context.Context, site string, id string) (*MediaFile, error) {
+	return g.c.GetMediaFile(ctx, site, id)
+}
+
+func (g systemClient) GetScheduleTask(ctx context.Context, site string, id string) (*ScheduleTask, error) {
+	return g.c.GetScheduleTask(ctx, site, id)
+}
+
+func (g systemClient) GetTag(ctx context.Context, site string, id string) (*Tag, error) {
+	return g.c.GetTag(ctx, site, id)
+}
+
+func (g systemClient) ListBroadcastGroup(ctx context.Context, site string) ([]BroadcastGroup, error) {
+	return g.c.ListBroadcastGroup(ctx, site)
+}
+
+func (g systemClient) ListDashboard(ctx context.Context, site string) ([]Dashboard, error) {
+	return g.c.ListDashboard(ctx, site)
+}
+
+func (g systemClient) ListMediaFile(ctx context.Context, site string) ([]MediaFile, error) {
+	return g.c.ListMediaFile(ctx, site)
+}
+
+func (g systemClient) ListScheduleTask(ctx context.Context, site string) ([]ScheduleTask, error) {
+	return g.c.ListScheduleTask(ctx, site)
+}
+
+func (g systemClient) ListTag(ctx context.Context, site string) ([]Tag, error) {
+	return g.c.ListTag(ctx, site)
+}
+
+func (g systemClient) UpdateBroadcastGroup(ctx context.Context, site string, b *BroadcastGroup) (*BroadcastGroup, error) {
+	return g.c.UpdateBroadcastGroup(ctx, site, b)
+}
+
+func (g systemClient) UpdateDashboard(ctx context.Context, site string, d *Dashboard) (*Dashboard, error) {
+	return g.c.UpdateDashboard(ctx, site, d)
+}
+
+func (g systemClient) UpdateMediaFile(ctx context.Context, site string, m *MediaFile) (*MediaFile, error) {
+	return g.c.UpdateMediaFile(ctx, site, m)
+}
+
+func (g systemClient) UpdateScheduleTask(ctx context.Context, site string, s *ScheduleTask) (*ScheduleTask, error) {
+	return g.c.UpdateScheduleTask(ctx, site, s)
+}
+
+func (g systemClient) UpdateTag(ctx context.Context, site string, t *Tag) (*Tag, error) {
+	return g.c.UpdateTag(ctx, site, t)
+}
+
+// SystemClientMock is a func-field test double implementing SystemClient. A nil
+// field panics on call, surfacing an un-stubbed method in tests.
+type SystemClientMock struct {
+	CreateBroadcastGroupFunc  func(context.Context, string, *BroadcastGroup) (*BroadcastGroup, error)
+	CreateDashboardFunc       func(context.Context, string, *Dashboard) (*Dashboard, error)
+	CreateMediaFileFunc       func(context.Context, string, *MediaFile) (*MediaFile, error)
+	CreateScheduleTaskFunc    func(context.Context, string, *ScheduleTask) (*ScheduleTask, error)
+	CreateTagFunc             func(context.Context, string, *Tag) (*Tag, error)
+	DeleteBroadcastGroupFunc  func(context.Context, string, string) error
+	DeleteDashboardFunc       func(context.Context, string, string) error
+	DeleteMediaFileFunc       func(context.Context, string, string) error
+	DeleteScheduleTaskFunc    func(context.Context, string, string) error
+	DeleteTagFunc             func(context.Context, string, string) error
+	GetBroadcastGroupFunc     func(context.Context, string, string) (*BroadcastGroup, error)
+	GetDashboardFunc          func(context.Context, string, string) (*Dashboard, error)
+	GetInfoFunc               func(context.Context, string) (*SysInfo, error)
+	GetInformationFunc        func() (*SysInfo, error)
+	GetInformationContextFunc func(context.Context) (*SysInfo, error)
+	GetMediaFileFunc          func(context.Context, string, string) (*MediaFile, error)
+	GetScheduleTaskFunc       func(context.Context, string, string) (*ScheduleTask, error)
+	GetTagFunc                func(context.Context, string, string) (*Tag, error)
+	ListBroadcastGroupFunc    func(context.Context, string) ([]BroadcastGroup, error)
+	ListDashboardFunc         func(context.Context, string) ([]Dashboard, error)
+	ListMediaFileFunc         func(context.Context, string) ([]MediaFile, error)
+	ListScheduleTaskFunc      func(context.Context, string) ([]ScheduleTask, error)
+	ListTagFunc               func(context.Context, string) ([]Tag, error)
+	UpdateBroadcastGroupFunc  func(context.Context, string, *BroadcastGroup) (*BroadcastGroup, error)
+	UpdateDashboardFunc       func(context.Context, string, *Dashboard) (*Dashboard, error)
+	UpdateMediaFileFunc       func(context.Context, string, *MediaFile) (*MediaFile, error)
+	UpdateScheduleTaskFunc    func(context.Context, string, *ScheduleTask) (*ScheduleTask, error)
+	UpdateTagFunc             func(context.Context, string, *Tag) (*Tag, error)
+}
+
+var _ SystemClient = (*SystemClientMock)(nil)
+
+func (mock *SystemClientMock) CreateBroadcastGroup(ctx context.Context, site string, b *BroadcastGroup) (*BroadcastGroup, error) {
+	return mock.CreateBroadcastGroupFunc(ctx, site, b)
+}
+
+func (mock *SystemClientMock) CreateDashboard(ctx context.Context, site string, d *Dashboard) (*Dashboard, error) {
+	return mock.CreateDashboardFunc(ctx, site, d)
+}
+
+func (mock *SystemClientMock) CreateMediaFile(ctx context.Context, site string, m *MediaFile) (*MediaFile, error) {
+	return mock.CreateMediaFileFunc(ctx, site, m)
+}
+
+func (mock *SystemClientMock) CreateScheduleTask(ctx context.Context, site string, s *ScheduleTask) (*ScheduleTask, error) {
+	return mock.CreateScheduleTaskFunc(ctx, site, s)
+}
+
+func (mock *SystemClientMock) CreateTag(ctx context.Context, site string, t *Tag) (*Tag, error) {
+	return mock.CreateTagFunc(ctx, site, t)
+}
+
+func (mock *SystemClientMock) DeleteBroadcastGroup(ctx context.Context, site string, id string) error {
+	return mock.DeleteBroadcastGroupFunc(ctx, site, id)
+}
+
+func (mock *SystemClientMock) DeleteDashboard(ctx context.Context, site string, id string) error {
+	return mock.DeleteDashboardFunc(ctx, site, id)
+}
+
+func (mock *SystemClientMock) DeleteMediaFile(ctx context.Context, site string, id string) error {
+	return mock.DeleteMediaFileFunc(ctx, site, id)
+}
+
+func (mock *SystemClientMock) DeleteScheduleTask(ctx context.Context, site string, id string) error {
+	return mock.DeleteScheduleTaskFunc(ctx, site, id)
+}
+
+func (mock *SystemClientMock) DeleteTag(ctx context.Context, site string, id string) error {
+	return mock.DeleteTagFunc(ctx, site, id)
+}
+
+func (mock *SystemClientMock) GetBroadcastGroup(ctx context.Context, site string, id string) (*BroadcastGroup, error) {
+	return mock.GetBroadcastGroupFunc(ctx, site, id)
+}
+
+func (mock *SystemClientMock) GetDashboard(ctx context.Context, site string, id string) (*Dashboard, error) {
+	return mock.GetDashboardFunc(ctx, site, id)
+}
+
+func (mock *SystemClientMock) GetInfo(ctx context.Context, id string) (*SysInfo, error) {
+	return mock.GetInfoFunc(ctx, id)
+}
+
+func (mock *SystemClientMock) GetInformation() (*SysInfo, error) {
+	return mock.GetInformationFunc()
+}
+
+func (mock *SystemClientMock) GetInformationContext(ctx context.Context) (*SysInfo, error) {
+	return mock.GetInformationContextFunc(ctx)
+}
+
+func (mock *SystemClientMock) GetMediaFile(ctx context.Context, site string, id string) (*MediaFile, error) {
+	return mock.GetMediaFileFunc(ctx, site, id)
+}
+
+func (mock *SystemClientMock) GetScheduleTask(ctx context.Context, site string, id string) (*ScheduleTask, error) {
+	return mock.GetScheduleTaskFunc(ctx, site, id)
+}
+
+func (mock *SystemClientMock) GetTag(ctx context.Context, site string, id string) (*Tag, error) {
+	return mock.GetTagFunc(ctx, site, id)
+}
+
+func (mock *SystemClientMock) ListBroadcastGroup(ctx context.Context, site string) ([]BroadcastGroup, error) {
+	return mock.ListBroadcastGroupFunc(ctx, site)
+}
+
+func (mock *SystemClientMock) ListDashboard(ctx context.Context, site string) ([]Dashboard, error) {
+	return mock.ListDashboardFunc(ctx, site)
+}
+
+func (mock *SystemClientMock) ListMediaFile(ctx context.Context, site string) ([]MediaFile, error) {
+	return mock.ListMediaFileFunc(ctx, site)
+}
+
+func (mock *SystemClientMock) ListScheduleTask(ctx context.Context, site string) ([]ScheduleTask, error) {
+	return mock.ListScheduleTaskFunc(ctx, site)
+}
+
+func (mock *SystemClientMock) ListTag(ctx context.Context, site string) ([]Tag, error) {
+	return mock.ListTagFunc(ctx, site)
+}
+
+func (mock *SystemClientMock) UpdateBroadcastGroup(ctx context.Context, site string, b *BroadcastGroup) (*BroadcastGroup, error) {
+	return mock.UpdateBroadcastGroupFunc(ctx, site, b)
+}
+
+func (mock *SystemClientMock) UpdateDashboard(ctx context.Context, site string, d *Dashboard) (*Dashboard, error) {
+	return mock.UpdateDashboardFunc(ctx, site, d)
+}
+
+func (mock *SystemClientMock) UpdateMediaFile(ctx context.Context, site string, m *MediaFile) (*MediaFile, error) {
+	return mock.UpdateMediaFileFunc(ctx, site, m)
+}
+
+func (mock *SystemClientMock) UpdateScheduleTask(ctx context.Context, site string, s *ScheduleTask) (*ScheduleTask, error) {
+	return mock.UpdateScheduleTaskFunc(ctx, site, s)
+}
+
+func (mock *SystemClientMock) UpdateTag(ctx context.Context, site string, t *Tag) (*Tag, error) {
+	return mock.UpdateTagFunc(ctx, site, t)
+}
+
+// TrafficFlowsClient is the TrafficFlows resource group of the legacy ("Internal") UniFi
+// Network API surface.
+type TrafficFlowsClient interface {
+	// Get fetches traffic flows using the provided request payload.
+	Get(ctx context.Context, site string, req *TrafficFlowsRequest) (*TrafficFlowsResponse, error)
+	// ListSeq returns the flows matching req across all pages, fetching each page as iteration reaches it.
+	ListSeq(ctx context.Context, site string, req *TrafficFlowsRequest) iter.Seq2[TrafficFlow, error]
+}
+
+// trafficFlowsClient forwards the TrafficFlows group to the flat client methods.
+type trafficFlowsClient struct{ c *client }
+
+var _ TrafficFlowsClient = trafficFlowsClient{}
+
+// TrafficFlows returns the TrafficFlows resource group.
+func (c *client) TrafficFlows() TrafficFlowsClient {
+	return trafficFlowsClient{c}
+}
+
+func (g trafficFlowsClient) Get(ctx context.Context, site string, req *TrafficFlowsRequest) (*TrafficFlowsResponse, error) {
+	return g.c.GetTrafficFlows(ctx, site, req)
+}
+
+func (g trafficFlowsClient) ListSeq(ctx context.Context, site string, req *TrafficFlowsRequest) iter.Seq2[TrafficFlow, error] {
+	return g.c.ListTrafficFlowsSeq(ctx, site, req)
+}
+
+// TrafficFlowsClientMock is a func-field test double implementing TrafficFlowsClient. A nil
+// field panics on call, surfacing an un-stubbed method in tests.
+type TrafficFlowsClientMock struct {
+	GetFunc     func(context.Context, string, *TrafficFlowsRequest) (*TrafficFlowsResponse, error)
+	ListSeqFunc func(context.Context, string, *TrafficFlowsRequest) iter.Seq2[TrafficFlow, error]
+}
+
+var _ TrafficFlowsClient = (*TrafficFlowsClientMock)(nil)
+
+func (mock *TrafficFlowsClientMock) Get(ctx context.Context, site string, req *TrafficFlowsRequest) (*TrafficFlowsResponse, error) {
+	return mock.GetFunc(ctx, site, req)
+}
+
+func (mock *TrafficFlowsClientMock) ListSeq(ctx context.Context, site string, req *TrafficFlowsRequest) iter.Seq2[TrafficFlow, error] {
+	return mock.ListSeqFunc(ctx, site, req)
+}
+
+// UsersClient is the Users resource group of the legacy ("Internal") UniFi
+// Network API surface.
+type UsersClient interface {
+	BlockByMAC(ctx context.Context, site string, mac string) error
+	// Create creates a resource
+	Create(ctx context.Context, site string, u *User) (*User, error)
+	// CreateGroup creates a resource
+	CreateGroup(ctx context.Context, site string, u *UserGroup) (*UserGroup, error)
+	// Delete deletes a resource
+	Delete(ctx context.Context, site string, id string) error
+	DeleteByMAC(ctx context.Context, site string, mac string) error
+	// DeleteGroup deletes a resource
+	DeleteGroup(ctx context.Context, site string, id string) error
+	// Get retrieves a resource
+	Get(ctx context.Context, site string, id string) (*User, error)
+	GetByMAC(ctx context.Context, site string, mac string) (*User, error)
+	// GetGroup retrieves a resource
+	GetGroup(ctx context.Context, site string, id string) (*UserGroup, error)
+	KickByMAC(ctx context.Context, site string, mac string) error
+	// List lists the resources
+	List(ctx context.Context, site string) ([]User, error)
+	// ListGroup lists the resources
+	ListGroup(ctx context.Context, site string) ([]UserGroup, error)
+	// ListSeq streams the users of a site, decoding the response element by element.
+	ListSeq(ctx context.Context, site string) iter.Seq2[User, error]
+	OverrideFingerprint(ctx context.Context, site string, mac string, devIdOverride int) error
+	UnblockByMAC(ctx context.Context, site string, mac string) error
+	// Update updates a resource
+	Update(ctx context.Context, site string, u *User) (*User, error)
+	// UpdateGroup updates a resource
+	UpdateGroup(ctx context.Context, site string, u *UserGroup) (*UserGroup, error)
+}
+
+// usersClient forwards the Users group to the flat client methods.
+type usersClient struct{ c *client }
+
+var _ UsersClient = usersClient{}
+
+// Users returns the Users resource group.
+func (c *client) Users() UsersClient {
+	return usersClient{c}
+}
+
+func (g usersClient) BlockByMAC(ctx context.Context, site string, mac string) error {
+	return g.c.BlockUserByMAC(ctx, site, mac)
+}
+
+func (g usersClient) Create(ctx context.Context, site string, u *User) (*User, error) {
+	return g.c.CreateUser(ctx, site, u)
+}
+
+func (g usersClient) CreateGroup(ctx context.Context, site string, u *UserGroup) (*UserGroup, error) {
+	return g.c.CreateUserGroup(ctx, site, u)
+}
+
+func (g usersClient) Delete(ctx context.Context, site string, id string) error {
+	return g.c.DeleteUser(ctx, site, id)
+}
+
+func (g usersClient) DeleteByMAC(ctx context.Context, site string, mac string) error {
+	return g.c.DeleteUserByMAC(ctx, site, mac)
+}
+
+func (g usersClient) DeleteGroup(ctx context.Context, site string, id string) error {
+	return g.c.DeleteUserGroup(ctx, site, id)
+}
+
+func (g usersClient) Get(ctx context.Context, site string, id string) (*User, error) {
+	return g.c.GetUser(ctx, site, id)
+}
+
+func (g usersClient) GetByMAC(ctx context.Context, site string, mac string) (*User, error) {
+	return g.c.GetUserByMAC(ctx, site, mac)
+}
+
+func (g usersClient) GetGroup(ctx context.Context, site string, id string) (*UserGroup, error) {
+	return g.c.GetUserGroup(ctx, site, id)
+}
+
+func (g usersClient) KickByMAC(ctx context.Context, site string, mac string) error {
+	return g.c.KickUserByMAC(ctx, site, mac)
+}
+
+func (g usersClient) List(ctx context.Context, site string) ([]User, error) {
+	return g.c.ListUser(ctx, site)
+}
+
+func (g usersClient) ListGroup(ctx context.Context, site string) ([]UserGroup, error) {
+	return g.c.ListUserGroup(ctx, site)
+}
+
+func (g usersClient) ListSeq(ctx context.Context, site string) iter.Seq2[User, error] {
+	return g.c.ListUserSeq(ctx, site)
+}
+
+func (g usersClient) OverrideFingerprint(ctx context.Context, site string, mac string, devIdOverride int) error {
+	return g.c.OverrideUserFingerprint(ctx, site, mac, devIdOverride)
+}
+
+func (g usersClient) UnblockByMAC(ctx context.Context, site string, mac string) error {
+	return g.c.UnblockUserByMAC(ctx, site, mac)
+}
+
+func (g usersClient) Update(ctx context.Context, site string, u *User) (*User, error) {
+	return g.c.UpdateUser(ctx, site, u)
+}
+
+func (g usersClient) UpdateGroup(ctx context.Context, site string, u *UserGroup) (*UserGroup, error) {
+	return g.c.UpdateUserGroup(ctx, site, u)
+}
+
+// UsersClientMock is a func-field test double implementing UsersClient. A nil
+// field panics on call, surfacing an un-stubbed method in tests.
+type UsersClientMock struct {
+	BlockByMACFunc          func(context.Context, string, string) error
+	CreateFunc              func(context.Context, string, *User) (*User, error)
+	CreateGroupFunc         func(context.Context, string, *UserGroup) (*UserGroup, error)
+	DeleteFunc              func(context.Context, string, string) error
+	DeleteByMACFunc         func(context.Context, string, string) error
+	DeleteGroupFunc         func(context.Context, string, string) error
+	GetFunc                 func(context.Context, string, string) (*User, error)
+	GetByMACFunc            func(context.Context, string, string) (*User, error)
+	GetGroupFunc            func(context.Context, string, string) (*UserGroup, error)
+	KickByMACFunc           func(context.Context, string, string) error
+	ListFunc                func(context.Context, string) ([]User, error)
+	ListGroupFunc           func(context.Context, string) ([]UserGroup, error)
+	ListSeqFunc             func(context.Context, string) iter.Seq2[User, error]
+	OverrideFingerprintFunc func(context.Context, string, string, int) error
+	UnblockByMACFunc        func(context.Context, string, string) error
+	UpdateFunc              func(context.Context, string, *User) (*User, error)
+	UpdateGroupFunc         func(context.Context, string, *UserGroup) (*UserGroup, error)
+}
+
+var _ UsersClient = (*UsersClientMock)(nil)
+
+func (mock *UsersClientMock) BlockByMAC(ctx context.Context, site string, mac string) error {
+	return mock.BlockByMACFunc(ctx, site, mac)
+}
+
+func (mock *UsersClientMock) Create(ctx context.Context, site string, u *User) (*User, error) {
+	return mock.CreateFunc(ctx, site, u)
+}
+
+func (mock *UsersClientMock) CreateGroup(ctx context.Context, site string, u *UserGroup) (*UserGroup, error) {
+	return mock.CreateGroupFunc(ctx, site, u)
+}
+
+func (mock *UsersClientMock) Delete(ctx context.Context, site string, id string) error {
+	return mock.DeleteFunc(ctx, site, id)
+}
+
+func (mock *UsersClientMock) DeleteByMAC(ctx context.Context, site string, mac string) error {
+	return mock.DeleteByMACFunc(ctx, site, mac)
+}
+
+func (mock *UsersClientMock) DeleteGroup(ctx context.Context, site string, id string) error {
+	return mock.DeleteGroupFunc(ctx, site, id)
+}
+
+func (mock *UsersClientMock) Get(ctx context.Context, site string, id string) (*User, error) {
+	return mock.GetFunc(ctx, site, id)
+}
+
+func (mock *UsersClientMock) GetByMAC(ctx context.Context, site string, mac string) (*User, error) {
+	return mock.GetByMACFunc(ctx, site, mac)
+}
+
+func (mock *UsersClientMock) GetGroup(ctx context.Context, site string, id string) (*UserGroup, error) {
+	return mock.GetGroupFunc(ctx, site, id)
+}
+
+func (mock *UsersClientMock) KickByMAC(ctx context.Context, site string, mac string) error {
+	return mock.KickByMACFunc(ctx, site, mac)
+}
+
+func (mock *UsersClientMock) List(ctx context.Context, site string) ([]User, error) {
+	return mock.ListFunc(ctx, site)
+}
+
+func (mock *UsersClientMock) ListGroup(ctx context.Context, site string) ([]UserGroup, error) {
+	return mock.ListGroupFunc(ctx, site)
+}
+
+func (mock *UsersClientMock) ListSeq(ctx context.Context, site string) iter.Seq2[User, error] {
+	return mock.ListSeqFunc(ctx, site)
+}
+
+func (mock *UsersClientMock) OverrideFingerprint(ctx context.Context, site string, mac string, devIdOverride int) error {
+	return mock.OverrideFingerprintFunc(ctx, site, mac, devIdOverride)
+}
+
+func (mock *UsersClientMock) UnblockByMAC(ctx context.Context, site string, mac string) error {
+	return mock.UnblockByMACFunc(ctx, site, mac)
+}
+
+func (mock *UsersClientMock) Update(ctx context.Context, site string, u *User) (*User, error) {
+	return mock.UpdateFunc(ctx, site, u)
+}
+
+func (mock *UsersClientMock) UpdateGroup(ctx context.Context, site string, u *UserGroup) (*UserGroup, error) {
+	return mock.UpdateGroupFunc(ctx, site, u)
+}
+
+// WLANsClient is the WLANs resource group of the legacy ("Internal") UniFi
+// Network API surface.
+type WLANsClient interface {
+	// Create creates a resource
+	Create(ctx context.Context, site string, w *WLAN) (*WLAN, error)
+	// CreateAPGroup creates a resource
+	CreateAPGroup(ctx context.Context, site string, a *APGroup) (*APGroup, error)
+	// CreateGroup creates a resource
+	CreateGroup(ctx context.Context, site string, w *WLANGroup) (*WLANGroup, error)
+	// Delete deletes a resource
+	Delete(ctx context.Context, site string, id string) error
+	// DeleteAPGroup deletes a resource
+	DeleteAPGroup(ctx context.Context, site string, id string) error
+	// DeleteGroup deletes a resource
+	DeleteGroup(ctx context.Context, site string, id string) error
+	// Get retrieves a resource
+	Get(ctx context.Context, site string, id string) (*WLAN, error)
+	// GetAPGroup retrieves a resource
+	GetAPGroup(ctx context.Context, site string, id string) (*APGroup, error)
+	// GetGroup retrieves a resource
+	GetGroup(ctx context.Context, site string, id string) (*WLANGroup, error)
+	// List lists the resources
+	List(ctx context.Context, site string) ([]WLAN, error)
+	// ListAPGroup lists the resources
+	ListAPGroup(ctx context.Context, site string) ([]APGroup, error)
+	// ListGroup lists the resources
+	ListGroup(ctx context.Context, site string) ([]WLANGroup, error)
+	// Update updates a resource
+	Update(ctx context.Context, site string, w *WLAN) (*WLAN, error)
+	// UpdateAPGroup updates a resource
+	UpdateAPGroup(ctx context.Context, site string, a *APGroup) (*APGroup, error)
+	// UpdateGroup updates a resource
+	UpdateGroup(ctx context.Context, site string, w *WLANGroup) (*WLANGroup, error)
+}
+
+// wlansClient forwards the WLANs group to the flat client methods.
+type wlansClient struct{ c *client }
+
+var _ WLANsClient = wlansClient{}
+
+// WLANs returns the WLANs resource group.
+func (c *client) WLANs() WLANsClient {
+	return wlansClient{c}
+}
+
+func (g wlansClient) Create(ctx context.Context, site string, w *WLAN) (*WLAN, error) {
+	return g.c.CreateWLAN(ctx, site, w)
+}
+
+func (g wlansClient) CreateAPGroup(ctx context.Context, site string, a *APGroup) (*APGroup, error) {
+	return g.c.CreateAPGroup(ctx, site, a)
+}
+
+func (g wlansClient) CreateGroup(ctx context.Context, site string, w *WLANGroup) (*WLANGroup, error) {
+	return g.c.CreateWLANGroup(ctx, site, w)
+}
+
+func (g wlansClient) Delete(ctx context.Context, site string, id string) error {
+	return g.c.DeleteWLAN(ctx, site, id)
+}
+
+func (g wlansClient) DeleteAPGroup(ctx context.Context, site string, id string) error {
+	return g.c.DeleteAPGroup(ctx, site, id)
+}
+
+func (g wlansClient) DeleteGroup(ctx context.Context, site string, id string) error {
+	return g.c.DeleteWLANGroup(ctx, site, id)
+}
+
+func (g wlansClient) Get(ctx context.Context, site string, id string) (*WLAN, error) {
+	return g.c.GetWLAN(ctx, site, id)
+}
+
+func (g wlansClient) GetAPGroup(ctx context.Context, site string, id string) (*APGroup, error) {
+	return g.c.GetAPGroup(ctx, site, id)
+}
+
+func (g wlansClient) GetGroup(ctx context.Context, site string, id string) (*WLANGroup, error) {
+	return g.c.GetWLANGroup(ctx, site, id)
+}
+
+func (g wlansClient) List(ctx context.Context, site string) ([]WLAN, error) {
+	return g.c.ListWLAN(ctx, site)
+}
+
+func (g wlansClient) ListAPGroup(ctx context.Context, site string) ([]APGroup, error) {
+	return g.c.ListAPGroup(ctx, site)
+}
+
+func (g wlansClient) ListGroup(ctx context.Context, site string) ([]WLANGroup, error) {
+	return g.c.ListWLANGroup(ctx, site)
+}
+
+func (g wlansClient) Update(ctx context.Context, site string, w *WLAN) (*WLAN, error) {
+	return g.c.UpdateWLAN(ctx, site, w)
+}
+
+func (g wlansClient) UpdateAPGroup(ctx context.Context, site string, a *APGroup) (*APGroup, error) {
+	return g.c.UpdateAPGroup(ctx, site, a)
+}
+
+func (g wlansClient) UpdateGroup(ctx context.Context, site string, w *WLANGroup) (*WLANGroup, error) {
+	return g.c.UpdateWLANGroup(ctx, site, w)
+}
+
+// WLANsClientMock is a func-field test double implementing WLANsClient. A nil
+// field panics on call, surfacing an un-stubbed method in tests.
+type WLANsClientMock struct {
+	CreateFunc        func(context.Context, string, *WLAN) (*WLAN, error)
+	CreateAPGroupFunc func(context.Context, string, *APGroup) (*APGroup, error)
+	CreateGroupFunc   func(context.Context, string, *WLANGroup) (*WLANGroup, error)
+	DeleteFunc        func(context.Context, string, string) error
+	DeleteAPGroupFunc func(context.Context, string, string) error
+	DeleteGroupFunc   func(context.Context, string, string) error
+	GetFunc           func(context.Context, string, string) (*WLAN, error)
+	GetAPGroupFunc    func(context.Context, string, string) (*APGroup, error)
+	GetGroupFunc      func(context.Context, string, string) (*WLANGroup, error)
+	ListFunc          func(context.Context, string) ([]WLAN, error)
+	ListAPGroupFunc   func(context.Context, string) ([]APGroup, error)
+	ListGroupFunc     func(context.Context, string) ([]WLANGroup, error)
+	UpdateFunc        func(context.Context, string, *WLAN) (*WLAN, error)
+	UpdateAPGroupFunc func(context.Context, string, *APGroup) (*APGroup, error)
+	UpdateGroupFunc   func(context.Context, string, *WLANGroup) (*WLANGroup, error)
+}
+
+var _ WLANsClient = (*WLANsClientMock)(nil)
+
+func (mock *WLANsClientMock) Create(ctx context.Context, site string, w *WLAN) (*WLAN, error) {
+	return mock.CreateFunc(ctx, site, w)
+}
+
+func (mock *WLANsClientMock) CreateAPGroup(ctx context.Context, site string, a *APGroup) (*APGroup, error) {
+	return mock.CreateAPGroupFunc(ctx, site, a)
+}
+
+func (mock *WLANsClientMock) CreateGroup(ctx context.Context, site string, w *WLANGroup) (*WLANGroup, error) {
+	return mock.CreateGroupFunc(ctx, site, w)
+}
+
+func (mock *WLANsClientMock) Delete(ctx context.Context, site string, id string) error {
+	return mock.DeleteFunc(ctx, site, id)
+}
+
+func (mock *WLANsClientMock) DeleteAPGroup(ctx context.Context, site string, id string) error {
+	return mock.DeleteAPGroupFunc(ctx, site, id)
+}
+
+func (mock *WLANsClientMock) DeleteGroup(ctx context.Context, site string, id string) error {
+	return mock.DeleteGroupFunc(ctx, site, id)
+}
+
+func (mock *WLANsClientMock) Get(ctx context.Context, site string, id string) (*WLAN, error) {
+	return mock.GetFunc(ctx, site, id)
+}
+
+func (mock *WLANsClientMock) GetAPGroup(ctx context.Context, site string, id string) (*APGroup, error) {
+	return mock.GetAPGroupFunc(ctx, site, id)
+}
+
+func (mock *WLANsClientMock) GetGroup(ctx context.Context, site string, id string) (*WLANGroup, error) {
+	return mock.GetGroupFunc(ctx, site, id)
+}
+
+func (mock *WLANsClientMock) List(ctx context.Context, site string) ([]WLAN, error) {
+	return mock.ListFunc(ctx, site)
+}
+
+func (mock *WLANsClientMock) ListAPGroup(ctx context.Context, site string) ([]APGroup, error) {
+	return mock.ListAPGroupFunc(ctx, site)
+}
+
+func (mock *WLANsClientMock) ListGroup(ctx context.Context, site string) ([]WLANGroup, error) {
+	return mock.ListGroupFunc(ctx, site)
+}
+
+func (mock *WLANsClientMock) Update(ctx context.Context, site string, w *WLAN) (*WLAN, error) {
+	return mock.UpdateFunc(ctx, site, w)
+}
+
+func (mock *WLANsClientMock) UpdateAPGroup(ctx context.Context, site string, a *APGroup) (*APGroup, error) {
+	return mock.UpdateAPGroupFunc(ctx, site, a)
+}
+
+func (mock *WLANsClientMock) UpdateGroup(ctx context.Context, site string, w *WLANGroup) (*WLANGroup, error) {
+	return mock.UpdateGroupFunc(ctx, site, w)
+}
+
+// groupOnlyMethods maps the *client methods reachable only through a client group,
+// not the flat InternalClient, to their group method.
+var groupOnlyMethods = map[string]string{
+	"GetDPICatalog":            "DPI().GetCatalog",
+	"GetClientDPIStats":        "DPI().GetClientStats",
+	"GetClientTopApplications": "DPI().GetClientTopApplications",
+	"GetSiteDPIStats":          "DPI().GetSiteStats",
+	"ListDeviceSeq":            "Devices().ListSeq",
+	"GetChannelPlanInput":      "Maps().GetChannelPlanInput",
+	"GetReport":                "Reports().Get",
+	"ListRogueAPs":             "RogueAPs().List",
+	"ListKnownRogueAPs":        "RogueAPs().ListKnown",
+	"ListSSIDImpersonators":    "RogueAPs().ListSSIDImpersonators",
+	"MarkRogueAPKnown":         "RogueAPs().MarkKnown",
+	"UnmarkRogueAPKnown":       "RogueAPs().UnmarkKnown",
+	"GetAllSitesHealth":        "Sites().GetAllHealth",
+	"GetSiteHealth":            "Sites().GetHealth",
+	"GetSpectrumScan":          "SpectrumScans().Get",
+	"StartSpectrumScan":        "SpectrumScans().Start",
+	"WaitSpectrumScan":         "SpectrumScans().Wait",
+	"GetSpeedTestStatus":       "SpeedTests().GetStatus",
+	"ListSpeedTestResults":     "SpeedTests().ListResults",
+	"RunSpeedTest":             "SpeedTests().Run",
+	"ListTrafficFlowsSeq":      "TrafficFlows().ListSeq",
+	"ListUserSeq":              "Users().ListSeq",
+}
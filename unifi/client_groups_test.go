@@ -0,0 +1,80 @@
+package unifi //nolint: testpackage
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestClientGroupsCoverFlatSurface guards the grouped surface against drift:
+// every flat InternalClient method must be reachable through a group method, so
+// the group methods across all accessors add up to at least the flat ones; the
+// surplus are group-only methods.
+func TestClientGroupsCoverFlatSurface(t *testing.T) {
+	t.Parallel()
+
+	it := reflect.TypeFor[InternalClient]()
+	accessors, grouped := 0, 0
+	for m := range it.Methods() {
+		if m.Type.NumIn() != 0 || m.Type.NumOut() != 1 {
+			continue
+		}
+		group := m.Type.Out(0)
+		if group.Kind() != reflect.Interface || !strings.HasSuffix(group.Name(), "Client") {
+			continue
+		}
+		accessors++
+		grouped += group.NumMethod()
+		assert.Equal(t, m.Name+"Client", group.Name(), "accessor %s must return its own group interface", m.Name)
+	}
+	require.NotZero(t, accessors)
+	assert.GreaterOrEqual(t, grouped, it.NumMethod()-accessors, "every flat InternalClient method must have a grouped counterpart")
+}
+
+// TestClientGroupsForwardOverWire drives grouped methods against the mock
+// controller: each must hit the same endpoint as its flat counterpart.
+func TestClientGroupsForwardOverWire(t *testing.T) {
+	t.Parallel()
+
+	cs := newControllerServer(t,
+		route{apiV1Path("s/default/rest/networkconf"), func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"_id":"n1","name":"LAN"}]}`))
+		}},
+		route{apiV1Path("s/default/rest/firewallrule/r1"), func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[]}`))
+		}},
+	)
+	c := cs.client()
+	ctx := context.Background()
+
+	networks, err := c.Networks().List(ctx, "default")
+	require.NoError(t, err)
+	require.Len(t, networks, 1)
+	assert.Equal(t, "LAN", networks[0].Name)
+
+	require.NoError(t, c.Firewall().DeleteRule(ctx, "default", "r1"))
+	last := cs.lastRequest()
+	assert.Equal(t, http.MethodDelete, last.Method)
+	assert.Equal(t, apiV1Path("s/default/rest/firewallrule/r1"), last.Path)
+}
+
+// TestClientGroupMocks shows the intended test setup: ClientMock stubs only the
+// accessor, and the per-group mock stubs only the called method.
+func TestClientGroupMocks(t *testing.T) {
+	t.Parallel()
+
+	devices := &DevicesClientMock{
+		AdoptFunc: func(_ context.Context, _, _ string) error { return nil },
+	}
+	var c Client = &ClientMock{
+		DevicesFunc: func() DevicesClient { return devices },
+	}
+
+	require.NoError(t, c.Devices().Adopt(context.Background(), "default", "aa:bb:cc:dd:ee:ff"))
+	assert.Panics(t, func() { _, _ = c.Devices().List(context.Background(), "default") }, "an un-stubbed group method panics")
+}
@@ -134,6 +134,12 @@ var _ Client = &ClientMock{}
 //			CreateWLANGroupFunc: func(ctx context.Context, site string, w *WLANGroup) (*WLANGroup, error) {
 //				panic("mock out the CreateWLANGroup method")
 //			},
+//			DNSFunc: func() DNSClient {
+//				panic("mock out the DNS method")
+//			},
+//			DPIFunc: func() DPIClient {
+//				panic("mock out the DPI method")
+//			},
 //			DeleteFunc: func(ctx context.Context, apiPath string, reqBody any, respBody any) error {
 //				panic("mock out the Delete method")
 //			},
@@ -248,9 +254,18 @@ var _ Client = &ClientMock{}
 //			DeleteWLANGroupFunc: func(ctx context.Context, site string, id string) error {
 //				panic("mock out the DeleteWLANGroup method")
 //			},
+//			DevicesFunc: func() DevicesClient {
+//				panic("mock out the Devices method")
+//			},
 //			DoFunc: func(ctx context.Context, method string, apiPath string, reqBody any, respBody any) error {
 //				panic("mock out the Do method")
 //			},
+//			FeaturesFunc: func() FeaturesClient {
+//				panic("mock out the Features method")
+//			},
+//			FirewallFunc: func() FirewallClient {
+//				panic("mock out the Firewall method")
+//			},
 //			ForgetDeviceFunc: func(ctx context.Context, site string, mac string) error {
 //				panic("mock out the ForgetDevice method")
 //			},
@@ -515,6 +530,9 @@ var _ Client = &ClientMock{}
 //			GetWLANGroupFunc: func(ctx context.Context, site string, id string) (*WLANGroup, error) {
 //				panic("mock out the GetWLANGroup method")
 //			},
+//			HotspotFunc: func() HotspotClient {
+//				panic("mock out the Hotspot method")
+//			},
 //			InternalFunc: func() InternalClient {
 //				panic("mock out the Internal method")
 //			},
@@ -641,6 +659,12 @@ var _ Client = &ClientMock{}
 //			LoggerFunc: func() Logger {
 //				panic("mock out the Logger method")
 //			},
+//			MapsFunc: func() MapsClient {
+//				panic("mock out the Maps method")
+//			},
+//			NetworksFunc: func() NetworksClient {
+//				panic("mock out the Networks method")
+//			},
 //			OfficialFunc: func() official.Client {
 //				panic("mock out the Official method")
 //			},
@@ -656,15 +680,42 @@ var _ Client = &ClientMock{}
 //			PutFunc: func(ctx context.Context, apiPath string, reqBody any, respBody any) error {
 //				panic("mock out the Put method")
 //			},
+//			RADIUSFunc: func() RADIUSClient {
+//				panic("mock out the RADIUS method")
+//			},
 //			ReorderFirewallPoliciesFunc: func(ctx context.Context, site string, d *FirewallPolicyOrderUpdate) ([]FirewallZonePolicy, error) {
 //				panic("mock out the ReorderFirewallPolicies method")
 //			},
 //			ReorderFirewallRulesFunc: func(ctx context.Context, site string, ruleset string, reorder []FirewallRuleIndexUpdate) error {
 //				panic("mock out the ReorderFirewallRules method")
 //			},
+//			ReportsFunc: func() ReportsClient {
+//				panic("mock out the Reports method")
+//			},
+//			RogueAPsFunc: func() RogueAPsClient {
+//				panic("mock out the RogueAPs method")
+//			},
 //			SetSettingFunc: func(ctx context.Context, site string, key string, reqBody any) (any, error) {
 //				panic("mock out the SetSetting method")
 //			},
+//			SettingsFunc: func() SettingsClient {
+//				panic("mock out the Settings method")
+//			},
+//			SitesFunc: func() SitesClient {
+//				panic("mock out the Sites method")
+//			},
+//			SpectrumScansFunc: func() SpectrumScansClient {
+//				panic("mock out the SpectrumScans method")
+//			},
+//			SpeedTestsFunc: func() SpeedTestsClient {
+//				panic("mock out the SpeedTests method")
+//			},
+//			SystemFunc: func() SystemClient {
+//				panic("mock out the System method")
+//			},
+//			TrafficFlowsFunc: func() TrafficFlowsClient {
+//				panic("mock out the TrafficFlows method")
+//			},
 //			UnblockUserByMACFunc: func(ctx context.Context, site string, mac string) error {
 //				panic("mock out the UnblockUserByMAC method")
 //			},
@@ -908,12 +959,18 @@ var _ Client = &ClientMock{}
 //			UploadPortalFileFromReaderFunc: func(ctx context.Context, site string, reader io.Reader, filename string) (*PortalFile, error) {
 //				panic("mock out the UploadPortalFileFromReader method")
 //			},
+//			UsersFunc: func() UsersClient {
+//				panic("mock out the Users method")
+//			},
 //			VersionFunc: func() string {
 //				panic("mock out the Version method")
 //			},
 //			VersionContextFunc: func(ctx context.Context) (string, error) {
 //				panic("mock out the VersionContext method")
 //			},
+//			WLANsFunc: func() WLANsClient {
+//				panic("mock out the WLANs method")
+//			},
 //		}
 //
 //		// use mockedClient in code that requires Client
@@ -1035,6 +1092,12 @@ type ClientMock struct {
 	// CreateWLANGroupFunc mocks the CreateWLANGroup method.
 	CreateWLANGroupFunc func(ctx context.Context, site string, w *WLANGroup) (*WLANGroup, error)
 
+	// DNSFunc mocks the DNS method.
+	DNSFunc func() DNSClient
+
+	// DPIFunc mocks the DPI method.
+	DPIFunc func() DPIClient
+
 	// DeleteFunc mocks the Delete method.
 	DeleteFunc func(ctx context.Context, apiPath string, reqBody any, respBody any) error
 
@@ -1149,9 +1212,18 @@ type ClientMock struct {
 	// DeleteWLANGroupFunc mocks the DeleteWLANGroup method.
 	DeleteWLANGroupFunc func(ctx context.Context, site string, id string) error
 
+	// DevicesFunc mocks the Devices method.
+	DevicesFunc func() DevicesClient
+
 	// DoFunc mocks the Do method.
 	DoFunc func(ctx context.Context, method string, apiPath string, reqBody any, respBody any) error
 
+	// FeaturesFunc mocks the Features method.
+	FeaturesFunc func() FeaturesClient
+
+	// FirewallFunc mocks the Firewall method.
+	FirewallFunc func() FirewallClient
+
 	// ForgetDeviceFunc mocks the ForgetDevice method.
 	ForgetDeviceFunc func(ctx context.Context, site string, mac string) error
 
@@ -1416,6 +1488,9 @@ type ClientMock struct {
 	// GetWLANGroupFunc mocks the GetWLANGroup method.
 	GetWLANGroupFunc func(ctx context.Context, site string, id string) (*WLANGroup, error)
 
+	// HotspotFunc mocks the Hotspot method.
+	HotspotFunc func() HotspotClient
+
 	// InternalFunc mocks the Internal method.
 	InternalFunc func() InternalClient
 
@@ -1542,6 +1617,12 @@ type ClientMock struct {
 	// LoggerFunc mocks the Logger method.
 	LoggerFunc func() Logger
 
+	// MapsFunc mocks the Maps method.
+	MapsFunc func() MapsClient
+
+	// NetworksFunc mocks the Networks method.
+	NetworksFunc func() NetworksClient
+
 	// OfficialFunc mocks the Official method.
 	OfficialFunc func() official.Client
 
@@ -1557,15 +1638,42 @@ type ClientMock struct {
 	// PutFunc mocks the Put method.
 	PutFunc func(ctx context.Context, apiPath string, reqBody any, respBody any) error
 
+	// RADIUSFunc mocks the RADIUS method.
+	RADIUSFunc func() RADIUSClient
+
 	// ReorderFirewallPoliciesFunc mocks the ReorderFirewallPolicies method.
 	ReorderFirewallPoliciesFunc func(ctx context.Context, site string, d *FirewallPolicyOrderUpdate) ([]FirewallZonePolicy, error)
 
 	// ReorderFirewallRulesFunc mocks the ReorderFirewallRules method.
 	ReorderFirewallRulesFunc func(ctx context.Context, site string, ruleset string, reorder []FirewallRuleIndexUpdate) error
 
+	// ReportsFunc mocks the Reports method.
+	ReportsFunc func() ReportsClient
+
+	// RogueAPsFunc mocks the RogueAPs method.
+	RogueAPsFunc func() RogueAPsClient
+
 	// SetSettingFunc mocks the SetSetting method.
 	SetSettingFunc func(ctx context.Context, site string, key string, reqBody any) (any, error)
 
+	// SettingsFunc mocks the Settings method.
+	SettingsFunc func() SettingsClient
+
+	// SitesFunc mocks the Sites method.
+	SitesFunc func() SitesClient
+
+	// SpectrumScansFunc mocks the SpectrumScans method.
+	SpectrumScansFunc func() SpectrumScansClient
+
+	// SpeedTestsFunc mocks the SpeedTests method.
+	SpeedTestsFunc func() SpeedTestsClient
+
+	// SystemFunc mocks the System method.
+	SystemFunc func() SystemClient
+
+	// TrafficFlowsFunc mocks the TrafficFlows method.
+	TrafficFlowsFunc func() TrafficFlowsClient
+
 	// UnblockUserByMACFunc mocks the UnblockUserByMAC method.
 	UnblockUserByMACFunc func(ctx context.Context, site string, mac string) error
 
@@ -1809,12 +1917,18 @@ type ClientMock struct {
 	// UploadPortalFileFromReaderFunc mocks the UploadPortalFileFromReader method.
 	UploadPortalFileFromReaderFunc func(ctx context.Context, site string, reader io.Reader, filename string) (*PortalFile, error)
 
+	// UsersFunc mocks the Users method.
+	UsersFunc func() UsersClient
+
 	// VersionFunc mocks the Version method.
 	VersionFunc func() string
 
 	// VersionContextFunc mocks the VersionContext method.
 	VersionContextFunc func(ctx context.Context) (string, error)
 
+	// WLANsFunc mocks the WLANs method.
+	WLANsFunc func() WLANsClient
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// AdoptDevice holds details about calls to the AdoptDevice method.
@@ -2151,6 +2265,12 @@ type ClientMock struct {
 			// W is the w argument value.
 			W *WLANGroup
 		}
+		// DNS holds details about calls to the DNS method.
+		DNS []struct {
+		}
+		// DPI holds details about calls to the DPI method.
+		DPI []struct {
+		}
 		// Delete holds details about calls to the Delete method.
 		Delete []struct {
 			// Ctx is the ctx argument value.
@@ -2493,6 +2613,9 @@ type ClientMock struct {
 			// ID is the id argument value.
 			ID string
 		}
+		// Devices holds details about calls to the Devices method.
+		Devices []struct {
+		}
 		// Do holds details about calls to the Do method.
 		Do []struct {
 			// Ctx is the ctx argument value.
@@ -2506,6 +2629,12 @@ type ClientMock struct {
 			// RespBody is the respBody argument value.
 			RespBody any
 		}
+		// Features holds details about calls to the Features method.
+		Features []struct {
+		}
+		// Firewall holds details about calls to the Firewall method.
+		Firewall []struct {
+		}
 		// ForgetDevice holds details about calls to the ForgetDevice method.
 		ForgetDevice []struct {
 			// Ctx is the ctx argument value.
@@ -3200,6 +3329,9 @@ type ClientMock struct {
 			// ID is the id argument value.
 			ID string
 		}
+		// Hotspot holds details about calls to the Hotspot method.
+		Hotspot []struct {
+		}
 		// Internal holds details about calls to the Internal method.
 		Internal []struct {
 		}
@@ -3488,6 +3620,12 @@ type ClientMock struct {
 		// Logger holds details about calls to the Logger method.
 		Logger []struct {
 		}
+		// Maps holds details about calls to the Maps method.
+		Maps []struct {
+		}
+		// Networks holds details about calls to the Networks method.
+		Networks []struct {
+		}
 		// Official holds details about calls to the Official method.
 		Official []struct {
 		}
@@ -3535,6 +3673,9 @@ type ClientMock struct {
 			// RespBody is the respBody argument value.
 			RespBody any
 		}
+		// RADIUS holds details about calls to the RADIUS method.
+		RADIUS []struct {
+		}
 		// ReorderFirewallPolicies holds details about calls to the ReorderFirewallPolicies method.
 		ReorderFirewallPolicies []struct {
 			// Ctx is the ctx argument value.
@@ -3555,6 +3696,12 @@ type ClientMock struct {
 			// Reorder is the reorder argument value.
 			Reorder []FirewallRuleIndexUpdate
 		}
+		// Reports holds details about calls to the Reports method.
+		Reports []struct {
+		}
+		// RogueAPs holds details about calls to the RogueAPs method.
+		RogueAPs []struct {
+		}
 		// SetSetting holds details about calls to the SetSetting method.
 		SetSetting []struct {
 			// Ctx is the ctx argument value.
@@ -3566,6 +3713,24 @@ type ClientMock struct {
 			// ReqBody is the reqBody argument value.
 			ReqBody any
 		}
+		// Settings holds details about calls to the Settings method.
+		Settings []struct {
+		}
+		// Sites holds details about calls to the Sites method.
+		Sites []struct {
+		}
+		// SpectrumScans holds details about calls to the SpectrumScans method.
+		SpectrumScans []struct {
+		}
+		// SpeedTests holds details about calls to the SpeedTests method.
+		SpeedTests []struct {
+		}
+		// System holds details about calls to the System method.
+		System []struct {
+		}
+		// TrafficFlows holds details about calls to the TrafficFlows method.
+		TrafficFlows []struct {
+		}
 		// UnblockUserByMAC holds details about calls to the UnblockUserByMAC method.
 		UnblockUserByMAC []struct {
 			// Ctx is the ctx argument value.
@@ -4297,6 +4462,9 @@ type ClientMock struct {
 			// Filename is the filename argument value.
 			Filename string
 		}
+		// Users holds details about calls to the Users method.
+		Users []struct {
+		}
 		// Version holds details about calls to the Version method.
 		Version []struct {
 		}
@@ -4305,6 +4473,9 @@ type ClientMock struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 		}
+		// WLANs holds details about calls to the WLANs method.
+		WLANs []struct {
+		}
 	}
 	lockAdoptDevice                      sync.RWMutex
 	lockBaseURL                          sync.RWMutex
@@ -4344,6 +4515,8 @@ type ClientMock struct {
 	lockCreateVirtualDevice              sync.RWMutex
 	lockCreateWLAN                       sync.RWMutex
 	lockCreateWLANGroup                  sync.RWMutex
+	lockDNS                              sync.RWMutex
+	lockDPI                              sync.RWMutex
 	lockDelete                           sync.RWMutex
 	lockDeleteAPGroup                    sync.RWMutex
 	lockDeleteAccount                    sync.RWMutex
@@ -4382,7 +4555,10 @@ type ClientMock struct {
 	lockDeleteVirtualDevice              sync.RWMutex
 	lockDeleteWLAN                       sync.RWMutex
 	lockDeleteWLANGroup                  sync.RWMutex
+	lockDevices                          sync.RWMutex
 	lockDo                               sync.RWMutex
+	lockFeatures                         sync.RWMutex
+	lockFirewall                         sync.RWMutex
 	lockForgetDevice                     sync.RWMutex
 	lockGet                              sync.RWMutex
 	lockGetAPGroup                       sync.RWMutex
@@ -4471,6 +4647,7 @@ type ClientMock struct {
 	lockGetVirtualDevice                 sync.RWMutex
 	lockGetWLAN                          sync.RWMutex
 	lockGetWLANGroup                     sync.RWMutex
+	lockHotspot                          sync.RWMutex
 	lockInternal                         sync.RWMutex
 	lockIsFeatureEnabled                 sync.RWMutex
 	lockKickUserByMAC                    sync.RWMutex
@@ -4513,14 +4690,25 @@ type ClientMock struct {
 	lockListWLAN                         sync.RWMutex
 	lockListWLANGroup                    sync.RWMutex
 	lockLogger                           sync.RWMutex
+	lockMaps                             sync.RWMutex
+	lockNetworks                         sync.RWMutex
 	lockOfficial                         sync.RWMutex
 	lockOverrideUserFingerprint          sync.RWMutex
 	lockPatch                            sync.RWMutex
 	lockPost                             sync.RWMutex
 	lockPut                              sync.RWMutex
+	lockRADIUS                           sync.RWMutex
 	lockReorderFirewallPolicies          sync.RWMutex
 	lockReorderFirewallRules             sync.RWMutex
+	lockReports                          sync.RWMutex
+	lockRogueAPs                         sync.RWMutex
 	lockSetSetting                       sync.RWMutex
+	lockSettings                         sync.RWMutex
+	lockSites                            sync.RWMutex
+	lockSpectrumScans                    sync.RWMutex
+	lockSpeedTests                       sync.RWMutex
+	lockSystem                           sync.RWMutex
+	lockTrafficFlows                     sync.RWMutex
 	lockUnblockUserByMAC                 sync.RWMutex
 	lockUpdateAPGroup                    sync.RWMutex
 	lockUpdateAccount                    sync.RWMutex
@@ -4602,8 +4790,10 @@ type ClientMock struct {
 	lockUpdateWLANGroup                  sync.RWMutex
 	lockUploadPortalFile                 sync.RWMutex
 	lockUploadPortalFileFromReader       sync.RWMutex
+	lockUsers                            sync.RWMutex
 	lockVersion                          sync.RWMutex
 	lockVersionContext                   sync.RWMutex
+	lockWLANs                            sync.RWMutex
 }
 
 // AdoptDevice calls AdoptDeviceFunc.
@@ -6109,6 +6299,60 @@ func (mock *ClientMock) CreateWLANGroupCalls() []struct {
 	return calls
 }
 
+// DNS calls DNSFunc.
+func (mock *ClientMock) DNS() DNSClient {
+	if mock.DNSFunc == nil {
+		panic("ClientMock.DNSFunc: method is nil but Client.DNS was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockDNS.Lock()
+	mock.calls.DNS = append(mock.calls.DNS, callInfo)
+	mock.lockDNS.Unlock()
+	return mock.DNSFunc()
+}
+
+// DNSCalls gets all the calls that were made to DNS.
+// Check the length with:
+//
+//	len(mockedClient.DNSCalls())
+func (mock *ClientMock) DNSCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockDNS.RLock()
+	calls = mock.calls.DNS
+	mock.lockDNS.RUnlock()
+	return calls
+}
+
+// DPI calls DPIFunc.
+func (mock *ClientMock) DPI() DPIClient {
+	if mock.DPIFunc == nil {
+		panic("ClientMock.DPIFunc: method is nil but Client.DPI was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockDPI.Lock()
+	mock.calls.DPI = append(mock.calls.DPI, callInfo)
+	mock.lockDPI.Unlock()
+	return mock.DPIFunc()
+}
+
+// DPICalls gets all the calls that were made to DPI.
+// Check the length with:
+//
+//	len(mockedClient.DPICalls())
+func (mock *ClientMock) DPICalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockDPI.RLock()
+	calls = mock.calls.DPI
+	mock.lockDPI.RUnlock()
+	return calls
+}
+
 // Delete calls DeleteFunc.
 func (mock *ClientMock) Delete(ctx context.Context, apiPath string, reqBody any, respBody any) error {
 	if mock.DeleteFunc == nil {
@@ -7629,6 +7873,33 @@ func (mock *ClientMock) DeleteWLANGroupCalls() []struct {
 	return calls
 }
 
+// Devices calls DevicesFunc.
+func (mock *ClientMock) Devices() DevicesClient {
+	if mock.DevicesFunc == nil {
+		panic("ClientMock.DevicesFunc: method is nil but Client.Devices was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockDevices.Lock()
+	mock.calls.Devices = append(mock.calls.Devices, callInfo)
+	mock.lockDevices.Unlock()
+	return mock.DevicesFunc()
+}
+
+// DevicesCalls gets all the calls that were made to Devices.
+// Check the length with:
+//
+//	len(mockedClient.DevicesCalls())
+func (mock *ClientMock) DevicesCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockDevices.RLock()
+	calls = mock.calls.Devices
+	mock.lockDevices.RUnlock()
+	return calls
+}
+
 // Do calls DoFunc.
 func (mock *ClientMock) Do(ctx context.Context, method string, apiPath string, reqBody any, respBody any) error {
 	if mock.DoFunc == nil {
@@ -7677,6 +7948,60 @@ func (mock *ClientMock) DoCalls() []struct {
 	return calls
 }
 
+// Features calls FeaturesFunc.
+func (mock *ClientMock) Features() FeaturesClient {
+	if mock.FeaturesFunc == nil {
+		panic("ClientMock.FeaturesFunc: method is nil but Client.Features was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockFeatures.Lock()
+	mock.calls.Features = append(mock.calls.Features, callInfo)
+	mock.lockFeatures.Unlock()
+	return mock.FeaturesFunc()
+}
+
+// FeaturesCalls gets all the calls that were made to Features.
+// Check the length with:
+//
+//	len(mockedClient.FeaturesCalls())
+func (mock *ClientMock) FeaturesCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockFeatures.RLock()
+	calls = mock.calls.Features
+	mock.lockFeatures.RUnlock()
+	return calls
+}
+
+// Firewall calls FirewallFunc.
+func (mock *ClientMock) Firewall() FirewallClient {
+	if mock.FirewallFunc == nil {
+		panic("ClientMock.FirewallFunc: method is nil but Client.Firewall was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockFirewall.Lock()
+	mock.calls.Firewall = append(mock.calls.Firewall, callInfo)
+	mock.lockFirewall.Unlock()
+	return mock.FirewallFunc()
+}
+
+// FirewallCalls gets all the calls that were made to Firewall.
+// Check the length with:
+//
+//	len(mockedClient.FirewallCalls())
+func (mock *ClientMock) FirewallCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockFirewall.RLock()
+	calls = mock.calls.Firewall
+	mock.lockFirewall.RUnlock()
+	return calls
+}
+
 // ForgetDevice calls ForgetDeviceFunc.
 func (mock *ClientMock) ForgetDevice(ctx context.Context, site string, mac string) error {
 	if mock.ForgetDeviceFunc == nil {
@@ -11000,6 +11325,33 @@ func (mock *ClientMock) GetWLANGroupCalls() []struct {
 	return calls
 }
 
+// Hotspot calls HotspotFunc.
+func (mock *ClientMock) Hotspot() HotspotClient {
+	if mock.HotspotFunc == nil {
+		panic("ClientMock.HotspotFunc: method is nil but Client.Hotspot was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockHotspot.Lock()
+	mock.calls.Hotspot = append(mock.calls.Hotspot, callInfo)
+	mock.lockHotspot.Unlock()
+	return mock.HotspotFunc()
+}
+
+// HotspotCalls gets all the calls that were made to Hotspot.
+// Check the length with:
+//
+//	len(mockedClient.HotspotCalls())
+func (mock *ClientMock) HotspotCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockHotspot.RLock()
+	calls = mock.calls.Hotspot
+	mock.lockHotspot.RUnlock()
+	return calls
+}
+
 // Internal calls InternalFunc.
 func (mock *ClientMock) Internal() InternalClient {
 	if mock.InternalFunc == nil {
@@ -12498,6 +12850,60 @@ func (mock *ClientMock) LoggerCalls() []struct {
 	return calls
 }
 
+// Maps calls MapsFunc.
+func (mock *ClientMock) Maps() MapsClient {
+	if mock.MapsFunc == nil {
+		panic("ClientMock.MapsFunc: method is nil but Client.Maps was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockMaps.Lock()
+	mock.calls.Maps = append(mock.calls.Maps, callInfo)
+	mock.lockMaps.Unlock()
+	return mock.MapsFunc()
+}
+
+// MapsCalls gets all the calls that were made to Maps.
+// Check the length with:
+//
+//	len(mockedClient.MapsCalls())
+func (mock *ClientMock) MapsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockMaps.RLock()
+	calls = mock.calls.Maps
+	mock.lockMaps.RUnlock()
+	return calls
+}
+
+// Networks calls NetworksFunc.
+func (mock *ClientMock) Networks() NetworksClient {
+	if mock.NetworksFunc == nil {
+		panic("ClientMock.NetworksFunc: method is nil but Client.Networks was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockNetworks.Lock()
+	mock.calls.Networks = append(mock.calls.Networks, callInfo)
+	mock.lockNetworks.Unlock()
+	return mock.NetworksFunc()
+}
+
+// NetworksCalls gets all the calls that were made to Networks.
+// Check the length with:
+//
+//	len(mockedClient.NetworksCalls())
+func (mock *ClientMock) NetworksCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockNetworks.RLock()
+	calls = mock.calls.Networks
+	mock.lockNetworks.RUnlock()
+	return calls
+}
+
 // Official calls OfficialFunc.
 func (mock *ClientMock) Official() official.Client {
 	if mock.OfficialFunc == nil {
@@ -12701,6 +13107,33 @@ func (mock *ClientMock) PutCalls() []struct {
 	return calls
 }
 
+// RADIUS calls RADIUSFunc.
+func (mock *ClientMock) RADIUS() RADIUSClient {
+	if mock.RADIUSFunc == nil {
+		panic("ClientMock.RADIUSFunc: method is nil but Client.RADIUS was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockRADIUS.Lock()
+	mock.calls.RADIUS = append(mock.calls.RADIUS, callInfo)
+	mock.lockRADIUS.Unlock()
+	return mock.RADIUSFunc()
+}
+
+// RADIUSCalls gets all the calls that were made to RADIUS.
+// Check the length with:
+//
+//	len(mockedClient.RADIUSCalls())
+func (mock *ClientMock) RADIUSCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockRADIUS.RLock()
+	calls = mock.calls.RADIUS
+	mock.lockRADIUS.RUnlock()
+	return calls
+}
+
 // ReorderFirewallPolicies calls ReorderFirewallPoliciesFunc.
 func (mock *ClientMock) ReorderFirewallPolicies(ctx context.Context, site string, d *FirewallPolicyOrderUpdate) ([]FirewallZonePolicy, error) {
 	if mock.ReorderFirewallPoliciesFunc == nil {
@@ -12785,6 +13218,60 @@ func (mock *ClientMock) ReorderFirewallRulesCalls() []struct {
 	return calls
 }
 
+// Reports calls ReportsFunc.
+func (mock *ClientMock) Reports() ReportsClient {
+	if mock.ReportsFunc == nil {
+		panic("ClientMock.ReportsFunc: method is nil but Client.Reports was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockReports.Lock()
+	mock.calls.Reports = append(mock.calls.Reports, callInfo)
+	mock.lockReports.Unlock()
+	return mock.ReportsFunc()
+}
+
+// ReportsCalls gets all the calls that were made to Reports.
+// Check the length with:
+//
+//	len(mockedClient.ReportsCalls())
+func (mock *ClientMock) ReportsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockReports.RLock()
+	calls = mock.calls.Reports
+	mock.lockReports.RUnlock()
+	return calls
+}
+
+// RogueAPs calls RogueAPsFunc.
+func (mock *ClientMock) RogueAPs() RogueAPsClient {
+	if mock.RogueAPsFunc == nil {
+		panic("ClientMock.RogueAPsFunc: method is nil but Client.RogueAPs was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockRogueAPs.Lock()
+	mock.calls.RogueAPs = append(mock.calls.RogueAPs, callInfo)
+	mock.lockRogueAPs.Unlock()
+	return mock.RogueAPsFunc()
+}
+
+// RogueAPsCalls gets all the calls that were made to RogueAPs.
+// Check the length with:
+//
+//	len(mockedClient.RogueAPsCalls())
+func (mock *ClientMock) RogueAPsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockRogueAPs.RLock()
+	calls = mock.calls.RogueAPs
+	mock.lockRogueAPs.RUnlock()
+	return calls
+}
+
 // SetSetting calls SetSettingFunc.
 func (mock *ClientMock) SetSetting(ctx context.Context, site string, key string, reqBody any) (any, error) {
 	if mock.SetSettingFunc == nil {
@@ -12829,6 +13316,168 @@ func (mock *ClientMock) SetSettingCalls() []struct {
 	return calls
 }
 
+// Settings calls SettingsFunc.
+func (mock *ClientMock) Settings() SettingsClient {
+	if mock.SettingsFunc == nil {
+		panic("ClientMock.SettingsFunc: method is nil but Client.Settings was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockSettings.Lock()
+	mock.calls.Settings = append(mock.calls.Settings, callInfo)
+	mock.lockSettings.Unlock()
+	return mock.SettingsFunc()
+}
+
+// SettingsCalls gets all the calls that were made to Settings.
+// Check the length with:
+//
+//	len(mockedClient.SettingsCalls())
+func (mock *ClientMock) SettingsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockSettings.RLock()
+	calls = mock.calls.Settings
+	mock.lockSettings.RUnlock()
+	return calls
+}
+
+// Sites calls SitesFunc.
+func (mock *ClientMock) Sites() SitesClient {
+	if mock.SitesFunc == nil {
+		panic("ClientMock.SitesFunc: method is nil but Client.Sites was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockSites.Lock()
+	mock.calls.Sites = append(mock.calls.Sites, callInfo)
+	mock.lockSites.Unlock()
+	return mock.SitesFunc()
+}
+
+// SitesCalls gets all the calls that were made to Sites.
+// Check the length with:
+//
+//	len(mockedClient.SitesCalls())
+func (mock *ClientMock) SitesCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockSites.RLock()
+	calls = mock.calls.Sites
+	mock.lockSites.RUnlock()
+	return calls
+}
+
+// SpectrumScans calls SpectrumScansFunc.
+func (mock *ClientMock) SpectrumScans() SpectrumScansClient {
+	if mock.SpectrumScansFunc == nil {
+		panic("ClientMock.SpectrumScansFunc: method is nil but Client.SpectrumScans was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockSpectrumScans.Lock()
+	mock.calls.SpectrumScans = append(mock.calls.SpectrumScans, callInfo)
+	mock.lockSpectrumScans.Unlock()
+	return mock.SpectrumScansFunc()
+}
+
+// SpectrumScansCalls gets all the calls that were made to SpectrumScans.
+// Check the length with:
+//
+//	len(mockedClient.SpectrumScansCalls())
+func (mock *ClientMock) SpectrumScansCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockSpectrumScans.RLock()
+	calls = mock.calls.SpectrumScans
+	mock.lockSpectrumScans.RUnlock()
+	return calls
+}
+
+// SpeedTests calls SpeedTestsFunc.
+func (mock *ClientMock) SpeedTests() SpeedTestsClient {
+	if mock.SpeedTestsFunc == nil {
+		panic("ClientMock.SpeedTestsFunc: method is nil but Client.SpeedTests was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockSpeedTests.Lock()
+	mock.calls.SpeedTests = append(mock.calls.SpeedTests, callInfo)
+	mock.lockSpeedTests.Unlock()
+	return mock.SpeedTestsFunc()
+}
+
+// SpeedTestsCalls gets all the calls that were made to SpeedTests.
+// Check the length with:
+//
+//	len(mockedClient.SpeedTestsCalls())
+func (mock *ClientMock) SpeedTestsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockSpeedTests.RLock()
+	calls = mock.calls.SpeedTests
+	mock.lockSpeedTests.RUnlock()
+	return calls
+}
+
+// System calls SystemFunc.
+func (mock *ClientMock) System() SystemClient {
+	if mock.SystemFunc == nil {
+		panic("ClientMock.SystemFunc: method is nil but Client.System was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockSystem.Lock()
+	mock.calls.System = append(mock.calls.System, callInfo)
+	mock.lockSystem.Unlock()
+	return mock.SystemFunc()
+}
+
+// SystemCalls gets all the calls that were made to System.
+// Check the length with:
+//
+//	len(mockedClient.SystemCalls())
+func (mock *ClientMock) SystemCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockSystem.RLock()
+	calls = mock.calls.System
+	mock.lockSystem.RUnlock()
+	return calls
+}
+
+// TrafficFlows calls TrafficFlowsFunc.
+func (mock *ClientMock) TrafficFlows() TrafficFlowsClient {
+	if mock.TrafficFlowsFunc == nil {
+		panic("ClientMock.TrafficFlowsFunc: method is nil but Client.TrafficFlows was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockTrafficFlows.Lock()
+	mock.calls.TrafficFlows = append(mock.calls.TrafficFlows, callInfo)
+	mock.lockTrafficFlows.Unlock()
+	return mock.TrafficFlowsFunc()
+}
+
+// TrafficFlowsCalls gets all the calls that were made to TrafficFlows.
+// Check the length with:
+//
+//	len(mockedClient.TrafficFlowsCalls())
+func (mock *ClientMock) TrafficFlowsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockTrafficFlows.RLock()
+	calls = mock.calls.TrafficFlows
+	mock.lockTrafficFlows.RUnlock()
+	return calls
+}
+
 // UnblockUserByMAC calls UnblockUserByMACFunc.
 func (mock *ClientMock) UnblockUserByMAC(ctx context.Context, site string, mac string) error {
 	if mock.UnblockUserByMACFunc == nil {
@@ -16073,6 +16722,33 @@ func (mock *ClientMock) UploadPortalFileFromReaderCalls() []struct {
 	return calls
 }
 
+// Users calls UsersFunc.
+func (mock *ClientMock) Users() UsersClient {
+	if mock.UsersFunc == nil {
+		panic("ClientMock.UsersFunc: method is nil but Client.Users was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockUsers.Lock()
+	mock.calls.Users = append(mock.calls.Users, callInfo)
+	mock.lockUsers.Unlock()
+	return mock.UsersFunc()
+}
+
+// UsersCalls gets all the calls that were made to Users.
+// Check the length with:
+//
+//	len(mockedClient.UsersCalls())
+func (mock *ClientMock) UsersCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockUsers.RLock()
+	calls = mock.calls.Users
+	mock.lockUsers.RUnlock()
+	return calls
+}
+
 // Version calls VersionFunc.
 func (mock *ClientMock) Version() string {
 	if mock.VersionFunc == nil {
@@ -16131,3 +16807,30 @@ func (mock *ClientMock) VersionContextCalls() []struct {
 	mock.lockVersionContext.RUnlock()
 	return calls
 }
+
+// WLANs calls WLANsFunc.
+func (mock *ClientMock) WLANs() WLANsClient {
+	if mock.WLANsFunc == nil {
+		panic("ClientMock.WLANsFunc: method is nil but Client.WLANs was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockWLANs.Lock()
+	mock.calls.WLANs = append(mock.calls.WLANs, callInfo)
+	mock.lockWLANs.Unlock()
+	return mock.WLANsFunc()
+}
+
+// WLANsCalls gets all the calls that were made to WLANs.
+// Check the length with:
+//
+//	len(mockedClient.WLANsCalls())
+func (mock *ClientMock) WLANsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockWLANs.RLock()
+	calls = mock.calls.WLANs
+	mock.lockWLANs.RUnlock()
+	return calls
+}
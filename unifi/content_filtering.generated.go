@@ -16,6 +16,25 @@ var (
 	_ json.Marshaler
 )
 
+// Self-register this resource's kind so the resourceKinds registry in
+// resource_registry.go mirrors the generated Client surface and can never
+// drift from it by hand.
+func init() { //nolint:gochecknoinits
+	registerResourceKind(ResourceKind{
+		Name:       "ContentFiltering",
+		Endpoint:   "content-filtering",
+		APIVersion: APIVersionV2,
+		IDField:    "_id",
+		NameField:  "name",
+		SiteScoped: true,
+	}, resourceOps[ContentFiltering]{
+		list:   InternalClient.ListContentFiltering,
+		create: InternalClient.CreateContentFiltering,
+		update: InternalClient.UpdateContentFiltering,
+		delete: InternalClient.DeleteContentFiltering,
+	})
+}
+
 type ContentFiltering struct {
 	ID     string `json:"_id,omitempty"`
 	SiteID string `json:"site_id,omitempty"`
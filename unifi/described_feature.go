@@ -9,6 +9,8 @@ func (c *client) ListFeatures(ctx context.Context, site string) ([]DescribedFeat
 	return c.listDescribedFeature(ctx, site)
 }
 
+// GetFeature filters ListFeatures by name, case-insensitively: features are
+// addressed by name, which the controller offers no endpoint or filter for.
 func (c *client) GetFeature(ctx context.Context, site string, name string) (*DescribedFeature, error) {
 	features, err := c.ListFeatures(ctx, site)
 	if err != nil {
@@ -3,6 +3,7 @@ package unifi
 import (
 	"context"
 	"fmt"
+	"strings"
 )
 
 //go:generate go run golang.org/x/tools/cmd/stringer -trimprefix DeviceState -type DeviceState
@@ -27,8 +28,36 @@ func (c *client) ListDevice(ctx context.Context, site string) ([]Device, error)
 	return c.listDevice(ctx, site)
 }
 
+// GetDeviceByMAC reads stat/device/{mac}, or filters stat/device by MAC on the
+// controller when that endpoint is missing.
 func (c *client) GetDeviceByMAC(ctx context.Context, site, mac string) (*Device, error) {
-	return c.getDevice(ctx, site, mac)
+	mac = strings.ToLower(mac)
+	return lookupOne(c, lookupDeviceByMAC,
+		func() (*Device, error) { return c.getDevice(ctx, site, mac) },
+		func() ([]Device, error) { return c.listDeviceByMAC(ctx, site, mac) },
+		func(d *Device) bool { return strings.EqualFold(d.MAC, mac) })
+}
+
+// listDeviceByMAC lists the devices of site with the given MACs, filtered by
+// the controller.
+func (c *client) listDeviceByMAC(ctx context.Context, site string, macs ...string) ([]Device, error) {
+	reqBody := struct {
+		MACs []string `json:"macs"`
+	}{
+		MACs: macs,
+	}
+
+	var respBody struct {
+		Meta Meta     `json:"meta"`
+		Data []Device `json:"data"`
+	}
+
+	err := c.Post(ctx, fmt.Sprintf("s/%s/stat/device", site), reqBody, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return respBody.Data, nil
 }
 
 func (c *client) DeleteDevice(ctx context.Context, site, id string) error {
@@ -43,19 +72,30 @@ func (c *client) UpdateDevice(ctx context.Context, site string, d *Device) (*Dev
 	return c.updateDevice(ctx, site, d)
 }
 
+// GetDevice resolves the device's MAC from rest/device/{id} and reads its
+// state with GetDeviceByMAC, instead of listing every device of the site. It
+// lists and filters when the controller lacks rest/device/{id}.
 func (c *client) GetDevice(ctx context.Context, site, id string) (*Device, error) {
-	devices, err := c.ListDevice(ctx, site)
-	if err != nil {
-		return nil, err
-	}
-
-	for _, d := range devices {
-		if d.ID == id {
-			return &d, nil
-		}
-	}
-
-	return nil, ErrNotFound
+	return lookupOne(c, lookupDeviceByID,
+		func() (*Device, error) {
+			var respBody struct {
+				Meta Meta `json:"meta"`
+				Data []struct {
+					MAC string `json:"mac"`
+				} `json:"data"`
+			}
+
+			err := c.Get(ctx, fmt.Sprintf("s/%s/rest/device/%s", site, id), nil, &respBody)
+			if err != nil {
+				return nil, err
+			}
+			if len(respBody.Data) != 1 || respBody.Data[0].MAC == "" {
+				return nil, ErrNotFound
+			}
+			return c.GetDeviceByMAC(ctx, site, respBody.Data[0].MAC)
+		},
+		func() ([]Device, error) { return c.ListDevice(ctx, site) },
+		func(d *Device) bool { return d.ID == id })
 }
 
 func (c *client) AdoptDevice(ctx context.Context, site, mac string) error {
@@ -10,8 +10,9 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-// TestGetDevice asserts the linear search in GetDevice: a matching ID is returned
-// and a miss maps to ErrNotFound (via the %w-safe sentinel).
+// TestGetDevice asserts the list fallback of GetDevice on a controller without
+// rest/device/{id}: a matching ID is returned and a miss maps to ErrNotFound
+// (via the %w-safe sentinel).
 func TestGetDevice(t *testing.T) {
 	t.Parallel()
 
@@ -49,6 +50,76 @@ func TestGetDevice(t *testing.T) {
 	}
 }
 
+// TestGetDeviceByID asserts GetDevice reads the one device it needs: its MAC
+// from rest/device/{id}, then its state from stat/device/{mac}, without listing
+// the site.
+func TestGetDeviceByID(t *testing.T) {
+	t.Parallel()
+
+	const site = "default"
+	cs := newControllerServer(t,
+		route{apiV1Path("s/" + site + "/rest/device/d2"), func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"_id":"d2","mac":"AA:BB:CC:DD:EE:02"}]}`))
+		}},
+		route{apiV1Path("s/" + site + "/rest/device/nope"), func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[]}`))
+		}},
+		route{apiV1Path("s/" + site + "/stat/device/aa:bb:cc:dd:ee:02"), func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"_id":"d2","mac":"aa:bb:cc:dd:ee:02","state":1}]}`))
+		}},
+		route{apiV1Path("s/" + site + "/stat/device"), func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"_id":"d2","mac":"aa:bb:cc:dd:ee:02"}]}`))
+		}},
+	)
+	c := cs.client()
+
+	got, err := c.GetDevice(context.Background(), site, "d2")
+	require.NoError(t, err)
+	assert.Equal(t, DeviceStateConnected, got.State)
+	assert.Equal(t, 2, cs.requestCount())
+	req := cs.lastRequest()
+	assert.Equal(t, http.MethodGet, req.Method)
+	assert.Equal(t, apiV1Path("s/"+site+"/stat/device/aa:bb:cc:dd:ee:02"), req.Path)
+
+	// A missing device is confirmed against the list, and does not mark the
+	// endpoint as unsupported.
+	_, err = c.GetDevice(context.Background(), site, "nope")
+	require.ErrorIs(t, err, ErrNotFound)
+	_, err = c.GetDevice(context.Background(), site, "d2")
+	require.NoError(t, err)
+	assert.Equal(t, 2, cs.countRequestsTo(apiV1Path("s/"+site+"/rest/device/d2")))
+}
+
+// TestGetDeviceByMACFallback asserts GetDeviceByMAC falls back from
+// stat/device/{mac} to the controller-side macs filter, and skips the missing
+// endpoint once the filter has found the device.
+func TestGetDeviceByMACFallback(t *testing.T) {
+	t.Parallel()
+
+	const (
+		site = "default"
+		mac  = "aa:bb:cc:dd:ee:01"
+	)
+	path := apiV1Path("s/" + site + "/stat/device")
+	cs := newControllerServer(t, route{path, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"_id":"d1","mac":"aa:bb:cc:dd:ee:01"}]}`))
+	}})
+	c := cs.client()
+
+	for range 2 {
+		got, err := c.GetDeviceByMAC(context.Background(), site, "AA:BB:CC:DD:EE:01")
+		require.NoError(t, err)
+		assert.Equal(t, "d1", got.ID)
+
+		req := cs.lastRequest()
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, path, req.Path)
+		assert.JSONEq(t, `{"macs":["`+mac+`"]}`, string(req.Body))
+	}
+	assert.Equal(t, 1, cs.countRequestsTo(path+"/"+mac), "the missing endpoint is tried once")
+	assert.Equal(t, 3, cs.requestCount())
+}
+
 // TestListDeviceUnwrapsEnvelope asserts ListDevice unwraps the {meta,data}
 // envelope to the inner slice.
 func TestListDeviceUnwrapsEnvelope(t *testing.T) {
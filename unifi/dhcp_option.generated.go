@@ -16,6 +16,26 @@ var (
 	_ json.Marshaler
 )
 
+// Self-register this resource's kind so the resourceKinds registry in
+// resource_registry.go mirrors the generated Client surface and can never
+// drift from it by hand.
+func init() { //nolint:gochecknoinits
+	registerResourceKind(ResourceKind{
+		Name:       "DHCPOption",
+		Endpoint:   "dhcpoption",
+		APIVersion: APIVersionV1,
+		IDField:    "_id",
+		NameField:  "name",
+		SiteScoped: true,
+	}, resourceOps[DHCPOption]{
+		list:   InternalClient.ListDHCPOption,
+		get:    InternalClient.GetDHCPOption,
+		create: InternalClient.CreateDHCPOption,
+		update: InternalClient.UpdateDHCPOption,
+		delete: InternalClient.DeleteDHCPOption,
+	})
+}
+
 type DHCPOption struct {
 	ID     string `json:"_id,omitempty"`
 	SiteID string `json:"site_id,omitempty"`
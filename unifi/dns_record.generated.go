@@ -16,6 +16,26 @@ var (
 	_ json.Marshaler
 )
 
+// Self-register this resource's kind so the resourceKinds registry in
+// resource_registry.go mirrors the generated Client surface and can never
+// drift from it by hand.
+func init() { //nolint:gochecknoinits
+	registerResourceKind(ResourceKind{
+		Name:       "DNSRecord",
+		Endpoint:   "static-dns",
+		APIVersion: APIVersionV2,
+		IDField:    "_id",
+		NameField:  "",
+		SiteScoped: true,
+	}, resourceOps[DNSRecord]{
+		list:   InternalClient.ListDNSRecord,
+		get:    InternalClient.GetDNSRecord,
+		create: InternalClient.CreateDNSRecord,
+		update: InternalClient.UpdateDNSRecord,
+		delete: InternalClient.DeleteDNSRecord,
+	})
+}
+
 type DNSRecord struct {
 	ID     string `json:"_id,omitempty"`
 	SiteID string `json:"site_id,omitempty"`
@@ -8,19 +8,13 @@ func (c *client) ListDNSRecord(ctx context.Context, site string) ([]DNSRecord, e
 	return c.listDNSRecord(ctx, site)
 }
 
+// GetDNSRecord reads static-dns/{id}, or lists and filters the records on
+// controllers that lack that endpoint.
 func (c *client) GetDNSRecord(ctx context.Context, site, id string) (*DNSRecord, error) {
-	// client-side filtering is needed, because of lack of endpoint
-	records, err := c.listDNSRecord(ctx, site)
-	if err != nil {
-		return nil, err
-	}
-
-	for _, record := range records {
-		if record.ID == id {
-			return &record, nil
-		}
-	}
-	return nil, ErrNotFound
+	return lookupOne(c, lookupDNSRecord,
+		func() (*DNSRecord, error) { return c.getDNSRecord(ctx, site, id) },
+		func() ([]DNSRecord, error) { return c.listDNSRecord(ctx, site) },
+		func(r *DNSRecord) bool { return r.ID == id })
 }
 
 func (c *client) DeleteDNSRecord(ctx context.Context, site, id string) error {
@@ -15,8 +15,8 @@
 //
 // The client exposes two surfaces:
 //
-//   - Internal — the legacy Network API (all generated resource methods, e.g. GetNetwork,
-//     ListUser). Reachable directly on the client or via [Client.Internal].
+//   - Internal — the legacy Network API, grouped into per-resource sub-clients (e.g.
+//     Networks().Get, Users().List). Reachable directly on the client or via [Client.Internal].
 //   - Official — the official UniFi OpenAPI (integration/v1), reached via [Client.Official].
 //     Requires controller ≥ 10.1.78 with API-key auth. Operations return
 //     [ErrOfficialAPIUnavailable] on unsupported controllers.
@@ -24,6 +24,28 @@
 // In 2.0.0 the Internal surface remains the default: existing code calling resource methods
 // directly on the client is unaffected. 3.0.0 is expected to flip the default to Official.
 //
+// # Resource groups
+//
+// The Internal surface mirrors the Official one: each accessor returns the methods of
+// one resource group, and each group ships a func-field mock (e.g. [NetworksClientMock])
+// so tests stub only what they call:
+//
+//	networks, err := c.Networks().List(ctx, "default")
+//	err = c.Devices().Adopt(ctx, "default", mac)
+//	mgmt, err := c.Settings().GetMgmt(ctx, "default")
+//
+// The flat methods (ListNetwork, AdoptDevice, ...) are deprecated aliases of the grouped
+// ones and behave identically.
+//
+// # Generic resources
+//
+// Every generated CRUD resource registers a [ResourceKind] (endpoint, API version,
+// ID/name fields, site scope). [Resource] returns a typed [ResourceClient] for one
+// kind, and [ResourceKinds] with [DynamicResource] lets tooling walk all of them:
+//
+//	networks, err := unifi.Resource[unifi.Network](c)
+//	all, err := networks.List(ctx, "default")
+//
 // # Concurrency
 //
 // A *client (and anything obtained from it) is safe for concurrent use by multiple goroutines.
@@ -0,0 +1,232 @@
+package unifi
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// DPIGrouping selects how DPI statistics are aggregated.
+type DPIGrouping string
+
+const (
+	// DPIByApplication reports one DPIStat per application.
+	DPIByApplication DPIGrouping = "by_app"
+	// DPIByCategory reports one DPIStat per category, with Application zero.
+	DPIByCategory DPIGrouping = "by_cat"
+)
+
+// DPIStat is the traffic deep packet inspection attributed to an application
+// or category, since DPI was enabled (see SettingDpi) or its counters were
+// last reset.
+type DPIStat struct {
+	Application int   `json:"app"`
+	Category    int   `json:"cat"`
+	RxBytes     int64 `json:"rx_bytes"`
+	TxBytes     int64 `json:"tx_bytes"`
+	RxPackets   int64 `json:"rx_packets"`
+	TxPackets   int64 `json:"tx_packets"`
+	// KnownClients is the number of clients the traffic came from, in site
+	// statistics.
+	KnownClients int `json:"known_clients,omitempty"`
+
+	// ApplicationName and CategoryName are resolved from the DPI catalog, see
+	// DPI().GetCatalog; they are empty when it is unavailable or does not know
+	// the IDs.
+	ApplicationName string `json:"-"`
+	CategoryName    string `json:"-"`
+}
+
+// TotalBytes returns the bytes received and sent.
+func (s DPIStat) TotalBytes() int64 {
+	return s.RxBytes + s.TxBytes
+}
+
+// ApplicationID returns the application's ID in the DPI catalog, which
+// combines the category and the per-category application number.
+func (s DPIStat) ApplicationID() int {
+	return s.Category<<16 | s.Application
+}
+
+// ClientDPIStats are the DPI statistics of one client.
+type ClientDPIStats struct {
+	MAC   string
+	Stats []DPIStat
+}
+
+// dpiStatsEntry is an element of the stat/sitedpi and stat/stadpi responses,
+// holding the statistics under the key of their grouping.
+type dpiStatsEntry struct {
+	MAC        string    `json:"mac"`
+	ByApp      []DPIStat `json:"by_app"`
+	ByCategory []DPIStat `json:"by_cat"`
+}
+
+func (e dpiStatsEntry) stats(by DPIGrouping) []DPIStat {
+	if by == DPIByCategory {
+		return e.ByCategory
+	}
+	return e.ByApp
+}
+
+func validDPIGrouping(by DPIGrouping) error {
+	if by != DPIByApplication && by != DPIByCategory {
+		return fmt.Errorf("unknown DPI grouping %q", by)
+	}
+	return nil
+}
+
+// GetSiteDPIStats implements DPI().GetSiteStats: it returns the site's DPI
+// statistics, grouped by application or by category, with names resolved from
+// the DPI catalog when available.
+func (c *client) GetSiteDPIStats(ctx context.Context, site string, by DPIGrouping) ([]DPIStat, error) {
+	if err := validDPIGrouping(by); err != nil {
+		return nil, err
+	}
+	reqBody := struct {
+		Type DPIGrouping `json:"type"`
+	}{Type: by}
+	var respBody struct {
+		Meta Meta            `json:"meta"`
+		Data []dpiStatsEntry `json:"data"`
+	}
+
+	err := c.Post(ctx, fmt.Sprintf("s/%s/stat/sitedpi", site), reqBody, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var stats []DPIStat
+	for _, e := range respBody.Data {
+		stats = append(stats, e.stats(by)...)
+	}
+	c.resolveDPINames(ctx, stats)
+	return stats, nil
+}
+
+// GetClientDPIStats implements DPI().GetClientStats: it returns the DPI
+// statistics of the clients with the given MAC addresses, or of every client
+// when none is given, grouped by application or by category, with names
+// resolved from the DPI catalog when available.
+func (c *client) GetClientDPIStats(ctx context.Context, site string, by DPIGrouping, macs ...string) ([]ClientDPIStats, error) {
+	if err := validDPIGrouping(by); err != nil {
+		return nil, err
+	}
+	reqBody := struct {
+		Type DPIGrouping `json:"type"`
+		MACs []string    `json:"macs,omitempty"`
+	}{Type: by, MACs: macs}
+	var respBody struct {
+		Meta Meta            `json:"meta"`
+		Data []dpiStatsEntry `json:"data"`
+	}
+
+	err := c.Post(ctx, fmt.Sprintf("s/%s/stat/stadpi", site), reqBody, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	clients := make([]ClientDPIStats, 0, len(respBody.Data))
+	stats := make([][]DPIStat, 0, len(respBody.Data))
+	for _, e := range respBody.Data {
+		clients = append(clients, ClientDPIStats{MAC: e.MAC, Stats: e.stats(by)})
+		stats = append(stats, e.stats(by))
+	}
+	c.resolveDPINames(ctx, stats...)
+	return clients, nil
+}
+
+// GetClientTopApplications implements DPI().GetClientTopApplications: it
+// returns the n applications the client exchanged the most traffic with, by
+// total bytes, or all of them when n <= 0.
+func (c *client) GetClientTopApplications(ctx context.Context, site, mac string, n int) ([]DPIStat, error) {
+	clients, err := c.GetClientDPIStats(ctx, site, DPIByApplication, mac)
+	if err != nil {
+		return nil, err
+	}
+	var stats []DPIStat
+	for _, cl := range clients {
+		stats = append(stats, cl.Stats...)
+	}
+	slices.SortStableFunc(stats, func(a, b DPIStat) int {
+		return cmp.Compare(b.TotalBytes(), a.TotalBytes())
+	})
+	if n > 0 && len(stats) > n {
+		stats = stats[:n]
+	}
+	return stats, nil
+}
+
+// DPICatalog maps DPI application and category IDs to their names.
+type DPICatalog struct {
+	// Applications is keyed by DPIStat.ApplicationID, Categories by
+	// DPIStat.Category.
+	Applications map[int]string
+	Categories   map[int]string
+}
+
+// Resolve sets the ApplicationName and CategoryName of stats.
+func (cat *DPICatalog) Resolve(stats []DPIStat) {
+	for i := range stats {
+		s := &stats[i]
+		s.CategoryName = cat.Categories[s.Category]
+		if s.Application != 0 {
+			s.ApplicationName = cat.Applications[s.ApplicationID()]
+		}
+	}
+}
+
+/*
+GetDPICatalog implements DPI().GetCatalog: it returns the names of the DPI
+applications and categories, from the Official API's supporting catalogs. It requires the Official API (see
+ErrOfficialAPIUnavailable).
+
+The catalog is fetched once and kept for the life of the client; it only
+changes with the controller's DPI signatures. DPI().GetSiteStats and
+DPI().GetClientStats use it to name their statistics.
+*/
+func (c *client) GetDPICatalog(ctx context.Context) (*DPICatalog, error) {
+	c.dpiCatalogMu.Lock()
+	defer c.dpiCatalogMu.Unlock()
+	if c.dpiCatalog != nil {
+		return c.dpiCatalog, nil
+	}
+
+	cat := &DPICatalog{Applications: map[int]string{}, Categories: map[int]string{}}
+	supporting := c.Official().Supporting()
+	for app, err := range supporting.ListDpiApplicationsAll(ctx, "") {
+		if err != nil {
+			return nil, err
+		}
+		cat.Applications[int(app.Id)] = app.Name
+	}
+	for category, err := range supporting.ListDpiApplicationCategoriesAll(ctx, "") {
+		if err != nil {
+			return nil, err
+		}
+		cat.Categories[int(category.Id)] = category.Name
+	}
+	c.dpiCatalog = cat
+	return cat, nil
+}
+
+// resolveDPINames names stats from the DPI catalog. It is best effort: the
+// statistics are still useful by ID, so a controller without the Official API
+// or a failing catalog leaves the names empty.
+func (c *client) resolveDPINames(ctx context.Context, stats ...[]DPIStat) {
+	if !slices.ContainsFunc(stats, func(s []DPIStat) bool { return len(s) > 0 }) {
+		return
+	}
+	cat, err := c.GetDPICatalog(ctx)
+	if err != nil {
+		if !errors.Is(err, ErrOfficialAPIUnavailable) && !errors.Is(err, ErrOfficialAPIDisabled) {
+			c.log.Warnf("failed fetching the DPI catalog, DPI statistics are not named: %s", err)
+		}
+		return
+	}
+	for _, s := range stats {
+		cat.Resolve(s)
+	}
+}
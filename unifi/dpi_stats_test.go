@@ -0,0 +1,99 @@
+package unifi //nolint: testpackage
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dpiCatalogRoutes serve the Official API DPI catalogs: application 3<<16|5
+// ("YouTube", category 3 "Media streaming") and 3<<16|7 ("Netflix").
+func dpiCatalogRoutes() []route {
+	return []route{
+		infoRoute("10.1.78"),
+		{integrationV1Path + "/dpi/applications", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"offset":0,"limit":200,"count":2,"totalCount":2,"data":[{"id":196613,"name":"YouTube"},{"id":196615,"name":"Netflix"}]}`))
+		}},
+		{integrationV1Path + "/dpi/categories", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"offset":0,"limit":200,"count":1,"totalCount":1,"data":[{"id":3,"name":"Media streaming"}]}`))
+		}},
+	}
+}
+
+func TestGetSiteDPIStats(t *testing.T) {
+	t.Parallel()
+	routes := append(dpiCatalogRoutes(), route{apiV1Path("s/default/stat/sitedpi"), func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{
+			"by_app":[{"app":5,"cat":3,"rx_bytes":100,"tx_bytes":20,"known_clients":2},{"app":9,"cat":4,"rx_bytes":1}],
+			"by_cat":[{"cat":3,"rx_bytes":120}]
+		}]}`))
+	}})
+	cs := newControllerServer(t, routes...)
+	c := cs.client()
+	ctx := context.Background()
+
+	stats, err := c.GetSiteDPIStats(ctx, "default", DPIByApplication)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"by_app"}`, string(cs.lastRequestTo(apiV1Path("s/default/stat/sitedpi")).Body))
+	require.Len(t, stats, 2)
+	assert.Equal(t, DPIStat{Application: 5, Category: 3, RxBytes: 100, TxBytes: 20, KnownClients: 2, ApplicationName: "YouTube", CategoryName: "Media streaming"}, stats[0])
+	assert.Equal(t, int64(120), stats[0].TotalBytes())
+	assert.Empty(t, stats[1].ApplicationName, "unknown IDs stay unnamed")
+
+	categories, err := c.GetSiteDPIStats(ctx, "default", DPIByCategory)
+	require.NoError(t, err)
+	require.Len(t, categories, 1)
+	assert.Equal(t, "Media streaming", categories[0].CategoryName)
+	assert.Empty(t, categories[0].ApplicationName)
+
+	assert.Equal(t, 1, cs.countRequestsTo(integrationV1Path+"/dpi/applications"), "the catalog is fetched once")
+
+	_, err = c.GetSiteDPIStats(ctx, "default", "by_day")
+	require.Error(t, err)
+}
+
+func TestGetClientDPIStats(t *testing.T) {
+	t.Parallel()
+	routes := append(dpiCatalogRoutes(), route{apiV1Path("s/default/stat/stadpi"), func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[
+			{"mac":"aa:bb:cc:dd:ee:01","by_app":[{"app":5,"cat":3,"rx_bytes":10},{"app":7,"cat":3,"rx_bytes":500,"tx_bytes":50},{"app":9,"cat":4,"tx_bytes":100}]},
+			{"mac":"aa:bb:cc:dd:ee:02","by_app":[{"app":7,"cat":3,"rx_bytes":1}]}
+		]}`))
+	}})
+	cs := newControllerServer(t, routes...)
+	c := cs.client()
+	ctx := context.Background()
+
+	clients, err := c.GetClientDPIStats(ctx, "default", DPIByApplication, "aa:bb:cc:dd:ee:01", "aa:bb:cc:dd:ee:02")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"by_app","macs":["aa:bb:cc:dd:ee:01","aa:bb:cc:dd:ee:02"]}`, string(cs.lastRequestTo(apiV1Path("s/default/stat/stadpi")).Body))
+	require.Len(t, clients, 2)
+	assert.Equal(t, "aa:bb:cc:dd:ee:02", clients[1].MAC)
+	assert.Equal(t, "Netflix", clients[1].Stats[0].ApplicationName)
+
+	top, err := c.GetClientTopApplications(ctx, "default", "aa:bb:cc:dd:ee:01", 2)
+	require.NoError(t, err)
+	require.Len(t, top, 2)
+	assert.Equal(t, "Netflix", top[0].ApplicationName)
+	assert.Equal(t, 9, top[1].Application)
+}
+
+func TestDPIStatsWithoutOfficialAPI(t *testing.T) {
+	t.Parallel()
+	cs := newControllerServer(t, route{apiV1Path("s/default/stat/sitedpi"), func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"by_app":[{"app":5,"cat":3,"rx_bytes":100}]}]}`))
+	}})
+	c := cs.clientWith(func(cfg *ClientConfig) { cfg.DisableOfficialAPI = true })
+
+	stats, err := c.GetSiteDPIStats(context.Background(), "default", DPIByApplication)
+	require.NoError(t, err, "names are best effort")
+	require.Len(t, stats, 1)
+	assert.Equal(t, 5, stats[0].Application)
+	assert.Empty(t, stats[0].ApplicationName)
+
+	_, err = c.GetDPICatalog(context.Background())
+	require.ErrorIs(t, err, ErrOfficialAPIDisabled)
+}
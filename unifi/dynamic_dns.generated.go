@@ -16,6 +16,26 @@ var (
 	_ json.Marshaler
 )
 
+// Self-register this resource's kind so the resourceKinds registry in
+// resource_registry.go mirrors the generated Client surface and can never
+// drift from it by hand.
+func init() { //nolint:gochecknoinits
+	registerResourceKind(ResourceKind{
+		Name:       "DynamicDNS",
+		Endpoint:   "dynamicdns",
+		APIVersion: APIVersionV1,
+		IDField:    "_id",
+		NameField:  "",
+		SiteScoped: true,
+	}, resourceOps[DynamicDNS]{
+		list:   InternalClient.ListDynamicDNS,
+		get:    InternalClient.GetDynamicDNS,
+		create: InternalClient.CreateDynamicDNS,
+		update: InternalClient.UpdateDynamicDNS,
+		delete: InternalClient.DeleteDynamicDNS,
+	})
+}
+
 type DynamicDNS struct {
 	ID     string `json:"_id,omitempty"`
 	SiteID string `json:"site_id,omitempty"`
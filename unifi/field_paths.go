@@ -0,0 +1,230 @@
+package unifi
+
+import (
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// FieldError attributes one validation failure to a field of the request
+// struct, so a UI or a Terraform provider can attach it to the right
+// attribute. It is produced by ServerError.FieldErrors for the controller's
+// rejections and by ValidationError.FieldErrors for client-side ones.
+type FieldError struct {
+	// Path is the Go field path within the request struct, e.g. "XPassphrase"
+	// or "PrivatePresharedKeys[1].Password". It is "" when the field could not
+	// be resolved against the request type.
+	Path string
+	// WirePath is the JSON path of the field, e.g. "x_passphrase" or
+	// "private_preshared_keys[1].password".
+	WirePath string
+	// Message describes the failure, when one was reported.
+	Message string
+	// Pattern is the regular expression the controller expected the value to
+	// match, when it reported one.
+	Pattern string
+}
+
+// ResolveFieldPath maps a JSON wire path reported by the controller (e.g.
+// "x_passphrase", "private_preshared_keys[1].password" or the dotted
+// "private_preshared_keys.1.password") to the Go field path within v, a
+// struct value, a pointer to one or a reflect.Type of either. It reports false
+// when a segment does not name a field of the type.
+//
+// An index into a slice, array or map is carried over as "[1]"; a name that
+// follows a slice without an index resolves against its element type and is
+// rendered with "[]" (e.g. "PrivatePresharedKeys[].Password").
+func ResolveFieldPath(v any, wirePath string) (string, bool) {
+	t, ok := v.(reflect.Type)
+	if !ok {
+		t = reflect.TypeOf(v)
+	}
+	return translateFieldPath(t, wirePath, true)
+}
+
+// fieldPathSegment is one step of a field path: a field name, or an index into
+// the preceding slice, array or map.
+type fieldPathSegment struct {
+	name  string
+	index string
+	isIdx bool
+}
+
+// parseFieldPath splits "a[1].b", "a.1.b" and "a[key].b" into segments. A
+// purely numeric dotted segment is an index.
+func parseFieldPath(p string) []fieldPathSegment {
+	var segs []fieldPathSegment
+	for part := range strings.SplitSeq(p, ".") {
+		name, rest, _ := strings.Cut(part, "[")
+		if name != "" {
+			if _, err := strconv.Atoi(name); err == nil {
+				segs = append(segs, fieldPathSegment{index: name, isIdx: true})
+			} else {
+				segs = append(segs, fieldPathSegment{name: name})
+			}
+		}
+		for rest != "" {
+			idx, after, _ := strings.Cut(rest, "]")
+			segs = append(segs, fieldPathSegment{index: idx, isIdx: true})
+			_, rest, _ = strings.Cut(after, "[")
+		}
+	}
+	return segs
+}
+
+// translateFieldPath walks path over t, translating JSON names to Go names
+// (fromWire) or Go names to JSON names, and renders the result in the bracket
+// form.
+func translateFieldPath(t reflect.Type, path string, fromWire bool) (string, bool) {
+	segs := parseFieldPath(path)
+	if t == nil || len(segs) == 0 {
+		return "", false
+	}
+	var b strings.Builder
+	for i, seg := range segs {
+		t = derefType(t)
+		if seg.isIdx {
+			switch t.Kind() {
+			case reflect.Slice, reflect.Array, reflect.Map:
+				b.WriteString("[" + seg.index + "]")
+				t = t.Elem()
+				continue
+			default:
+				return "", false
+			}
+		}
+		switch t.Kind() {
+		case reflect.Slice, reflect.Array:
+			// A name after a collection without an index: the element's field.
+			b.WriteString("[]")
+			t = derefType(t.Elem())
+		case reflect.Struct:
+		default:
+			return "", false
+		}
+		if t.Kind() != reflect.Struct {
+			return "", false
+		}
+		names := structFieldNames(t)
+		lookup := names.byGo
+		if fromWire {
+			lookup = names.byWire
+		}
+		f, ok := lookup[seg.name]
+		if !ok {
+			return "", false
+		}
+		if i > 0 {
+			b.WriteByte('.')
+		}
+		if fromWire {
+			b.WriteString(f.goName)
+		} else {
+			b.WriteString(f.wireName)
+		}
+		t = f.typ
+	}
+	return b.String(), true
+}
+
+func derefType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// structField is one JSON-visible field of a struct, keyed both ways in
+// fieldNames.
+type structField struct {
+	goName   string
+	wireName string
+	typ      reflect.Type
+}
+
+type fieldNames struct {
+	byWire map[string]structField
+	byGo   map[string]structField
+}
+
+// fieldNamesCache maps a struct reflect.Type to its fieldNames.
+var fieldNamesCache sync.Map
+
+// structFieldNames indexes the exported fields of struct type t by their JSON
+// and Go names, following encoding/json: a "-" tag hides a field, an untagged
+// field is named for the Go field, and the fields of an embedded struct are
+// promoted unless the outer struct declares the same name.
+func structFieldNames(t reflect.Type) fieldNames {
+	if cached, ok := fieldNamesCache.Load(t); ok {
+		return cached.(fieldNames) //nolint:forcetypeassert
+	}
+	names := fieldNames{byWire: map[string]structField{}, byGo: map[string]structField{}}
+	var embedded []reflect.Type
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		wire, _, _ := strings.Cut(tag, ",")
+		if sf.Anonymous && wire == "" && derefType(sf.Type).Kind() == reflect.Struct {
+			embedded = append(embedded, derefType(sf.Type))
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		if wire == "" {
+			wire = sf.Name
+		}
+		f := structField{goName: sf.Name, wireName: wire, typ: sf.Type}
+		names.byWire[wire] = f
+		names.byGo[sf.Name] = f
+	}
+	for _, et := range embedded {
+		inner := structFieldNames(et)
+		for wire, f := range inner.byWire {
+			if _, ok := names.byWire[wire]; !ok {
+				names.byWire[wire] = f
+			}
+		}
+		for goName, f := range inner.byGo {
+			if _, ok := names.byGo[goName]; !ok {
+				names.byGo[goName] = f
+			}
+		}
+	}
+	cached, _ := fieldNamesCache.LoadOrStore(t, names)
+	return cached.(fieldNames) //nolint:forcetypeassert
+}
+
+// requestResourceType returns the Go type of the resource a request URL
+// addresses: the registered kind for its endpoint, or the setting type for a
+// set/setting/<key> or rest/setting/<key> path. It returns nil when the path
+// maps to neither.
+func requestResourceType(method, rawURL string) reflect.Type {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil
+	}
+	op := describeOperation(method, u.Path)
+	if op.Surface != "internal" {
+		return nil
+	}
+	if kind, ok := LookupResourceKind(op.Resource); ok {
+		return kind.Type
+	}
+	if op.Resource != "setting" {
+		return nil
+	}
+	segs := splitPath(u.Path)
+	if i := slices.Index(segs, "setting"); i >= 0 && i+1 < len(segs) {
+		if factory, ok := settingFactories[segs[i+1]]; ok {
+			return reflect.TypeOf(factory())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package unifi //nolint: testpackage
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveFieldPath(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		v        any
+		wirePath string
+		want     string
+		wantOK   bool
+	}{
+		"top-level secret":       {WLAN{}, "x_passphrase", "XPassphrase", true},
+		"pointer value":          {&WLAN{}, "name", "Name", true},
+		"reflect type":           {reflect.TypeFor[WLAN](), "wpa3_transition", "WPA3Transition", true},
+		"bracket index":          {WLAN{}, "private_preshared_keys[1].password", "PrivatePresharedKeys[1].Password", true},
+		"dotted index":           {WLAN{}, "private_preshared_keys.1.password", "PrivatePresharedKeys[1].Password", true},
+		"slice without index":    {WLAN{}, "private_preshared_keys.password", "PrivatePresharedKeys[].Password", true},
+		"nested struct":          {FirewallZonePolicy{}, "source.matching_target", "Source.MatchingTarget", true},
+		"unknown field":          {WLAN{}, "x_nope", "", false},
+		"unknown nested field":   {WLAN{}, "private_preshared_keys[0].nope", "", false},
+		"index into a scalar":    {WLAN{}, "name[0]", "", false},
+		"empty path":             {WLAN{}, "", "", false},
+		"nil type":               {nil, "name", "", false},
+		"untagged and embedded":  {embeddingFields{}, "Plain", "Plain", true},
+		"promoted embedded":      {embeddingFields{}, "inner_name", "InnerName", true},
+		"outer shadows embedded": {embeddingFields{}, "shadowed", "Shadowed", true},
+		"hidden field":           {embeddingFields{}, "Hidden", "", false},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := ResolveFieldPath(tt.v, tt.wirePath)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+type embeddedFields struct {
+	InnerName string `json:"inner_name"`
+	Shadowed  string `json:"shadowed"`
+}
+
+type embeddingFields struct {
+	embeddedFields
+	Plain    string
+	Shadowed int    `json:"shadowed"`
+	Hidden   string `json:"-"`
+}
+
+func TestServerErrorFieldErrors(t *testing.T) {
+	t.Parallel()
+	cs := newControllerServer(t,
+		route{apiV1Path("s/default/rest/wlanconf"), func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"meta":{"rc":"error","msg":"api.err.InvalidPayload"},"data":[
+				{"rc":"error","msg":"api.err.Invalid","validationError":{"field":"x_passphrase","pattern":"[\\x20-\\x7E]{8,255}"}},
+				{"rc":"error","validationError":{"field":"private_preshared_keys[2].password"}},
+				{"rc":"error","validationError":{"field":"x_unknown"}},
+				{"rc":"error","msg":"api.err.Other"}]}`))
+		}},
+		route{apiV1Path("s/default/set/setting/mgmt"), func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"meta":{"rc":"error","msg":"api.err.Invalid"},"data":[{"rc":"error","validationError":{"field":"x_ssh_enabled"}}]}`))
+		}},
+		route{apiV2("site/default/firewall-policies"), func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code":"api.err.InvalidPayload","message":"invalid","details":{"invalid_fields":["source.matching_target","name"]}}`))
+		}},
+	)
+	c := cs.clientWith(func(cfg *ClientConfig) { cfg.ValidationMode = DisableValidation })
+	ctx := context.Background()
+
+	_, err := c.CreateWLAN(ctx, "default", &WLAN{Name: "corp"})
+	var serverErr *ServerError
+	require.ErrorAs(t, err, &serverErr)
+	assert.Equal(t, []FieldError{
+		{Path: "XPassphrase", WirePath: "x_passphrase", Message: "api.err.Invalid", Pattern: `[\x20-\x7E]{8,255}`},
+		{Path: "PrivatePresharedKeys[2].Password", WirePath: "private_preshared_keys[2].password"},
+		{WirePath: "x_unknown"},
+	}, serverErr.FieldErrors())
+
+	_, err = c.UpdateSettingMgmt(ctx, "default", &SettingMgmt{})
+	require.ErrorAs(t, err, &serverErr)
+	assert.Equal(t, []FieldError{{Path: "XSshEnabled", WirePath: "x_ssh_enabled"}}, serverErr.FieldErrors())
+
+	_, err = c.CreateFirewallZonePolicy(ctx, "default", &FirewallZonePolicy{})
+	require.ErrorAs(t, err, &serverErr)
+	assert.Equal(t, []FieldError{
+		{Path: "Source.MatchingTarget", WirePath: "source.matching_target"},
+		{Path: "Name", WirePath: "name"},
+	}, serverErr.FieldErrors())
+
+	assert.Nil(t, (&ServerError{StatusCode: http.StatusBadRequest}).FieldErrors())
+}
+
+func TestValidationErrorFieldErrors(t *testing.T) {
+	t.Parallel()
+	type member struct {
+		Email string `json:"email_address" validate:"required,email"`
+	}
+	type team struct {
+		Name    string   `json:"name" validate:"required"`
+		Members []member `json:"members" validate:"dive"`
+	}
+	v, err := newValidator()
+	require.NoError(t, err)
+
+	err = v.Validate(&team{Members: []member{{Email: "a@example.com"}, {Email: "nope"}}})
+	var ve *ValidationError
+	require.ErrorAs(t, err, &ve)
+	assert.ElementsMatch(t, []FieldError{
+		{Path: "Name", WirePath: "name", Message: ve.Messages["team.Name"]},
+		{Path: "Members[1].Email", WirePath: "members[1].email_address", Message: ve.Messages["team.Members[1].Email"]},
+	}, ve.FieldErrors())
+	for _, fe := range ve.FieldErrors() {
+		assert.NotEmpty(t, fe.Message)
+	}
+
+	assert.Nil(t, (&ValidationError{}).FieldErrors())
+}
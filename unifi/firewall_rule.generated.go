@@ -16,6 +16,26 @@ var (
 	_ json.Marshaler
 )
 
+// Self-register this resource's kind so the resourceKinds registry in
+// resource_registry.go mirrors the generated Client surface and can never
+// drift from it by hand.
+func init() { //nolint:gochecknoinits
+	registerResourceKind(ResourceKind{
+		Name:       "FirewallRule",
+		Endpoint:   "firewallrule",
+		APIVersion: APIVersionV1,
+		IDField:    "_id",
+		NameField:  "name",
+		SiteScoped: true,
+	}, resourceOps[FirewallRule]{
+		list:   InternalClient.ListFirewallRule,
+		get:    InternalClient.GetFirewallRule,
+		create: InternalClient.CreateFirewallRule,
+		update: InternalClient.UpdateFirewallRule,
+		delete: InternalClient.DeleteFirewallRule,
+	})
+}
+
 type FirewallRule struct {
 	ID     string `json:"_id,omitempty"`
 	SiteID string `json:"site_id,omitempty"`
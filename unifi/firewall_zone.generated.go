@@ -16,6 +16,26 @@ var (
 	_ json.Marshaler
 )
 
+// Self-register this resource's kind so the resourceKinds registry in
+// resource_registry.go mirrors the generated Client surface and can never
+// drift from it by hand.
+func init() { //nolint:gochecknoinits
+	registerResourceKind(ResourceKind{
+		Name:       "FirewallZone",
+		Endpoint:   "firewall/zone",
+		APIVersion: APIVersionV2,
+		IDField:    "_id",
+		NameField:  "name",
+		SiteScoped: true,
+	}, resourceOps[FirewallZone]{
+		list:   InternalClient.ListFirewallZone,
+		get:    InternalClient.GetFirewallZone,
+		create: InternalClient.CreateFirewallZone,
+		update: InternalClient.UpdateFirewallZone,
+		delete: InternalClient.DeleteFirewallZone,
+	})
+}
+
 type FirewallZone struct {
 	ID     string `json:"_id,omitempty"`
 	SiteID string `json:"site_id,omitempty"`
@@ -16,6 +16,26 @@ var (
 	_ json.Marshaler
 )
 
+// Self-register this resource's kind so the resourceKinds registry in
+// resource_registry.go mirrors the generated Client surface and can never
+// drift from it by hand.
+func init() { //nolint:gochecknoinits
+	registerResourceKind(ResourceKind{
+		Name:       "FirewallZonePolicy",
+		Endpoint:   "firewall-policies",
+		APIVersion: APIVersionV2,
+		IDField:    "_id",
+		NameField:  "name",
+		SiteScoped: true,
+	}, resourceOps[FirewallZonePolicy]{
+		list:   InternalClient.ListFirewallZonePolicy,
+		get:    InternalClient.GetFirewallZonePolicy,
+		create: InternalClient.CreateFirewallZonePolicy,
+		update: InternalClient.UpdateFirewallZonePolicy,
+		delete: InternalClient.DeleteFirewallZonePolicy,
+	})
+}
+
 type FirewallZonePolicy struct {
 	ID     string `json:"_id,omitempty"`
 	SiteID string `json:"site_id,omitempty"`
@@ -16,6 +16,26 @@ var (
 	_ json.Marshaler
 )
 
+// Self-register this resource's kind so the resourceKinds registry in
+// resource_registry.go mirrors the generated Client surface and can never
+// drift from it by hand.
+func init() { //nolint:gochecknoinits
+	registerResourceKind(ResourceKind{
+		Name:       "HeatMap",
+		Endpoint:   "heatmap",
+		APIVersion: APIVersionV1,
+		IDField:    "_id",
+		NameField:  "name",
+		SiteScoped: true,
+	}, resourceOps[HeatMap]{
+		list:   InternalClient.ListHeatMap,
+		get:    InternalClient.GetHeatMap,
+		create: InternalClient.CreateHeatMap,
+		update: InternalClient.UpdateHeatMap,
+		delete: InternalClient.DeleteHeatMap,
+	})
+}
+
 type HeatMap struct {
 	ID     string `json:"_id,omitempty"`
 	SiteID string `json:"site_id,omitempty"`
@@ -16,6 +16,26 @@ var (
 	_ json.Marshaler
 )
 
+// Self-register this resource's kind so the resourceKinds registry in
+// resource_registry.go mirrors the generated Client surface and can never
+// drift from it by hand.
+func init() { //nolint:gochecknoinits
+	registerResourceKind(ResourceKind{
+		Name:       "Hotspot2Conf",
+		Endpoint:   "hotspot2conf",
+		APIVersion: APIVersionV1,
+		IDField:    "_id",
+		NameField:  "name",
+		SiteScoped: true,
+	}, resourceOps[Hotspot2Conf]{
+		list:   InternalClient.ListHotspot2Conf,
+		get:    InternalClient.GetHotspot2Conf,
+		create: InternalClient.CreateHotspot2Conf,
+		update: InternalClient.UpdateHotspot2Conf,
+		delete: InternalClient.DeleteHotspot2Conf,
+	})
+}
+
 type Hotspot2Conf struct {
 	ID     string `json:"_id,omitempty"`
 	SiteID string `json:"site_id,omitempty"`
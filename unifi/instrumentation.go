@@ -0,0 +1,388 @@
+package unifi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptrace"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Attribute is a key/value pair attached to spans and metric recordings. Value
+// is always a string, an int64 or a bool, so adapters (e.g. to OpenTelemetry's
+// attribute.KeyValue) only have to handle those three types.
+type Attribute struct {
+	Key   string
+	Value any
+}
+
+// Attribute keys set by the client. The http.* and error.type keys follow the
+// OpenTelemetry semantic conventions; the unifi.* keys describe the logical
+// operation.
+const (
+	AttrSurface        = "unifi.surface"
+	AttrResource       = "unifi.resource"
+	AttrOperation      = "unifi.operation"
+	AttrSite           = "unifi.site"
+	AttrErrorCode      = "unifi.error_code"
+	AttrRetries        = "unifi.retries"
+	AttrHTTPMethod     = "http.request.method"
+	AttrHTTPStatusCode = "http.response.status_code"
+	AttrErrorType      = "error.type"
+	AttrCacheResult    = "unifi.cache.result"
+)
+
+// Metric instrument names emitted through ClientConfig.Meter.
+const (
+	MetricRequests        = "unifi.client.requests"
+	MetricRequestDuration = "unifi.client.request.duration"
+	MetricRetries         = "unifi.client.retries"
+	MetricCacheRequests   = "unifi.client.cache.requests"
+)
+
+// Tracer starts spans for controller calls. It is the seam for a tracing
+// backend such as OpenTelemetry: an adapter wraps a trace.Tracer and returns
+// the context carrying the new span, so transport-level instrumentation
+// further down (e.g. otelhttp) nests under it.
+type Tracer interface {
+	Start(ctx context.Context, name string, attrs []Attribute) (context.Context, Span)
+}
+
+// Span is a started span. End is called exactly once with the outcome of the
+// call (nil on success) and the attributes only known once it completed.
+type Span interface {
+	End(err error, attrs []Attribute)
+}
+
+// Meter creates the client's metric instruments. It is called once per
+// instrument when the client is built.
+type Meter interface {
+	Int64Counter(name, unit, description string) Int64Counter
+	Float64Histogram(name, unit, description string) Float64Histogram
+}
+
+// Int64Counter is a monotonic counter created by a Meter.
+type Int64Counter interface {
+	Add(ctx context.Context, n int64, attrs []Attribute)
+}
+
+// Float64Histogram is a histogram created by a Meter.
+type Float64Histogram interface {
+	Record(ctx context.Context, v float64, attrs []Attribute)
+}
+
+// Operation describes the logical operation behind a controller request,
+// derived from its path: the API surface ("internal" or "official"), the
+// resource (a ResourceKind name such as "Network" where the path maps to a
+// registered kind, else the path segment such as "devmgr" or "networks"), the
+// operation ("List", "Get", "Create", "Update", "Patch", "Delete", "Command",
+// or an Official sub-resource such as "references") and the site, if any.
+type Operation struct {
+	Surface   string
+	Resource  string
+	Operation string
+	Site      string
+}
+
+// SpanName returns the span name for the operation: "Network.List default",
+// or "Network.List" for calls that are not site-scoped.
+func (o Operation) SpanName() string {
+	name := o.Resource + "." + o.Operation
+	if o.Site != "" {
+		name += " " + o.Site
+	}
+	return name
+}
+
+func (o Operation) attributes(method string) []Attribute {
+	attrs := []Attribute{
+		{AttrSurface, o.Surface},
+		{AttrResource, o.Resource},
+		{AttrOperation, o.Operation},
+		{AttrHTTPMethod, method},
+	}
+	if o.Site != "" {
+		attrs = append(attrs, Attribute{AttrSite, o.Site})
+	}
+	return attrs
+}
+
+// telemetry holds the configured tracer and the instruments created from the
+// configured meter. A nil *telemetry disables instrumentation entirely.
+type telemetry struct {
+	tracer   Tracer
+	requests Int64Counter
+	duration Float64Histogram
+	retries  Int64Counter
+}
+
+// newTelemetry returns nil when neither a tracer nor a meter is configured, so
+// uninstrumented clients pay nothing per request.
+func newTelemetry(tracer Tracer, meter Meter) *telemetry {
+	if tracer == nil && meter == nil {
+		return nil
+	}
+	t := &telemetry{tracer: tracer}
+	if meter != nil {
+		t.requests = meter.Int64Counter(MetricRequests, "{request}", "Number of requests made to the UniFi controller.")
+		t.duration = meter.Float64Histogram(MetricRequestDuration, "s", "Duration of requests made to the UniFi controller.")
+		t.retries = meter.Int64Counter(MetricRetries, "{retry}", "Number of times a request was re-sent by the HTTP transport.")
+	}
+	return t
+}
+
+// observation tracks one in-flight controller call. Methods on a nil
+// *observation are no-ops.
+type observation struct {
+	t      *telemetry
+	span   Span
+	attrs  []Attribute
+	start  time.Time
+	status int
+	// writes counts the times the request was written to the wire; the
+	// transport re-sends a request on a stale keep-alive connection and a
+	// retrying RoundTripper re-sends it on its own, so writes-1 are retries.
+	writes atomic.Int64
+}
+
+// start begins observing a request for method and the resolved URL path,
+// returning the context to send it with.
+func (t *telemetry) start(ctx context.Context, method, urlPath string) (context.Context, *observation) {
+	if t == nil {
+		return ctx, nil
+	}
+	op := describeOperation(method, urlPath)
+	o := &observation{t: t, attrs: op.attributes(method), start: time.Now()}
+	if t.tracer != nil {
+		ctx, o.span = t.tracer.Start(ctx, op.SpanName(), o.attrs)
+	}
+	ctx = httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		WroteRequest: func(httptrace.WroteRequestInfo) { o.writes.Add(1) },
+	})
+	return ctx, o
+}
+
+// setStatus records the HTTP status of the response.
+func (o *observation) setStatus(status int) {
+	if o != nil {
+		o.status = status
+	}
+}
+
+// end finishes the span and records the metrics for the call's outcome.
+func (o *observation) end(ctx context.Context, err error) {
+	if o == nil {
+		return
+	}
+	var done []Attribute
+	if o.status != 0 {
+		done = append(done, Attribute{AttrHTTPStatusCode, int64(o.status)})
+	}
+	if err != nil {
+		done = append(done, Attribute{AttrErrorType, errorType(err, o.status)})
+		var serverErr *ServerError
+		if errors.As(err, &serverErr) && serverErr.ErrorCode != "" {
+			done = append(done, Attribute{AttrErrorCode, serverErr.ErrorCode})
+		}
+	}
+	retries := max(o.writes.Load()-1, 0)
+	if o.span != nil {
+		o.span.End(err, append(done, Attribute{AttrRetries, retries}))
+	}
+	attrs := append(slices.Clip(o.attrs), done...)
+	if o.t.requests != nil {
+		o.t.requests.Add(ctx, 1, attrs)
+		o.t.duration.Record(ctx, time.Since(o.start).Seconds(), attrs)
+		if retries > 0 {
+			o.t.retries.Add(ctx, retries, o.attrs)
+		}
+	}
+}
+
+// errorType classifies a failed call with a low-cardinality error.type value:
+// "server" for controller error responses (HTTP errors and v1 rc:error
+// envelopes), "timeout" and "canceled" for context errors, "transport" when no
+// response arrived, and "response" when a response arrived but could not be
+// read or decoded.
+func errorType(err error, status int) string {
+	var serverErr *ServerError
+	switch {
+	case errors.As(err, &serverErr):
+		return "server"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case status == 0:
+		return "transport"
+	default:
+		return "response"
+	}
+}
+
+// describeOperation derives the logical operation from a request method and
+// its resolved URL path. Paths the parser does not recognize still yield an
+// operation named for their first segment, so every call gets a stable name.
+func describeOperation(method, urlPath string) Operation {
+	rest := strings.TrimPrefix(urlPath, "/proxy/network")
+	if after, ok := strings.CutPrefix(rest, "/integration/v1/"); ok {
+		return describeOfficialOperation(method, splitPath(after))
+	}
+	op := Operation{Surface: "internal"}
+	version := APIVersionV1
+	var segs []string
+	if after, ok := strings.CutPrefix(rest, apiV2Path+"/"); ok {
+		version, segs = APIVersionV2, splitPath(after)
+		if len(segs) >= 2 && segs[0] == "site" {
+			op.Site, segs = segs[1], segs[2:]
+		}
+	} else {
+		segs = splitPath(strings.TrimPrefix(rest, apiPath+"/"))
+		if len(segs) >= 2 && segs[0] == "s" {
+			op.Site, segs = segs[1], segs[2:]
+		}
+	}
+	if len(segs) == 0 {
+		op.Resource, op.Operation = "api", crudOperation(method, false)
+		return op
+	}
+
+	if version == APIVersionV2 && method == http.MethodPost && v2QueryEndpoints[segs[0]] {
+		op.Resource, op.Operation = segs[0], string(OperationList)
+		return op
+	}
+
+	category := ""
+	if version == APIVersionV1 && len(segs) > 1 {
+		switch segs[0] {
+		case "rest", "stat", "cmd", "get", "set", "upd", "list", "group":
+			category, segs = segs[0], segs[1:]
+		}
+	}
+	name, id := lookupEndpoint(version, segs)
+	op.Resource = name
+	switch category {
+	case "cmd":
+		op.Operation = "Command"
+	case "get":
+		op.Operation = "Get"
+	case "set", "upd":
+		op.Operation = "Update"
+	case "stat", "list":
+		if method == http.MethodGet || method == http.MethodPost {
+			op.Operation = crudOperation(http.MethodGet, id)
+		} else {
+			op.Operation = crudOperation(method, id)
+		}
+	default:
+		op.Operation = crudOperation(method, id)
+	}
+	return op
+}
+
+// v2QueryEndpoints are the v2 endpoints a POST queries rather than creates
+// on, taking the filter and pagination as its body.
+var v2QueryEndpoints = map[string]bool{
+	"traffic-flows": true,
+}
+
+// describeOfficialOperation parses a path below integration/v1:
+// sites/{siteId}/<resource>[/{id}[/<sub-resource>...]].
+func describeOfficialOperation(method string, segs []string) Operation {
+	op := Operation{Surface: "official"}
+	if len(segs) >= 3 && segs[0] == "sites" {
+		op.Site, segs = segs[1], segs[2:]
+	}
+	if len(segs) == 0 {
+		op.Resource, op.Operation = "sites", crudOperation(method, false)
+		return op
+	}
+	op.Resource = segs[0]
+	switch {
+	case op.Resource == "info":
+		// The one singleton at the root of the API.
+		op.Operation = string(OperationGet)
+	case len(segs) > 2:
+		op.Operation = strings.Join(literalSegments(segs[2:]), ".")
+	default:
+		op.Operation = crudOperation(method, len(segs) == 2)
+	}
+	return op
+}
+
+// crudOperation names a request by its method and whether it targets a single
+// object.
+func crudOperation(method string, item bool) string {
+	switch method {
+	case http.MethodGet:
+		if item {
+			return string(OperationGet)
+		}
+		return string(OperationList)
+	case http.MethodPost:
+		return string(OperationCreate)
+	case http.MethodPut:
+		return string(OperationUpdate)
+	case http.MethodPatch:
+		return "Patch"
+	case http.MethodDelete:
+		return string(OperationDelete)
+	default:
+		return method
+	}
+}
+
+var (
+	endpointKindsOnce sync.Once
+	endpointKinds     map[APIVersion]map[string]string
+)
+
+// lookupEndpoint resolves the longest leading run of segs that is a registered
+// kind endpoint ("firewall/zone", "networkconf") to the kind name, reporting
+// whether an id follows it. Unregistered endpoints resolve to their first
+// segment.
+func lookupEndpoint(version APIVersion, segs []string) (string, bool) {
+	endpointKindsOnce.Do(func() {
+		endpointKinds = map[APIVersion]map[string]string{APIVersionV1: {}, APIVersionV2: {}}
+		for _, e := range resourceKinds {
+			endpointKinds[e.kind.APIVersion][e.kind.Endpoint] = e.kind.Name
+		}
+	})
+	for i := len(segs); i > 0; i-- {
+		if name, ok := endpointKinds[version][strings.Join(segs[:i], "/")]; ok {
+			return name, i < len(segs)
+		}
+	}
+	return segs[0], len(segs) > 1
+}
+
+// literalSegments drops the uuid and index segments of an Official
+// sub-resource path, keeping e.g. "interfaces.ports.actions".
+func literalSegments(segs []string) []string {
+	out := make([]string, 0, len(segs))
+	for _, s := range segs {
+		if _, err := uuid.Parse(s); err == nil {
+			continue
+		}
+		if _, err := strconv.Atoi(s); err == nil {
+			continue
+		}
+		out = append(out, s)
+	}
+	return out
+}
+
+func splitPath(p string) []string {
+	p = strings.Trim(p, "/")
+	if p == "" {
+		return nil
+	}
+	return strings.Split(p, "/")
+}
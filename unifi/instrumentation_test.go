@@ -0,0 +1,332 @@
+package unifi //nolint: testpackage
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingTelemetry is a Tracer and Meter that keeps every span and metric
+// recording in memory.
+type recordingTelemetry struct {
+	mu      sync.Mutex
+	spans   []*recordedSpan
+	metrics map[string][]recordedMetric
+}
+
+type recordedSpan struct {
+	name  string
+	attrs map[string]any
+	err   error
+	ended int
+}
+
+type recordedMetric struct {
+	value float64
+	attrs map[string]any
+}
+
+func newRecordingTelemetry() *recordingTelemetry {
+	return &recordingTelemetry{metrics: map[string][]recordedMetric{}}
+}
+
+func attrMap(dst map[string]any, attrs []Attribute) map[string]any {
+	for _, a := range attrs {
+		dst[a.Key] = a.Value
+	}
+	return dst
+}
+
+func (r *recordingTelemetry) Start(ctx context.Context, name string, attrs []Attribute) (context.Context, Span) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s := &recordedSpan{name: name, attrs: attrMap(map[string]any{}, attrs)}
+	r.spans = append(r.spans, s)
+	return ctx, &recordingSpan{r: r, s: s}
+}
+
+type recordingSpan struct {
+	r *recordingTelemetry
+	s *recordedSpan
+}
+
+func (s *recordingSpan) End(err error, attrs []Attribute) {
+	s.r.mu.Lock()
+	defer s.r.mu.Unlock()
+	s.s.err = err
+	s.s.ended++
+	attrMap(s.s.attrs, attrs)
+}
+
+type recordingInstrument struct {
+	r    *recordingTelemetry
+	name string
+}
+
+func (i recordingInstrument) Add(_ context.Context, n int64, attrs []Attribute) {
+	i.Record(context.Background(), float64(n), attrs)
+}
+
+func (i recordingInstrument) Record(_ context.Context, v float64, attrs []Attribute) {
+	i.r.mu.Lock()
+	defer i.r.mu.Unlock()
+	i.r.metrics[i.name] = append(i.r.metrics[i.name], recordedMetric{value: v, attrs: attrMap(map[string]any{}, attrs)})
+}
+
+func (r *recordingTelemetry) Int64Counter(name, _, _ string) Int64Counter {
+	return recordingInstrument{r: r, name: name}
+}
+
+func (r *recordingTelemetry) Float64Histogram(name, _, _ string) Float64Histogram {
+	return recordingInstrument{r: r, name: name}
+}
+
+func (r *recordingTelemetry) onlySpan(t *testing.T) *recordedSpan {
+	t.Helper()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	require.Len(t, r.spans, 1)
+	return r.spans[0]
+}
+
+func withTelemetry(r *recordingTelemetry) func(*ClientConfig) {
+	return func(cfg *ClientConfig) {
+		cfg.Tracer = r
+		cfg.Meter = r
+	}
+}
+
+func TestDescribeOperation(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		method string
+		path   string
+		want   Operation
+	}{
+		"v1 list": {
+			http.MethodGet, "/proxy/network/api/s/default/rest/networkconf",
+			Operation{"internal", "Network", "List", "default"},
+		},
+		"v1 get": {
+			http.MethodGet, "/proxy/network/api/s/default/rest/networkconf/abc",
+			Operation{"internal", "Network", "Get", "default"},
+		},
+		"v1 delete old style": {
+			http.MethodDelete, "/api/s/lab/rest/wlanconf/abc",
+			Operation{"internal", "WLAN", "Delete", "lab"},
+		},
+		"v1 stat filter post": {
+			http.MethodPost, "/proxy/network/api/s/default/stat/device",
+			Operation{"internal", "Device", "List", "default"},
+		},
+		"v1 command": {
+			http.MethodPost, "/proxy/network/api/s/default/cmd/devmgr",
+			Operation{"internal", "devmgr", "Command", "default"},
+		},
+		"v1 setting update": {
+			http.MethodPut, "/proxy/network/api/s/default/set/setting/mgmt",
+			Operation{"internal", "setting", "Update", "default"},
+		},
+		"v2 multi-segment endpoint": {
+			http.MethodPut, "/proxy/network/v2/api/site/default/firewall/zone/abc",
+			Operation{"internal", "FirewallZone", "Update", "default"},
+		},
+		"v2 query post": {
+			http.MethodPost, "/proxy/network/v2/api/site/default/traffic-flows",
+			Operation{"internal", "traffic-flows", "List", "default"},
+		},
+		"v1 unscoped": {
+			http.MethodGet, "/proxy/network/api/self/sites",
+			Operation{"internal", "Site", "List", ""},
+		},
+		"v2 create": {
+			http.MethodPost, "/proxy/network/v2/api/site/default/firewall-policies",
+			Operation{"internal", "FirewallZonePolicy", "Create", "default"},
+		},
+		"official get": {
+			http.MethodGet, "/proxy/network/integration/v1/sites/88f7af54-98f8-306a-a1c7-c9349722b1f6/networks/0b9d1a3e-5c3f-4f5e-9e7a-1d2c3b4a5f6e",
+			Operation{"official", "networks", "Get", "88f7af54-98f8-306a-a1c7-c9349722b1f6"},
+		},
+		"official sub-resource": {
+			http.MethodPost, "/proxy/network/integration/v1/sites/88f7af54-98f8-306a-a1c7-c9349722b1f6/devices/0b9d1a3e-5c3f-4f5e-9e7a-1d2c3b4a5f6e/interfaces/ports/1/actions",
+			Operation{"official", "devices", "interfaces.ports.actions", "88f7af54-98f8-306a-a1c7-c9349722b1f6"},
+		},
+		"official info": {
+			http.MethodGet, "/proxy/network/integration/v1/info",
+			Operation{"official", "info", "Get", ""},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, describeOperation(tc.method, tc.path))
+		})
+	}
+}
+
+func TestInstrumentationSuccess(t *testing.T) {
+	t.Parallel()
+	rec := newRecordingTelemetry()
+	cs := newControllerServer(t, route{apiV1Path("s/default/rest/networkconf"), func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"_id":"n1","name":"LAN"}]}`))
+	}})
+	c := cs.clientWith(withTelemetry(rec))
+
+	_, err := c.ListNetwork(context.Background(), "default")
+	require.NoError(t, err)
+
+	span := rec.onlySpan(t)
+	assert.Equal(t, "Network.List default", span.name)
+	assert.Equal(t, 1, span.ended)
+	require.NoError(t, span.err)
+	assert.Equal(t, "internal", span.attrs[AttrSurface])
+	assert.Equal(t, "Network", span.attrs[AttrResource])
+	assert.Equal(t, "List", span.attrs[AttrOperation])
+	assert.Equal(t, "default", span.attrs[AttrSite])
+	assert.Equal(t, http.MethodGet, span.attrs[AttrHTTPMethod])
+	assert.Equal(t, int64(http.StatusOK), span.attrs[AttrHTTPStatusCode])
+	assert.Equal(t, int64(0), span.attrs[AttrRetries])
+
+	require.Len(t, rec.metrics[MetricRequests], 1)
+	assert.InDelta(t, 1, rec.metrics[MetricRequests][0].value, 0)
+	assert.Equal(t, "Network", rec.metrics[MetricRequests][0].attrs[AttrResource])
+	require.Len(t, rec.metrics[MetricRequestDuration], 1)
+	assert.GreaterOrEqual(t, rec.metrics[MetricRequestDuration][0].value, 0.0)
+	assert.Empty(t, rec.metrics[MetricRetries])
+}
+
+func TestInstrumentationErrors(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		handler       http.HandlerFunc
+		wantStatus    int64
+		wantErrorType string
+		wantErrorCode string
+	}{
+		"http error": {
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"meta":{"rc":"error","msg":"api.err.Invalid"},"data":[]}`))
+			},
+			wantStatus:    http.StatusBadRequest,
+			wantErrorType: "server",
+			wantErrorCode: "error",
+		},
+		"decode failure": {
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"data":`))
+			},
+			wantStatus:    http.StatusOK,
+			wantErrorType: "response",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			rec := newRecordingTelemetry()
+			cs := newControllerServer(t, route{apiV1Path("s/default/rest/networkconf"), tc.handler})
+			c := cs.clientWith(withTelemetry(rec))
+
+			_, err := c.ListNetwork(context.Background(), "default")
+			require.Error(t, err)
+
+			span := rec.onlySpan(t)
+			assert.Equal(t, err, span.err)
+			assert.Equal(t, tc.wantStatus, span.attrs[AttrHTTPStatusCode])
+			assert.Equal(t, tc.wantErrorType, span.attrs[AttrErrorType])
+			if tc.wantErrorCode != "" {
+				assert.Equal(t, tc.wantErrorCode, span.attrs[AttrErrorCode])
+			} else {
+				assert.NotContains(t, span.attrs, AttrErrorCode)
+			}
+			require.Len(t, rec.metrics[MetricRequests], 1)
+			assert.Equal(t, tc.wantErrorType, rec.metrics[MetricRequests][0].attrs[AttrErrorType])
+		})
+	}
+}
+
+func TestInstrumentationTransportError(t *testing.T) {
+	t.Parallel()
+	rec := newRecordingTelemetry()
+	c := newOfflineClient(t, &ClientConfig{URL: testUrl, APIKey: "test-key", Tracer: rec})
+
+	_, err := c.ListNetwork(context.Background(), "default")
+	require.Error(t, err)
+	span := rec.onlySpan(t)
+	assert.Equal(t, "transport", span.attrs[AttrErrorType])
+	assert.NotContains(t, span.attrs, AttrHTTPStatusCode)
+}
+
+// retryingTransport re-sends a request once when the first attempt answers 503.
+type retryingTransport struct{ next http.RoundTripper }
+
+func (r retryingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := r.next.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusServiceUnavailable {
+		resp.Body.Close()
+		return r.next.RoundTrip(req)
+	}
+	return resp, err
+}
+
+func TestInstrumentationCountsRetries(t *testing.T) {
+	t.Parallel()
+	rec := newRecordingTelemetry()
+	var (
+		mu    sync.Mutex
+		calls int
+	)
+	cs := newControllerServer(t, route{apiV1Path("s/default/rest/networkconf"), func(w http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[]}`))
+	}})
+	srvTransport := cs.srv.Client().Transport
+	c := cs.clientWith(withTelemetry(rec), func(cfg *ClientConfig) {
+		cfg.HttpRoundTripperProvider = func() http.RoundTripper { return retryingTransport{srvTransport} }
+	})
+
+	_, err := c.ListNetwork(context.Background(), "default")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), rec.onlySpan(t).attrs[AttrRetries])
+	require.Len(t, rec.metrics[MetricRetries], 1)
+	assert.InDelta(t, 1, rec.metrics[MetricRetries][0].value, 0)
+}
+
+func TestInstrumentationOfficialSurface(t *testing.T) {
+	t.Parallel()
+	rec := newRecordingTelemetry()
+	cs := newControllerServer(t, infoRoute("10.1.78"))
+	c := cs.clientWith(func(cfg *ClientConfig) { cfg.Tracer = rec })
+
+	_, err := c.Official().Info().Get(context.Background())
+	require.NoError(t, err)
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	require.NotEmpty(t, rec.spans)
+	for _, s := range rec.spans {
+		assert.Equal(t, "info.Get", s.name)
+		assert.Equal(t, "official", s.attrs[AttrSurface])
+	}
+}
+
+func TestInstrumentationDisabled(t *testing.T) {
+	t.Parallel()
+	assert.Nil(t, newTelemetry(nil, nil))
+	ctx := context.Background()
+	got, obs := (*telemetry)(nil).start(ctx, http.MethodGet, "/api/self")
+	assert.Equal(t, ctx, got)
+	assert.Nil(t, obs)
+	obs.setStatus(http.StatusOK)
+	obs.end(ctx, nil)
+}
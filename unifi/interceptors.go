@@ -14,8 +14,9 @@ import (
 // IMPORTANT: Do not read or consume resp.Body in InterceptResponse — the body
 // is decoded after interceptors run; consuming it will cause silent decode failures
 // (the caller receives a zero-valued response with nil error).
-// For timing and tracing use ClientConfig.HttpRoundTripperProvider which wraps
-// the transport and can properly read and replace the response body.
+// For tracing and metrics use ClientConfig.Tracer and ClientConfig.Meter, which
+// also observe transport and decode failures; to read or replace raw response
+// bodies wrap the transport with ClientConfig.HttpRoundTripperProvider.
 type ClientInterceptor interface {
 	InterceptRequest(req *http.Request) error
 	InterceptResponse(resp *http.Response) error
@@ -0,0 +1,80 @@
+package unifi
+
+import (
+	"errors"
+	"net/http"
+)
+
+// Keys of the single-object endpoints in client.listOnlyLookups.
+const (
+	lookupDeviceByID  = "rest/device/{id}"
+	lookupDeviceByMAC = "stat/device/{mac}"
+	lookupDNSRecord   = "static-dns/{id}"
+)
+
+// lookupOne fetches one object through a single-object endpoint (narrow) and
+// falls back to a list request (list) filtered with match when the controller
+// lacks that endpoint. Older controllers answer an unknown route with 404,
+// which is indistinguishable from a missing object, so a not-found answer is
+// confirmed against the list: when the list does hold the object, the endpoint
+// is remembered as unsupported under key and later lookups list straight away.
+// Once the endpoint has answered an object, it is remembered as supported and
+// its not-found answers are returned as they are.
+func lookupOne[T any](c *client, key string, narrow func() (*T, error), list func() ([]T, error), match func(*T) bool) (*T, error) {
+	_, listOnly := c.listOnlyLookups.Load(key)
+	if !listOnly {
+		v, err := narrow()
+		if err == nil {
+			c.narrowLookups.Store(key, true)
+			return v, nil
+		}
+		if !narrowLookupUnsupported(err) {
+			return nil, err
+		}
+		if _, supported := c.narrowLookups.Load(key); supported && errors.Is(err, ErrNotFound) {
+			return nil, err
+		}
+		if narrowLookupMissing(err) {
+			c.listOnlyLookups.Store(key, true)
+			listOnly = true
+		}
+	}
+	items, err := list()
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		if match(&items[i]) {
+			if !listOnly {
+				c.log.Debugf("%s is not supported by the controller, looking up by list from now on", key)
+				c.listOnlyLookups.Store(key, true)
+			}
+			return &items[i], nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// narrowLookupUnsupported reports whether err from a single-object endpoint
+// warrants falling back to the list: the object was not found, the endpoint
+// does not exist, or the controller rejected the identifier.
+func narrowLookupUnsupported(err error) bool {
+	if errors.Is(err, ErrNotFound) {
+		return true
+	}
+	var serverErr *ServerError
+	if !errors.As(err, &serverErr) {
+		return false
+	}
+	return serverErr.StatusCode == http.StatusBadRequest || narrowLookupMissing(err)
+}
+
+// narrowLookupMissing reports whether err says for certain that the controller
+// has no such endpoint.
+func narrowLookupMissing(err error) bool {
+	var serverErr *ServerError
+	if !errors.As(err, &serverErr) {
+		return false
+	}
+	return serverErr.StatusCode == http.StatusMethodNotAllowed || serverErr.StatusCode == http.StatusNotImplemented
+}
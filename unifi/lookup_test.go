@@ -0,0 +1,120 @@
+package unifi //nolint: testpackage
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetDNSRecordLookup asserts the request shape of GetDNSRecord against
+// controllers with and without static-dns/{id}.
+func TestGetDNSRecordLookup(t *testing.T) {
+	t.Parallel()
+
+	listPath := apiV2("site/default/static-dns")
+	itemPath := listPath + "/r2"
+	list := func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`[{"_id":"r1","key":"a.lan"},{"_id":"r2","key":"b.lan"}]`))
+	}
+	cases := map[string]struct {
+		item      http.HandlerFunc
+		id        string
+		wantErrIs error
+		// wantStatus is the status of the *ServerError expected from each lookup.
+		wantStatus int
+		// wantPaths are the request paths of two lookups in a row.
+		wantPaths []string
+	}{
+		"single-object endpoint": {
+			item: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"_id":"r2","key":"b.lan"}`))
+			},
+			id:        "r2",
+			wantPaths: []string{itemPath, itemPath},
+		},
+		"endpoint missing (404)": {
+			id:        "r2",
+			wantPaths: []string{itemPath, listPath, listPath},
+		},
+		"method not allowed": {
+			item: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+			},
+			id:        "r2",
+			wantPaths: []string{itemPath, listPath, listPath},
+		},
+		"missing record": {
+			id:        "ghost",
+			wantErrIs: ErrNotFound,
+			wantPaths: []string{listPath + "/ghost", listPath, listPath + "/ghost", listPath},
+		},
+		"server failure is not masked": {
+			item: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			id:         "r2",
+			wantStatus: http.StatusInternalServerError,
+			wantPaths:  []string{itemPath, itemPath},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			routes := []route{{listPath, list}}
+			if tc.item != nil {
+				routes = append(routes, route{itemPath, tc.item})
+			}
+			cs := newControllerServer(t, routes...)
+			c := cs.client()
+
+			for range 2 {
+				got, err := c.GetDNSRecord(context.Background(), "default", tc.id)
+				if tc.wantErrIs != nil {
+					require.ErrorIs(t, err, tc.wantErrIs)
+					continue
+				}
+				if tc.wantStatus != 0 {
+					var serverErr *ServerError
+					require.ErrorAs(t, err, &serverErr)
+					assert.Equal(t, tc.wantStatus, serverErr.StatusCode)
+					continue
+				}
+				require.NoError(t, err)
+				assert.Equal(t, "b.lan", got.Key)
+			}
+
+			var paths []string
+			cs.mu.Lock()
+			for _, r := range cs.requests {
+				assert.Equal(t, http.MethodGet, r.Method)
+				paths = append(paths, r.Path)
+			}
+			cs.mu.Unlock()
+			assert.Equal(t, tc.wantPaths, paths)
+		})
+	}
+}
+
+// TestGetDNSRecordLookupAfterHit asserts that once static-dns/{id} has
+// answered a record, its not-found is trusted without listing.
+func TestGetDNSRecordLookupAfterHit(t *testing.T) {
+	t.Parallel()
+
+	listPath := apiV2("site/default/static-dns")
+	cs := newControllerServer(t, route{listPath + "/r2", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"_id":"r2","key":"b.lan"}`))
+	}})
+	c := cs.client()
+
+	_, err := c.GetDNSRecord(context.Background(), "default", "r2")
+	require.NoError(t, err)
+	_, err = c.GetDNSRecord(context.Background(), "default", "ghost")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	assert.Equal(t, 1, cs.countRequestsTo(listPath+"/ghost"))
+	assert.Zero(t, cs.countRequestsTo(listPath), "a supported endpoint's not-found is not confirmed by listing")
+}
@@ -16,6 +16,26 @@ var (
 	_ json.Marshaler
 )
 
+// Self-register this resource's kind so the resourceKinds registry in
+// resource_registry.go mirrors the generated Client surface and can never
+// drift from it by hand.
+func init() { //nolint:gochecknoinits
+	registerResourceKind(ResourceKind{
+		Name:       "Map",
+		Endpoint:   "map",
+		APIVersion: APIVersionV1,
+		IDField:    "_id",
+		NameField:  "name",
+		SiteScoped: true,
+	}, resourceOps[Map]{
+		list:   InternalClient.ListMap,
+		get:    InternalClient.GetMap,
+		create: InternalClient.CreateMap,
+		update: InternalClient.UpdateMap,
+		delete: InternalClient.DeleteMap,
+	})
+}
+
 type Map struct {
 	ID     string `json:"_id,omitempty"`
 	SiteID string `json:"site_id,omitempty"`
@@ -16,6 +16,26 @@ var (
 	_ json.Marshaler
 )
 
+// Self-register this resource's kind so the resourceKinds registry in
+// resource_registry.go mirrors the generated Client surface and can never
+// drift from it by hand.
+func init() { //nolint:gochecknoinits
+	registerResourceKind(ResourceKind{
+		Name:       "Network",
+		Endpoint:   "networkconf",
+		APIVersion: APIVersionV1,
+		IDField:    "_id",
+		NameField:  "name",
+		SiteScoped: true,
+	}, resourceOps[Network]{
+		list:   InternalClient.ListNetwork,
+		get:    InternalClient.GetNetwork,
+		create: InternalClient.CreateNetwork,
+		update: InternalClient.UpdateNetwork,
+		delete: InternalClient.DeleteNetwork,
+	})
+}
+
 type Network struct {
 	ID     string `json:"_id,omitempty"`
 	SiteID string `json:"site_id,omitempty"`
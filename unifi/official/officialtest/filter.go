@@ -0,0 +1,345 @@
+package officialtest
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// filterExpr is a parsed filter query parameter, in the syntax the
+// integration API documents: property expressions (name.eq('x')), compound
+// expressions (and(...), or(...)) and negation (not(...)).
+type filterExpr interface {
+	match(obj map[string]any) bool
+}
+
+type compoundExpr struct {
+	op    string // and, or, not
+	exprs []filterExpr
+}
+
+func (e compoundExpr) match(obj map[string]any) bool {
+	switch e.op {
+	case "not":
+		return !e.exprs[0].match(obj)
+	case "or":
+		return slices.ContainsFunc(e.exprs, func(x filterExpr) bool { return x.match(obj) })
+	default:
+		return !slices.ContainsFunc(e.exprs, func(x filterExpr) bool { return !x.match(obj) })
+	}
+}
+
+type propertyExpr struct {
+	property []string
+	fn       string
+	args     []any
+}
+
+// filterArity is the argument count of each property function; -1 means one
+// or more.
+var filterArity = map[string]int{
+	"isNull": 0, "isNotNull": 0, "isEmpty": 0,
+	"eq": 1, "ne": 1, "gt": 1, "ge": 1, "lt": 1, "le": 1, "like": 1, "contains": 1,
+	"in": -1, "notIn": -1, "containsAny": -1, "containsAll": -1, "containsExactly": -1,
+}
+
+func (e propertyExpr) match(obj map[string]any) bool {
+	var v any = obj
+	for _, p := range e.property {
+		m, ok := v.(map[string]any)
+		if !ok {
+			v = nil
+			break
+		}
+		v = m[p]
+	}
+	switch e.fn {
+	case "isNull":
+		return v == nil
+	case "isNotNull":
+		return v != nil
+	case "eq":
+		return v != nil && compareValues(v, e.args[0]) == 0
+	case "ne":
+		return v == nil || compareValues(v, e.args[0]) != 0
+	case "gt", "ge", "lt", "le":
+		if v == nil {
+			return false
+		}
+		c := compareValues(v, e.args[0])
+		return map[string]bool{"gt": c > 0, "ge": c >= 0, "lt": c < 0, "le": c <= 0}[e.fn]
+	case "like":
+		s, ok := v.(string)
+		pattern, _ := e.args[0].(string)
+		return ok && likeMatch(pattern, s)
+	case "in":
+		return v != nil && containsValue(e.args, v)
+	case "notIn":
+		return v == nil || !containsValue(e.args, v)
+	}
+	set, _ := v.([]any)
+	switch e.fn {
+	case "isEmpty":
+		return len(set) == 0
+	case "contains", "containsAny":
+		return slices.ContainsFunc(e.args, func(a any) bool { return containsValue(set, a) })
+	case "containsAll":
+		return !slices.ContainsFunc(e.args, func(a any) bool { return !containsValue(set, a) })
+	case "containsExactly":
+		return !slices.ContainsFunc(e.args, func(a any) bool { return !containsValue(set, a) }) &&
+			!slices.ContainsFunc(set, func(s any) bool { return !containsValue(e.args, s) })
+	}
+	return false
+}
+
+func containsValue(set []any, v any) bool {
+	return slices.ContainsFunc(set, func(s any) bool { return compareValues(s, v) == 0 })
+}
+
+// compareValues orders a stored JSON value against a filter literal: numbers
+// numerically, timestamps chronologically and everything else as strings.
+func compareValues(a, b any) int {
+	fa, okA := a.(float64)
+	fb, okB := b.(float64)
+	if okA && okB {
+		switch {
+		case fa < fb:
+			return -1
+		case fa > fb:
+			return 1
+		}
+		return 0
+	}
+	sa, sb := fmt.Sprint(a), fmt.Sprint(b)
+	if ta, ok := parseTimestamp(sa); ok {
+		if tb, ok := parseTimestamp(sb); ok {
+			return ta.Compare(tb)
+		}
+	}
+	return strings.Compare(sa, sb)
+}
+
+func parseTimestamp(s string) (time.Time, bool) {
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// likeMatch matches s against a like pattern: '.' is any single character,
+// '*' any run of characters and '\' escapes the next one.
+func likeMatch(pattern, s string) bool {
+	p, str := []rune(pattern), []rune(s)
+	if len(p) == 0 {
+		return len(str) == 0
+	}
+	switch p[0] {
+	case '*':
+		for i := 0; i <= len(str); i++ {
+			if likeMatch(string(p[1:]), string(str[i:])) {
+				return true
+			}
+		}
+		return false
+	case '.':
+		return len(str) > 0 && likeMatch(string(p[1:]), string(str[1:]))
+	case '\\':
+		if len(p) > 1 {
+			p = p[1:]
+		}
+	}
+	return len(str) > 0 && str[0] == p[0] && likeMatch(string(p[1:]), string(str[1:]))
+}
+
+// parseFilter parses a filter expression.
+func parseFilter(src string) (filterExpr, error) {
+	p := &filterParser{src: src}
+	expr, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos < len(p.src) {
+		return nil, p.errorf("unexpected %q", p.src[p.pos:])
+	}
+	return expr, nil
+}
+
+type filterParser struct {
+	src string
+	pos int
+}
+
+func (p *filterParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("invalid filter at position %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *filterParser) skipSpace() {
+	for p.pos < len(p.src) && p.src[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+func (p *filterParser) consume(c byte) bool {
+	p.skipSpace()
+	if p.pos < len(p.src) && p.src[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *filterParser) ident() string {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		if c != '_' && (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			break
+		}
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
+
+func (p *filterParser) expr() (filterExpr, error) {
+	var path []string
+	for {
+		name := p.ident()
+		if name == "" {
+			return nil, p.errorf("expected a property or logical operator")
+		}
+		path = append(path, name)
+		if !p.consume('.') {
+			break
+		}
+	}
+	if !p.consume('(') {
+		return nil, p.errorf("expected '('")
+	}
+	if len(path) == 1 {
+		return p.compound(path[0])
+	}
+	fn := path[len(path)-1]
+	arity, ok := filterArity[fn]
+	if !ok {
+		return nil, p.errorf("unknown function %q", fn)
+	}
+	args, err := p.args()
+	if err != nil {
+		return nil, err
+	}
+	if (arity >= 0 && len(args) != arity) || (arity < 0 && len(args) == 0) {
+		return nil, p.errorf("wrong number of arguments to %s", fn)
+	}
+	return propertyExpr{property: path[:len(path)-1], fn: fn, args: args}, nil
+}
+
+func (p *filterParser) compound(op string) (filterExpr, error) {
+	if op != "and" && op != "or" && op != "not" {
+		return nil, p.errorf("unknown logical operator %q", op)
+	}
+	var exprs []filterExpr
+	for {
+		e, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, e)
+		if p.consume(')') {
+			break
+		}
+		if !p.consume(',') {
+			return nil, p.errorf("expected ',' or ')'")
+		}
+	}
+	if (op == "not") != (len(exprs) == 1) || (op != "not" && len(exprs) < 2) {
+		return nil, p.errorf("wrong number of expressions to %s", op)
+	}
+	return compoundExpr{op: op, exprs: exprs}, nil
+}
+
+// args parses a comma-separated argument list up to the closing ')'. A set
+// literal ([a, b]) is flattened into the list.
+func (p *filterParser) args() ([]any, error) {
+	var out []any
+	if p.consume(')') {
+		return out, nil
+	}
+	for {
+		if p.consume('[') {
+			for !p.consume(']') {
+				v, err := p.value()
+				if err != nil {
+					return nil, err
+				}
+				out = append(out, v)
+				p.consume(',')
+			}
+		} else {
+			v, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, v)
+		}
+		if p.consume(')') {
+			return out, nil
+		}
+		if !p.consume(',') {
+			return nil, p.errorf("expected ',' or ')'")
+		}
+	}
+}
+
+// value parses one literal: a quoted string, or a bare number, boolean,
+// timestamp or UUID.
+func (p *filterParser) value() (any, error) {
+	p.skipSpace()
+	if p.pos >= len(p.src) {
+		return nil, p.errorf("unexpected end of filter")
+	}
+	if p.src[p.pos] == '\'' {
+		var b strings.Builder
+		for p.pos++; p.pos < len(p.src); p.pos++ {
+			if p.src[p.pos] == '\'' {
+				if p.pos+1 < len(p.src) && p.src[p.pos+1] == '\'' {
+					b.WriteByte('\'')
+					p.pos++
+					continue
+				}
+				p.pos++
+				return b.String(), nil
+			}
+			b.WriteByte(p.src[p.pos])
+		}
+		return nil, p.errorf("unterminated string")
+	}
+	start := p.pos
+	for p.pos < len(p.src) && !strings.ContainsRune(",)] ", rune(p.src[p.pos])) {
+		p.pos++
+	}
+	raw := p.src[start:p.pos]
+	switch {
+	case raw == "":
+		return nil, p.errorf("expected a value")
+	case raw == "true" || raw == "false":
+		return raw == "true", nil
+	}
+	if _, err := uuid.Parse(raw); err == nil {
+		return raw, nil
+	}
+	if raw[0] < '0' || raw[0] > '9' {
+		return nil, p.errorf("strings must be single-quoted: %s", raw)
+	}
+	if f, err := strconv.ParseFloat(raw, 64); err == nil {
+		return f, nil
+	}
+	return raw, nil
+}
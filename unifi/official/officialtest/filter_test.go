@@ -0,0 +1,75 @@
+package officialtest //nolint: testpackage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterMatch(t *testing.T) {
+	t.Parallel()
+	obj := map[string]any{
+		"id":        "550e8400-e29b-41d4-a716-446655440000",
+		"name":      "Guest's WiFi",
+		"enabled":   true,
+		"vlanId":    30.0,
+		"createdAt": "2025-01-29T12:39:11Z",
+		"metadata":  map[string]any{"origin": "USER_DEFINED"},
+		"tags":      []any{"a", "b"},
+		"empty":     []any{},
+	}
+
+	tests := map[string]bool{
+		"name.eq('Guest''s WiFi')":                             true,
+		"name.ne('Guest''s WiFi')":                             false,
+		"name.like('Guest*')":                                  true,
+		"name.like('Guest.s*')":                                true,
+		"name.like('Guest')":                                   false,
+		"id.eq(550e8400-e29b-41d4-a716-446655440000)":          true,
+		"enabled.eq(true)":                                     true,
+		"vlanId.gt(10)":                                        true,
+		"vlanId.le(29.5)":                                      false,
+		"vlanId.in(10, 20, 30)":                                true,
+		"vlanId.notIn([10, 20])":                               true,
+		"createdAt.gt(2025-01-01)":                             true,
+		"createdAt.lt(2025-01-29T12:00:00Z)":                   false,
+		"metadata.origin.eq('USER_DEFINED')":                   true,
+		"missing.isNull()":                                     true,
+		"name.isNotNull()":                                     true,
+		"empty.isEmpty()":                                      true,
+		"tags.contains('a')":                                   true,
+		"tags.containsAny('x', 'b')":                           true,
+		"tags.containsAll('a', 'x')":                           false,
+		"tags.containsExactly('b', 'a')":                       true,
+		"and(enabled.eq(true), vlanId.eq(30))":                 true,
+		"or(enabled.eq(false), not(name.like('x*')))":          true,
+		"not(and(enabled.eq(true), metadata.origin.isNull()))": true,
+	}
+	for src, want := range tests {
+		expr, err := parseFilter(src)
+		require.NoError(t, err, src)
+		assert.Equal(t, want, expr.match(obj), src)
+	}
+}
+
+func TestFilterParseErrors(t *testing.T) {
+	t.Parallel()
+	for _, src := range []string{
+		"",
+		"name",
+		"name.eq('x'",
+		"name.eq(x)",
+		"name.unknown('x')",
+		"name.eq('a', 'b')",
+		"name.in()",
+		"xor(a.isNull(), b.isNull())",
+		"and(a.isNull())",
+		"not(a.isNull(), b.isNull())",
+		"name.eq('unterminated)",
+		"name.eq('x') trailing",
+	} {
+		_, err := parseFilter(src)
+		assert.Error(t, err, src)
+	}
+}
@@ -0,0 +1,631 @@
+// Package officialtest provides a fake of the Official UniFi Network
+// integration API (integration/v1), generated from the OpenAPI specs bundled
+// with go-unifi.
+//
+// A Handler serves every operation of one spec version under BasePath. It
+// validates path and query parameters and request bodies against the spec
+// (types, formats, required fields, bounds, enums, discriminated unions) and
+// answers violations with the API's 400 error body; it keeps stateful
+// collections for every paginated resource (create, read, update, patch,
+// delete), answers list calls with {offset,limit,count,totalCount,data} pages
+// honoring offset, limit and the filter query language, and answers every
+// other operation with a schema-conformant example response.
+//
+// The Handler is mounted by unifitest.Server for controllers new enough to
+// expose the API, so the usual entry point is:
+//
+//	srv := unifitest.NewServer(nil)
+//	defer srv.Close()
+//
+//	c, err := unifi.NewClient(srv.ClientConfig())
+//	siteID, err := c.Official().Sites().ResolveID(ctx, "default")
+//	created, err := c.Official().Networks().Create(ctx, siteID, body)
+//
+// It is an http.Handler, so it can also be mounted in any test server. State
+// is seeded with Seed and inspected with Objects, using paths relative to
+// BasePath:
+//
+//	h := srv.Official()
+//	err := h.Seed("/sites/"+siteID.String()+"/devices", &official.AdoptedDeviceDetails{Name: "ap-1"})
+//
+// The specs are regenerated with the Official surface (codegen/official
+// -mock-out-dir); Versions lists the bundled ones.
+package officialtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// BasePath is the controller path prefix the Handler serves.
+	BasePath = "/proxy/network/integration/v1"
+	// DefaultSite is the internalReference of the site every Handler starts
+	// with.
+	DefaultSite = "default"
+
+	apiKeyHeader = "X-Api-Key"
+	// specPrefix is the version segment the spec paths start with; BasePath
+	// ends with it.
+	specPrefix       = "/v1"
+	defaultPageLimit = 25
+)
+
+// Config configures a Handler. The zero value (or a nil *Config) selects the
+// newest bundled spec and accepts any API key.
+type Config struct {
+	// Version selects the bundled spec (see Versions). Empty means the newest.
+	Version string
+	// ApplicationVersion is the controller version GET /info reports. Empty
+	// means Version.
+	ApplicationVersion string
+	// APIKey, when set, is the X-Api-Key value every request must carry.
+	APIKey string
+}
+
+// Handler is a fake integration API for one spec version. It is safe for
+// concurrent use; all state is guarded by a single mutex.
+type Handler struct {
+	spec       *spec
+	apiKey     string
+	appVersion string
+
+	mu sync.Mutex
+	// collections holds stored objects keyed by concrete collection path
+	// (e.g. /v1/sites/<uuid>/networks), in insertion order.
+	collections map[string][]map[string]any
+	// singletons holds the last body PUT to a non-collection path (e.g. a
+	// policy ordering), keyed by path and query.
+	singletons map[string]any
+}
+
+// New returns a Handler serving the spec selected by cfg, seeded with a
+// DefaultSite. It fails only when cfg.Version names no bundled spec.
+func New(cfg *Config) (*Handler, error) {
+	if cfg == nil {
+		cfg = &Config{}
+	}
+	version := cfg.Version
+	if version == "" {
+		all := Versions()
+		version = all[len(all)-1]
+	}
+	sp, err := loadSpec(version)
+	if err != nil {
+		return nil, err
+	}
+	h := &Handler{
+		spec:        sp,
+		apiKey:      cfg.APIKey,
+		appVersion:  cfg.ApplicationVersion,
+		collections: map[string][]map[string]any{},
+		singletons:  map[string]any{},
+	}
+	if h.appVersion == "" {
+		h.appVersion = version
+	}
+	h.AddSite(DefaultSite, "Default")
+	return h, nil
+}
+
+// Version returns the version of the spec the Handler serves.
+func (h *Handler) Version() string {
+	return h.spec.Version
+}
+
+// AddSite adds a site with the given internalReference (the legacy site name)
+// and display name, returning its id. Adding an existing internalReference
+// returns the existing id.
+func (h *Handler) AddSite(internalReference, name string) uuid.UUID {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if id, ok := h.siteIDLocked(internalReference); ok {
+		return id
+	}
+	id := uuid.New()
+	site := h.spec.conform(h.spec.collections[specPrefix+"/sites"].detail, map[string]any{
+		"id": id.String(), "internalReference": internalReference, "name": name,
+	})
+	h.collections[specPrefix+"/sites"] = append(h.collections[specPrefix+"/sites"], site.(map[string]any))
+	return id
+}
+
+// RemoveSite removes the site with the given internalReference and every
+// object stored under it.
+func (h *Handler) RemoveSite(internalReference string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	id, ok := h.siteIDLocked(internalReference)
+	if !ok {
+		return
+	}
+	sites := specPrefix + "/sites"
+	h.collections[sites] = removeByID(h.collections[sites], id.String())
+	prefix := sites + "/" + id.String() + "/"
+	for key := range h.collections {
+		if strings.HasPrefix(key, prefix) {
+			delete(h.collections, key)
+		}
+	}
+}
+
+// SiteID returns the id of the site with the given internalReference.
+func (h *Handler) SiteID(internalReference string) (uuid.UUID, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.siteIDLocked(internalReference)
+}
+
+func (h *Handler) siteIDLocked(internalReference string) (uuid.UUID, bool) {
+	for _, site := range h.collections[specPrefix+"/sites"] {
+		if site["internalReference"] == internalReference {
+			id, err := uuid.Parse(fmt.Sprint(site["id"]))
+			return id, err == nil
+		}
+	}
+	return uuid.Nil, false
+}
+
+// Seed stores objs in the collection at path (relative to BasePath, e.g.
+// "/sites/<id>/networks"), as if created through the API but without request
+// validation: missing required fields are filled with examples and objects
+// without an id get one. Each obj is any JSON-marshalable value; when it is a
+// pointer, the stored object (with its id) is decoded back into it.
+func (h *Handler) Seed(path string, objs ...any) error {
+	full := specPrefix + "/" + strings.Trim(path, "/")
+	op, params, _ := h.spec.match(http.MethodGet, full)
+	if op == nil || op.role != roleList {
+		return fmt.Errorf("officialtest: %s is not a collection", path)
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if err := h.checkParentsLocked(op, params, 0); err != nil {
+		return fmt.Errorf("officialtest: seeding %s: %w", path, err)
+	}
+	coll := h.spec.collections[op.collection]
+	for _, obj := range objs {
+		raw, err := json.Marshal(obj)
+		if err != nil {
+			return fmt.Errorf("officialtest: encoding %T: %w", obj, err)
+		}
+		var body map[string]any
+		if err := json.Unmarshal(raw, &body); err != nil {
+			return fmt.Errorf("officialtest: %T is not a JSON object: %w", obj, err)
+		}
+		if id, _ := body["id"].(string); id == "" || id == uuid.Nil.String() {
+			body["id"] = uuid.NewString()
+		}
+		stored := h.build(coll, body)
+		h.collections[full] = append(h.collections[full], stored)
+		if reflect.ValueOf(obj).Kind() == reflect.Pointer {
+			raw, _ = json.Marshal(stored)
+			if err := json.Unmarshal(raw, obj); err != nil {
+				return fmt.Errorf("officialtest: decoding stored object into %T: %w", obj, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Objects returns copies of the objects stored in the collection at path
+// (relative to BasePath), in insertion order.
+func (h *Handler) Objects(path string) []map[string]any {
+	full := specPrefix + "/" + strings.Trim(path, "/")
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	out := make([]map[string]any, 0, len(h.collections[full]))
+	for _, obj := range h.collections[full] {
+		out = append(out, cloneJSON(obj).(map[string]any))
+	}
+	return out
+}
+
+// ServeHTTP serves the integration API under BasePath.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.apiKey != "" {
+		switch r.Header.Get(apiKeyHeader) {
+		case h.apiKey:
+		case "":
+			writeError(w, r, http.StatusUnauthorized, "api.authentication.missing-credentials", "Missing credentials")
+			return
+		default:
+			writeError(w, r, http.StatusUnauthorized, "api.authentication.invalid-credentials", "Invalid API key")
+			return
+		}
+	}
+	if !strings.HasPrefix(r.URL.Path, BasePath+"/") {
+		writeError(w, r, http.StatusNotFound, "api.request.not-found", "No endpoint "+r.URL.Path)
+		return
+	}
+	path := specPrefix + strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, BasePath), "/")
+	op, params, allowed := h.spec.match(r.Method, path)
+	if op == nil {
+		if len(allowed) > 0 {
+			w.Header().Set("Allow", strings.Join(allowed, ", "))
+			writeError(w, r, http.StatusMethodNotAllowed, "api.request.method-not-allowed", "Method "+r.Method+" is not supported")
+			return
+		}
+		writeError(w, r, http.StatusNotFound, "api.request.not-found", "No endpoint "+r.URL.Path)
+		return
+	}
+
+	query, err := h.validateParams(op, params, r.URL.Query())
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "api.request.argument-validation-error", err.Error())
+		return
+	}
+	body, err := h.readBody(op, r)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "api.request.argument-validation-error", err.Error())
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	skip := 0
+	if op.role == roleItem {
+		// The item itself is checked by the handlers below.
+		skip = 1
+	}
+	if err := h.checkParentsLocked(op, params, skip); err != nil {
+		writeError(w, r, http.StatusNotFound, "api.request.not-found", err.Error())
+		return
+	}
+
+	key := expand(op.collection, params)
+	switch op.role {
+	case roleList:
+		h.serveList(w, op, key, query)
+	case roleCreate:
+		stored := h.build(h.spec.collections[op.collection], withID(body, uuid.NewString()))
+		h.collections[key] = append(h.collections[key], stored)
+		h.respond(w, op, []map[string]any{stored})
+	case roleBulkDelete:
+		h.serveBulkDelete(w, op, key, query)
+	case roleItem:
+		h.serveItem(w, r, op, key, params[strings.Trim(op.segments[len(op.segments)-1], "{}")], body)
+	default:
+		h.serveOther(w, op, path, query, body)
+	}
+}
+
+// validateParams checks path and query parameters against their schemas,
+// returning the typed query values.
+func (h *Handler) validateParams(op *operation, params map[string]string, q url.Values) (map[string]any, error) {
+	query := map[string]any{}
+	for _, p := range op.Parameters {
+		raw, present := params[p.Name], true
+		if p.In == "query" {
+			raw, present = q.Get(p.Name), q.Has(p.Name)
+		}
+		if !present || raw == "" {
+			if p.Required {
+				return nil, invalid(p.Name, "must not be null")
+			}
+			continue
+		}
+		v := any(raw)
+		if s := h.spec.resolve(p.Schema); s != nil && (s.Type == "integer" || s.Type == "number" || s.Type == "boolean") {
+			var err error
+			if v, err = parseScalar(s.Type, raw); err != nil {
+				return nil, invalid(p.Name, "must be a valid %s", s.Type)
+			}
+		}
+		if err := h.spec.validate(p.Schema, v, p.Name); err != nil {
+			return nil, err
+		}
+		if p.Name == "filter" {
+			if _, err := parseFilter(raw); err != nil {
+				return nil, invalid(p.Name, "%s", err)
+			}
+		}
+		query[p.Name] = v
+	}
+	return query, nil
+}
+
+func parseScalar(typ, raw string) (any, error) {
+	if typ == "boolean" {
+		return strconv.ParseBool(raw)
+	}
+	return strconv.ParseFloat(raw, 64)
+}
+
+// readBody decodes and validates the request body of operations that take
+// one; the result is nil for the others.
+func (h *Handler) readBody(op *operation, r *http.Request) (map[string]any, error) {
+	if op.RequestBody == nil {
+		return nil, nil
+	}
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(r.Body); err != nil {
+		return nil, fmt.Errorf("reading request body: %w", err)
+	}
+	if len(bytes.TrimSpace(buf.Bytes())) == 0 {
+		if op.BodyOptional {
+			return nil, nil
+		}
+		return nil, invalid("", "Request body is required")
+	}
+	var body any
+	if err := json.Unmarshal(buf.Bytes(), &body); err != nil {
+		return nil, invalid("", "Malformed request body: %s", err)
+	}
+	if err := h.spec.validate(op.RequestBody, body, ""); err != nil {
+		return nil, err
+	}
+	obj, _ := body.(map[string]any)
+	return obj, nil
+}
+
+// checkParentsLocked reports a not-found error when an id in the request path
+// names no stored object of its collection (e.g. an unknown siteId). The last
+// skip path parameters are not checked.
+func (h *Handler) checkParentsLocked(op *operation, params map[string]string, skip int) error {
+	for i, seg := range op.segments[:len(op.segments)-skip] {
+		if !isParam(seg) {
+			continue
+		}
+		tmpl := "/" + strings.Join(op.segments[:i], "/")
+		if h.spec.collections[tmpl] == nil {
+			continue
+		}
+		id := params[strings.Trim(seg, "{}")]
+		if findByID(h.collections[expand(tmpl, params)], id) < 0 {
+			return fmt.Errorf("%s '%s' not found", strings.Trim(seg, "{}"), id)
+		}
+	}
+	return nil
+}
+
+func (h *Handler) serveList(w http.ResponseWriter, op *operation, key string, query map[string]any) {
+	items := h.collections[key]
+	if f, ok := query["filter"].(string); ok {
+		expr, _ := parseFilter(f)
+		var matched []map[string]any
+		for _, obj := range items {
+			if expr.match(obj) {
+				matched = append(matched, obj)
+			}
+		}
+		items = matched
+	}
+	offset, limit := 0, defaultPageLimit
+	if v, ok := query["offset"].(float64); ok {
+		offset = int(v)
+	}
+	if v, ok := query["limit"].(float64); ok {
+		limit = int(v)
+	} else if s := paramSchema(op, "limit"); s != nil {
+		if d, ok := s.Default.(float64); ok {
+			limit = int(d)
+		}
+	}
+	data := []any{}
+	overview := h.spec.collections[op.collection].overview
+	for i := offset; i < len(items) && i < offset+limit; i++ {
+		data = append(data, h.spec.conform(overview, items[i]))
+	}
+	writeJSON(w, op.Status, map[string]any{
+		"offset":     offset,
+		"limit":      limit,
+		"count":      len(data),
+		"totalCount": len(items),
+		"data":       data,
+	})
+}
+
+func (h *Handler) serveBulkDelete(w http.ResponseWriter, op *operation, key string, query map[string]any) {
+	var deleted, kept []map[string]any
+	f, _ := query["filter"].(string)
+	expr, _ := parseFilter(f)
+	for _, obj := range h.collections[key] {
+		if expr != nil && expr.match(obj) {
+			deleted = append(deleted, obj)
+		} else {
+			kept = append(kept, obj)
+		}
+	}
+	h.collections[key] = kept
+	h.respond(w, op, deleted)
+}
+
+func (h *Handler) serveItem(w http.ResponseWriter, r *http.Request, op *operation, key, id string, body map[string]any) {
+	items := h.collections[key]
+	i := findByID(items, id)
+	if i < 0 {
+		writeError(w, r, http.StatusNotFound, "api.request.not-found", fmt.Sprintf("Object '%s' not found", id))
+		return
+	}
+	coll := h.spec.collections[op.collection]
+	switch op.Method {
+	case http.MethodPut:
+		items[i] = h.build(coll, withID(body, id))
+	case http.MethodPatch:
+		merged := cloneJSON(items[i]).(map[string]any)
+		mergePatch(merged, body)
+		items[i] = h.build(coll, withID(merged, id))
+	case http.MethodDelete:
+		deleted := items[i]
+		h.collections[key] = append(items[:i:i], items[i+1:]...)
+		h.respond(w, op, []map[string]any{deleted})
+		return
+	}
+	h.respond(w, op, []map[string]any{items[i]})
+}
+
+// serveOther answers operations outside the collections: GETs return the last
+// body PUT to the same path and query, else an example; PUTs store their
+// body; other methods (actions) return an example built on the body.
+func (h *Handler) serveOther(w http.ResponseWriter, op *operation, path string, query map[string]any, body map[string]any) {
+	key := path + "?" + encodeQuery(query)
+	switch {
+	case op.Method == http.MethodGet && path == specPrefix+"/info":
+		writeJSON(w, op.Status, h.spec.conform(op.Response, map[string]any{"applicationVersion": h.appVersion}))
+		return
+	case op.Method == http.MethodGet && h.singletons[key] != nil:
+		writeJSON(w, op.Status, h.spec.conform(op.Response, h.singletons[key]))
+		return
+	case op.Method == http.MethodPut && body != nil:
+		h.singletons[key] = h.spec.conform(op.RequestBody, body)
+	}
+	if op.Response == nil {
+		w.WriteHeader(op.Status)
+		return
+	}
+	var hint any
+	if body != nil {
+		hint = body
+	}
+	writeJSON(w, op.Status, h.spec.conform(op.Response, hint))
+}
+
+// build conforms body to the collection's detail and overview schemas, so the
+// stored object answers both item reads and list pages.
+func (h *Handler) build(coll *collection, body map[string]any) map[string]any {
+	stored, _ := h.spec.conform(coll.overview, body).(map[string]any)
+	if stored == nil {
+		stored = map[string]any{}
+	}
+	if detail, ok := h.spec.conform(coll.detail, body).(map[string]any); ok {
+		for k, v := range detail {
+			stored[k] = v
+		}
+	}
+	return stored
+}
+
+// respond writes the objects an operation affected, shaped into its response:
+// a response listing objects of the collection (a creation result) wraps
+// them, an integer "...Deleted" counter counts them, and any other object
+// response projects the first one. Operations without a response schema get
+// an empty body.
+func (h *Handler) respond(w http.ResponseWriter, op *operation, objs []map[string]any) {
+	resp := h.spec.resolve(op.Response)
+	if resp == nil {
+		w.WriteHeader(op.Status)
+		return
+	}
+	coll := h.spec.collections[op.collection]
+	hint := map[string]any{}
+	for name, prop := range resp.Properties {
+		p := h.spec.resolve(prop)
+		switch {
+		case p == nil:
+		case p.Type == "integer" && strings.HasSuffix(name, "Deleted"):
+			hint[name] = float64(len(objs))
+		case p.Type == "array" && coll != nil && h.sameSchema(p.Items, coll.detail, coll.overview):
+			list := make([]any, len(objs))
+			for i, obj := range objs {
+				list[i] = obj
+			}
+			hint[name] = list
+		}
+	}
+	if len(hint) == 0 && len(objs) > 0 {
+		writeJSON(w, op.Status, h.spec.conform(op.Response, objs[0]))
+		return
+	}
+	writeJSON(w, op.Status, h.spec.conform(op.Response, hint))
+}
+
+// sameSchema reports whether s resolves to one of candidates.
+func (h *Handler) sameSchema(s *schema, candidates ...*schema) bool {
+	target := h.spec.resolve(s)
+	for _, c := range candidates {
+		if rc := h.spec.resolve(c); target != nil && rc == target {
+			return true
+		}
+	}
+	return false
+}
+
+func paramSchema(op *operation, name string) *schema {
+	for _, p := range op.Parameters {
+		if p.Name == name {
+			return p.Schema
+		}
+	}
+	return nil
+}
+
+func withID(body map[string]any, id string) map[string]any {
+	out := map[string]any{}
+	for k, v := range body {
+		out[k] = v
+	}
+	out["id"] = id
+	return out
+}
+
+// mergePatch applies a JSON merge patch (RFC 7386) to dst.
+func mergePatch(dst, patch map[string]any) {
+	for k, v := range patch {
+		switch v := v.(type) {
+		case nil:
+			delete(dst, k)
+		case map[string]any:
+			child, ok := dst[k].(map[string]any)
+			if !ok {
+				child = map[string]any{}
+			}
+			mergePatch(child, v)
+			dst[k] = child
+		default:
+			dst[k] = v
+		}
+	}
+}
+
+func findByID(objs []map[string]any, id string) int {
+	for i, obj := range objs {
+		if fmt.Sprint(obj["id"]) == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func removeByID(objs []map[string]any, id string) []map[string]any {
+	if i := findByID(objs, id); i >= 0 {
+		return append(objs[:i:i], objs[i+1:]...)
+	}
+	return objs
+}
+
+func encodeQuery(query map[string]any) string {
+	v := url.Values{}
+	for k, val := range query {
+		v.Set(k, fmt.Sprint(val))
+	}
+	return v.Encode()
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeError writes the integration API's error body.
+func writeError(w http.ResponseWriter, r *http.Request, status int, code, msg string) {
+	writeJSON(w, status, map[string]any{
+		"statusCode":  status,
+		"statusName":  strings.ToUpper(strings.ReplaceAll(http.StatusText(status), " ", "_")),
+		"code":        code,
+		"message":     msg,
+		"timestamp":   time.Now().UTC().Format(time.RFC3339),
+		"requestPath": r.URL.Path,
+		"requestId":   uuid.NewString(),
+	})
+}
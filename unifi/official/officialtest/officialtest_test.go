@@ -0,0 +1,249 @@
+package officialtest //nolint: testpackage
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestHandler(t *testing.T, cfg *Config) (*Handler, string) {
+	t.Helper()
+	h, err := New(cfg)
+	require.NoError(t, err)
+	id, ok := h.SiteID(DefaultSite)
+	require.True(t, ok)
+	return h, "/sites/" + id.String()
+}
+
+// do sends a request to h and decodes the JSON response (nil for an empty
+// body).
+func do(t *testing.T, h http.Handler, method, path, body string) (int, map[string]any) {
+	t.Helper()
+	req := httptest.NewRequest(method, BasePath+path, strings.NewReader(body))
+	req.Header.Set(apiKeyHeader, "key")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	var out map[string]any
+	if rec.Body.Len() > 0 {
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &out), rec.Body.String())
+	}
+	return rec.Code, out
+}
+
+func TestVersions(t *testing.T) {
+	t.Parallel()
+	versions := Versions()
+	require.NotEmpty(t, versions)
+	assert.Equal(t, "10.1.78", versions[0])
+
+	newest := versions[len(versions)-1]
+	assert.Equal(t, newest, VersionFor("99.0.0"))
+	assert.Equal(t, "10.1.78", VersionFor("10.2.0"))
+	assert.Empty(t, VersionFor("9.5.21"))
+	assert.Empty(t, VersionFor("not-a-version"))
+
+	h, err := New(nil)
+	require.NoError(t, err)
+	assert.Equal(t, newest, h.Version())
+	_, err = New(&Config{Version: "1.0.0"})
+	require.ErrorContains(t, err, "no bundled spec")
+}
+
+// TestExamplesConform generates an example of every schema of every bundled
+// spec and validates it against the same schema.
+func TestExamplesConform(t *testing.T) {
+	t.Parallel()
+	for _, version := range Versions() {
+		sp, err := loadSpec(version)
+		require.NoError(t, err)
+		for name := range sp.Schemas {
+			ref := &schema{Ref: schemaRefPrefix + name}
+			assert.NoError(t, sp.validate(ref, sp.conform(ref, nil), ""), "%s: %s", version, name)
+		}
+	}
+}
+
+func TestInfo(t *testing.T) {
+	t.Parallel()
+	h, _ := newTestHandler(t, &Config{Version: "10.1.78", ApplicationVersion: "10.2.5"})
+	code, body := do(t, h, http.MethodGet, "/info", "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "10.2.5", body["applicationVersion"])
+}
+
+func TestCollectionCRUD(t *testing.T) {
+	t.Parallel()
+	h, site := newTestHandler(t, nil)
+
+	code, created := do(t, h, http.MethodPost, site+"/networks", `{"management":"UNMANAGED","name":"IoT","enabled":true,"vlanId":30}`)
+	require.Equal(t, http.StatusCreated, code, created)
+	id, _ := created["id"].(string)
+	require.NotEmpty(t, id)
+	assert.Equal(t, "IoT", created["name"])
+	assert.NotNil(t, created["metadata"], "required response fields are filled in")
+
+	code, got := do(t, h, http.MethodGet, site+"/networks/"+id, "")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, created, got)
+
+	code, updated := do(t, h, http.MethodPut, site+"/networks/"+id, `{"management":"UNMANAGED","name":"Cameras","enabled":false,"vlanId":31}`)
+	require.Equal(t, http.StatusOK, code, updated)
+	assert.Equal(t, id, updated["id"])
+	assert.Equal(t, "Cameras", updated["name"])
+	assert.Len(t, h.Objects(site+"/networks"), 1)
+
+	code, _ = do(t, h, http.MethodDelete, site+"/networks/"+id, "")
+	assert.Equal(t, http.StatusOK, code)
+	code, missing := do(t, h, http.MethodGet, site+"/networks/"+id, "")
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.Equal(t, "NOT_FOUND", missing["statusName"])
+	assert.Equal(t, BasePath+site+"/networks/"+id, missing["requestPath"])
+}
+
+func TestPatch(t *testing.T) {
+	t.Parallel()
+	h, site := newTestHandler(t, nil)
+	require.NoError(t, h.Seed(site+"/firewall/policies", map[string]any{"name": "block", "enabled": true}))
+	id := h.Objects(site + "/firewall/policies")[0]["id"].(string)
+
+	code, patched := do(t, h, http.MethodPatch, site+"/firewall/policies/"+id, `{"loggingEnabled":true}`)
+	require.Equal(t, http.StatusOK, code, patched)
+	assert.Equal(t, "block", patched["name"])
+	assert.Equal(t, true, patched["loggingEnabled"])
+}
+
+func TestValidation(t *testing.T) {
+	t.Parallel()
+	h, site := newTestHandler(t, nil)
+
+	tests := map[string]struct {
+		method, path, body string
+		want               string
+	}{
+		"range":              {http.MethodPost, site + "/networks", `{"management":"UNMANAGED","name":"IoT","enabled":true,"vlanId":5000}`, "'vlanId' must be less than or equal to 4009"},
+		"required":           {http.MethodPost, site + "/networks", `{"management":"UNMANAGED","enabled":true,"vlanId":30}`, "'name' must not be null"},
+		"min length":         {http.MethodPost, site + "/hotspot/vouchers", `{"name":"","timeLimitMinutes":60}`, "'name' must be at least 1 characters long"},
+		"type":               {http.MethodPost, site + "/networks", `{"management":"UNMANAGED","name":"IoT","enabled":"yes","vlanId":30}`, "'enabled' must be a boolean"},
+		"discriminator":      {http.MethodPost, site + "/networks", `{"management":"CLOUD","name":"IoT","enabled":true,"vlanId":30}`, "'management' must be one of GATEWAY, SWITCH, UNMANAGED"},
+		"missing body":       {http.MethodPost, site + "/networks", "", "Request body is required"},
+		"malformed body":     {http.MethodPost, site + "/networks", `{`, "Malformed request body"},
+		"path uuid":          {http.MethodGet, "/sites/default/networks", "", "'siteId' must be a valid uuid"},
+		"query bound":        {http.MethodGet, site + "/networks?limit=500", "", "'limit' must be less than or equal to 200"},
+		"query type":         {http.MethodGet, site + "/networks?offset=first", "", "'offset' must be a valid integer"},
+		"filter":             {http.MethodGet, site + "/networks?filter=name.eq(IoT)", "", "strings must be single-quoted"},
+		"required query arg": {http.MethodDelete, site + "/hotspot/vouchers", "", "'filter' must not be null"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			code, body := do(t, h, tc.method, tc.path, tc.body)
+			assert.Equal(t, http.StatusBadRequest, code)
+			assert.Equal(t, "BAD_REQUEST", body["statusName"])
+			assert.Contains(t, body["message"], tc.want)
+		})
+	}
+}
+
+func TestRouting(t *testing.T) {
+	t.Parallel()
+	h, site := newTestHandler(t, &Config{APIKey: "key"})
+
+	code, _ := do(t, h, http.MethodGet, "/nope", "")
+	assert.Equal(t, http.StatusNotFound, code)
+	code, _ = do(t, h, http.MethodPatch, site+"/networks", "")
+	assert.Equal(t, http.StatusMethodNotAllowed, code)
+	code, _ = do(t, h, http.MethodGet, "/sites/00000000-0000-0000-0000-000000000001/networks", "")
+	assert.Equal(t, http.StatusNotFound, code, "unknown sites are 404")
+
+	req := httptest.NewRequest(http.MethodGet, BasePath+"/info", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Contains(t, rec.Body.String(), "api.authentication.missing-credentials")
+}
+
+func TestPagination(t *testing.T) {
+	t.Parallel()
+	h, site := newTestHandler(t, nil)
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		require.NoError(t, h.Seed(site+"/networks", map[string]any{"management": "UNMANAGED", "name": name, "vlanId": 10}))
+	}
+
+	code, page := do(t, h, http.MethodGet, site+"/networks?offset=1&limit=2", "")
+	require.Equal(t, http.StatusOK, code)
+	assert.InDelta(t, 1, page["offset"], 0)
+	assert.InDelta(t, 2, page["limit"], 0)
+	assert.InDelta(t, 2, page["count"], 0)
+	assert.InDelta(t, 5, page["totalCount"], 0)
+	data := page["data"].([]any)
+	require.Len(t, data, 2)
+	assert.Equal(t, "b", data[0].(map[string]any)["name"])
+
+	_, page = do(t, h, http.MethodGet, site+"/networks", "")
+	assert.InDelta(t, 25, page["limit"], 0, "the spec's default limit applies")
+
+	_, page = do(t, h, http.MethodGet, site+"/networks?filter=or(name.in('a','e'),name.like('c*'))", "")
+	assert.InDelta(t, 3, page["totalCount"], 0)
+}
+
+func TestBulkDelete(t *testing.T) {
+	t.Parallel()
+	h, site := newTestHandler(t, nil)
+	require.NoError(t, h.Seed(site+"/hotspot/vouchers",
+		map[string]any{"name": "keep"}, map[string]any{"name": "guest-1"}, map[string]any{"name": "guest-2"}))
+
+	code, body := do(t, h, http.MethodDelete, site+"/hotspot/vouchers?filter=name.like('guest*')", "")
+	require.Equal(t, http.StatusOK, code)
+	assert.InDelta(t, 2, body["vouchersDeleted"], 0)
+	require.Len(t, h.Objects(site+"/hotspot/vouchers"), 1)
+
+	code, body = do(t, h, http.MethodPost, site+"/hotspot/vouchers", `{"name":"new","timeLimitMinutes":60}`)
+	require.Equal(t, http.StatusCreated, code, body)
+	vouchers := body["vouchers"].([]any)
+	require.Len(t, vouchers, 1, "the creation result lists the stored voucher")
+	assert.Equal(t, "new", vouchers[0].(map[string]any)["name"])
+}
+
+func TestSingletonsAndActions(t *testing.T) {
+	t.Parallel()
+	h, site := newTestHandler(t, nil)
+
+	code, _ := do(t, h, http.MethodGet, site+"/acl-rules/ordering", "")
+	require.Equal(t, http.StatusOK, code)
+	ordering := `{"orderedAclRuleIds":["4f1b6a0e-2f5c-4b8e-9a57-3f2b0c9d1e11"]}`
+	code, _ = do(t, h, http.MethodPut, site+"/acl-rules/ordering", ordering)
+	require.Equal(t, http.StatusOK, code)
+	_, got := do(t, h, http.MethodGet, site+"/acl-rules/ordering", "")
+	assert.Equal(t, []any{"4f1b6a0e-2f5c-4b8e-9a57-3f2b0c9d1e11"}, got["orderedAclRuleIds"])
+
+	require.NoError(t, h.Seed(site+"/devices", map[string]any{"name": "ap-1"}))
+	id := h.Objects(site + "/devices")[0]["id"].(string)
+	code, _ = do(t, h, http.MethodPost, site+"/devices/"+id+"/actions", `{"action":"RESTART"}`)
+	assert.Equal(t, http.StatusOK, code)
+	code, stats := do(t, h, http.MethodGet, site+"/devices/"+id+"/statistics/latest", "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.NotNil(t, stats)
+}
+
+func TestSites(t *testing.T) {
+	t.Parallel()
+	h, _ := newTestHandler(t, nil)
+	id := h.AddSite("branch", "Branch")
+	assert.Equal(t, id, h.AddSite("branch", "Branch"), "adding an existing site returns its id")
+	require.NoError(t, h.Seed("/sites/"+id.String()+"/networks", map[string]any{"name": "LAN"}))
+
+	_, page := do(t, h, http.MethodGet, "/sites?filter=internalReference.eq('branch')", "")
+	assert.InDelta(t, 1, page["totalCount"], 0)
+
+	h.RemoveSite("branch")
+	_, ok := h.SiteID("branch")
+	assert.False(t, ok)
+	assert.Empty(t, h.Objects("/sites/"+id.String()+"/networks"))
+	require.ErrorContains(t, h.Seed("/sites/"+id.String()+"/networks", map[string]any{}), "not found")
+	require.ErrorContains(t, h.Seed("/info", map[string]any{}), "not a collection")
+}
@@ -0,0 +1,442 @@
+package officialtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// maxExampleDepth bounds example generation through recursive schemas.
+const maxExampleDepth = 32
+
+// exampleTime is the instant generated date-time and date values use.
+var exampleTime = time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// validationError is a request that does not conform to the spec; field is the
+// dotted path of the offending value ("" for the whole body).
+type validationError struct {
+	field string
+	msg   string
+}
+
+func (e *validationError) Error() string {
+	if e.field == "" {
+		return e.msg
+	}
+	return fmt.Sprintf("'%s' %s", e.field, e.msg)
+}
+
+func invalid(field, format string, args ...any) error {
+	return &validationError{field: field, msg: fmt.Sprintf(format, args...)}
+}
+
+func joinField(base, name string) string {
+	if base == "" {
+		return name
+	}
+	return base + "." + name
+}
+
+// validate checks v (decoded JSON) against s.
+func (sp *spec) validate(s *schema, v any, field string) error {
+	return sp.validateSchema(s, v, field, true)
+}
+
+// validateSchema checks v against s. dispatch is false for allOf members: a
+// subtype lists its discriminated parents (often several sibling unions) in
+// allOf, and those contribute their own keywords only.
+func (sp *spec) validateSchema(s *schema, v any, field string, dispatch bool) error {
+	s = sp.resolve(s)
+	if s == nil {
+		return nil
+	}
+	if d := s.Discriminator; d != nil && len(d.Mapping) > 0 && dispatch {
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return invalid(field, "must be an object")
+		}
+		tag, _ := obj[d.PropertyName].(string)
+		ref, ok := d.mapping(tag)
+		if !ok {
+			return invalid(joinField(field, d.PropertyName), "must be one of %s", strings.Join(sortedKeys(d.Mapping), ", "))
+		}
+		return sp.validateSchema(&schema{Ref: ref}, v, field, false)
+	}
+	for _, sub := range s.AllOf {
+		if err := sp.validateSchema(sub, v, field, false); err != nil {
+			return err
+		}
+	}
+	if v == nil {
+		// Absent and null values are the parent's concern (required).
+		return nil
+	}
+	// Some integer enums are listed as strings ("2000"), so members compare by
+	// their rendering.
+	if len(s.Enum) > 0 && !slices.ContainsFunc(s.Enum, func(e any) bool { return fmt.Sprint(e) == fmt.Sprint(v) }) {
+		return invalid(field, "must be one of %s", joinValues(s.Enum))
+	}
+	switch {
+	case s.Type == "string":
+		return sp.validateString(s, v, field)
+	case s.Type == "integer" || s.Type == "number":
+		return validateNumber(s, v, field)
+	case s.Type == "boolean":
+		if _, ok := v.(bool); !ok {
+			return invalid(field, "must be a boolean")
+		}
+	case s.Type == "array":
+		return sp.validateArray(s, v, field)
+	case s.isObject():
+		return sp.validateObject(s, v, field)
+	}
+	return nil
+}
+
+func (sp *spec) validateObject(s *schema, v any, field string) error {
+	obj, ok := v.(map[string]any)
+	if !ok {
+		if s.Type == "object" {
+			return invalid(field, "must be an object")
+		}
+		return nil
+	}
+	for _, req := range s.Required {
+		if obj[req] == nil {
+			return invalid(joinField(field, req), "must not be null")
+		}
+	}
+	for _, k := range sortedKeys(s.Properties) {
+		if val, ok := obj[k]; ok {
+			if err := sp.validate(s.Properties[k], val, joinField(field, k)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (sp *spec) validateArray(s *schema, v any, field string) error {
+	arr, ok := v.([]any)
+	if !ok {
+		return invalid(field, "must be an array")
+	}
+	if s.MinItems != nil && len(arr) < *s.MinItems {
+		return invalid(field, "must contain at least %d items", *s.MinItems)
+	}
+	if s.MaxItems != nil && len(arr) > *s.MaxItems {
+		return invalid(field, "must contain at most %d items", *s.MaxItems)
+	}
+	for i, item := range arr {
+		if s.UniqueItems && slices.ContainsFunc(arr[:i], func(prev any) bool { return jsonEqual(prev, item) }) {
+			return invalid(field, "must contain unique items")
+		}
+		if err := sp.validate(s.Items, item, fmt.Sprintf("%s[%d]", field, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (sp *spec) validateString(s *schema, v any, field string) error {
+	str, ok := v.(string)
+	if !ok {
+		return invalid(field, "must be a string")
+	}
+	n := utf8.RuneCountInString(str)
+	if s.MinLength != nil && n < *s.MinLength {
+		return invalid(field, "must be at least %d characters long", *s.MinLength)
+	}
+	if s.MaxLength != nil && n > *s.MaxLength {
+		return invalid(field, "must be at most %d characters long", *s.MaxLength)
+	}
+	var err error
+	switch s.Format {
+	case "uuid":
+		_, err = uuid.Parse(str)
+	case "date-time":
+		_, err = time.Parse(time.RFC3339, str)
+	case "date":
+		_, err = time.Parse(time.DateOnly, str)
+	}
+	if err != nil {
+		return invalid(field, "must be a valid %s", s.Format)
+	}
+	return nil
+}
+
+func validateNumber(s *schema, v any, field string) error {
+	f, ok := v.(float64)
+	if !ok {
+		return invalid(field, "must be a number")
+	}
+	if s.Type == "integer" && f != math.Trunc(f) {
+		return invalid(field, "must be an integer")
+	}
+	if s.Minimum != nil && f < *s.Minimum {
+		return invalid(field, "must be greater than or equal to %s", formatNumber(*s.Minimum))
+	}
+	if s.Maximum != nil && f > *s.Maximum {
+		return invalid(field, "must be less than or equal to %s", formatNumber(*s.Maximum))
+	}
+	return nil
+}
+
+// conform returns a value of schema s built on hint: every field of hint the
+// schema knows is kept (recursively conformed), fields it does not know are
+// dropped, and missing required fields are filled with generated examples. A
+// nil hint yields a pure example. Discriminated schemas pick the subtype named
+// by hint's tag, else the first mapping entry.
+func (sp *spec) conform(s *schema, hint any) any {
+	return sp.conformDepth(s, hint, true, 0)
+}
+
+// conformDepth is conform with validateSchema's dispatch rule for allOf
+// members.
+func (sp *spec) conformDepth(s *schema, hint any, dispatch bool, depth int) any {
+	s = sp.resolve(s)
+	if s == nil || depth > maxExampleDepth {
+		return hint
+	}
+	if d := s.Discriminator; d != nil && len(d.Mapping) > 0 && dispatch {
+		tag := sortedKeys(d.Mapping)[0]
+		if h, ok := hint.(map[string]any); ok {
+			if t, ok := h[d.PropertyName].(string); ok {
+				if _, known := d.mapping(t); known {
+					tag = t
+				}
+			}
+		}
+		ref, _ := d.mapping(tag)
+		out := sp.conformDepth(&schema{Ref: ref}, hint, false, depth+1)
+		if obj, ok := out.(map[string]any); ok {
+			if have, _ := obj[d.PropertyName].(string); tagKey(have) != tagKey(tag) {
+				obj[d.PropertyName] = sp.tagValue(s, d.PropertyName, tag)
+			}
+		}
+		return out
+	}
+	switch {
+	case s.Type == "array":
+		arr, ok := hint.([]any)
+		if !ok {
+			if hint != nil {
+				return hint
+			}
+			if s.Example != nil && sp.validate(s, s.Example, "") == nil {
+				return cloneJSON(s.Example)
+			}
+			n := 0
+			if s.MinItems != nil {
+				n = *s.MinItems
+			}
+			arr = make([]any, n)
+		}
+		out := make([]any, len(arr))
+		for i, item := range arr {
+			out[i] = sp.conformDepth(s.Items, item, true, depth+1)
+		}
+		return out
+	case s.isObject():
+		return sp.conformObject(s, hint, depth)
+	case hint != nil:
+		return hint
+	default:
+		return sp.exampleScalar(s)
+	}
+}
+
+func (sp *spec) conformObject(s *schema, hint any, depth int) any {
+	h, ok := hint.(map[string]any)
+	if !ok && hint != nil {
+		return hint
+	}
+	if len(s.Properties) == 0 && len(s.AllOf) == 0 {
+		// Free-form map (additionalProperties): keep the hint as is.
+		if h == nil {
+			return map[string]any{}
+		}
+		return cloneJSON(h)
+	}
+	out := map[string]any{}
+	for _, sub := range s.AllOf {
+		if m, ok := sp.conformDepth(sub, hint, false, depth+1).(map[string]any); ok {
+			for k, v := range m {
+				out[k] = v
+			}
+		}
+	}
+	for _, k := range sortedKeys(s.Properties) {
+		if v, ok := h[k]; ok && v != nil {
+			out[k] = sp.conformDepth(s.Properties[k], v, true, depth+1)
+		}
+	}
+	for _, req := range s.Required {
+		if out[req] == nil {
+			if prop := sp.property(s, req); prop != nil {
+				out[req] = sp.conformDepth(prop, nil, true, depth+1)
+			}
+		}
+	}
+	return out
+}
+
+// mapping returns the subtype ref for a discriminator tag. Tags match
+// exactly or by tagKey: some unions key their mapping by the upper-cased enum
+// value with punctuation replaced ("AX_25" for "ax.25").
+func (d *discriminator) mapping(tag string) (string, bool) {
+	if ref, ok := d.Mapping[tag]; ok {
+		return ref, true
+	}
+	for key, ref := range d.Mapping {
+		if tagKey(key) == tagKey(tag) {
+			return ref, true
+		}
+	}
+	return "", false
+}
+
+// tagValue spells tag the way the discriminator property's enum does, if it
+// has one (e.g. "ax.25" for mapping key AX_25).
+func (sp *spec) tagValue(s *schema, name, tag string) any {
+	if prop := sp.resolve(sp.property(s, name)); prop != nil {
+		for _, e := range prop.Enum {
+			if tagKey(fmt.Sprint(e)) == tagKey(tag) {
+				return e
+			}
+		}
+	}
+	return tag
+}
+
+func tagKey(tag string) string {
+	return strings.ToUpper(strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, tag))
+}
+
+// property finds the schema of property name on s or, for a subtype that
+// only lists it as required, on its allOf members, most specific (last) first.
+// Bases often declare the property as an empty schema the subtype refines.
+func (sp *spec) property(s *schema, name string) *schema {
+	if prop := s.Properties[name]; prop != nil && !prop.isEmpty() {
+		return prop
+	}
+	for i := len(s.AllOf) - 1; i >= 0; i-- {
+		if r := sp.resolve(s.AllOf[i]); r != nil {
+			if prop := sp.property(r, name); prop != nil {
+				return prop
+			}
+		}
+	}
+	return nil
+}
+
+// exampleScalar generates a value for a leaf schema: the first of its
+// example, default and first enum value that validates (the spec has
+// examples like "ALLOW|BLOCK" and string enums on integers), else a
+// format-appropriate value within its bounds.
+func (sp *spec) exampleScalar(s *schema) any {
+	var candidates []any
+	if len(s.Enum) > 0 {
+		candidates = append(candidates, s.Enum[0])
+		if s.Type == "integer" || s.Type == "number" {
+			if f, err := strconv.ParseFloat(fmt.Sprint(s.Enum[0]), 64); err == nil {
+				candidates = append(candidates, f)
+			}
+		}
+	}
+	for _, c := range append([]any{s.Example, s.Default}, candidates...) {
+		if c != nil && sp.validate(s, c, "") == nil {
+			return cloneJSON(c)
+		}
+	}
+	switch s.Type {
+	case "string":
+		switch s.Format {
+		case "uuid":
+			return uuid.NewString()
+		case "date-time":
+			return exampleTime.Format(time.RFC3339)
+		case "date":
+			return exampleTime.Format(time.DateOnly)
+		}
+		str := "string"
+		if s.MinLength != nil && len(str) < *s.MinLength {
+			str += strings.Repeat("x", *s.MinLength-len(str))
+		}
+		if s.MaxLength != nil && len(str) > *s.MaxLength {
+			str = str[:*s.MaxLength]
+		}
+		return str
+	case "integer", "number":
+		switch {
+		case s.Minimum != nil:
+			return *s.Minimum
+		case s.Maximum != nil && *s.Maximum < 0:
+			return *s.Maximum
+		}
+		return 0.0
+	case "boolean":
+		return false
+	}
+	return nil
+}
+
+// cloneJSON deep-copies a decoded JSON value.
+func cloneJSON(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, child := range v {
+			out[k] = cloneJSON(child)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, child := range v {
+			out[i] = cloneJSON(child)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+func jsonEqual(a, b any) bool {
+	ra, errA := json.Marshal(a)
+	rb, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(ra) == string(rb)
+}
+
+func joinValues(vals []any) string {
+	parts := make([]string, len(vals))
+	for i, v := range vals {
+		parts[i] = fmt.Sprint(v)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func formatNumber(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
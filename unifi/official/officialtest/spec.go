@@ -0,0 +1,282 @@
+package officialtest
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	goversion "github.com/hashicorp/go-version"
+)
+
+// specs holds the reduced OpenAPI snapshots emitted by the codegen/official
+// frontend (-mock-out-dir), one per committed controller version.
+//
+//go:embed specs/*.json
+var specs embed.FS
+
+const schemaRefPrefix = "#/components/schemas/"
+
+// schema is the subset of OpenAPI 3.1 schema keywords the integration spec
+// uses. Documentation keywords are stripped at generation time.
+type schema struct {
+	Ref           string             `json:"$ref"`
+	Type          string             `json:"type"`
+	Format        string             `json:"format"`
+	Properties    map[string]*schema `json:"properties"`
+	Required      []string           `json:"required"`
+	Items         *schema            `json:"items"`
+	AllOf         []*schema          `json:"allOf"`
+	Discriminator *discriminator     `json:"discriminator"`
+	Enum          []any              `json:"enum"`
+	Minimum       *float64           `json:"minimum"`
+	Maximum       *float64           `json:"maximum"`
+	MinLength     *int               `json:"minLength"`
+	MaxLength     *int               `json:"maxLength"`
+	MinItems      *int               `json:"minItems"`
+	MaxItems      *int               `json:"maxItems"`
+	UniqueItems   bool               `json:"uniqueItems"`
+	Example       any                `json:"example"`
+	Default       any                `json:"default"`
+}
+
+type discriminator struct {
+	PropertyName string            `json:"propertyName"`
+	Mapping      map[string]string `json:"mapping"`
+}
+
+// isObject reports whether s describes an object, explicitly or through the
+// object keywords an allOf subtype carries without a type.
+func (s *schema) isObject() bool {
+	return s.Type == "object" || (s.Type == "" && (s.Properties != nil || s.Required != nil || s.AllOf != nil))
+}
+
+// isEmpty reports whether s is the empty schema {}, which accepts anything.
+func (s *schema) isEmpty() bool {
+	return s.Ref == "" && s.Type == "" && s.Properties == nil && s.AllOf == nil && s.Items == nil &&
+		s.Discriminator == nil && s.Enum == nil
+}
+
+type parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *schema `json:"schema"`
+}
+
+// operation is one method+path of a spec, as written by the generator, plus
+// the routing facts derived from it at load time.
+type operation struct {
+	Method       string      `json:"method"`
+	Path         string      `json:"path"`
+	Parameters   []parameter `json:"parameters"`
+	RequestBody  *schema     `json:"requestBody"`
+	BodyOptional bool        `json:"bodyOptional"`
+	Status       int         `json:"status"`
+	Response     *schema     `json:"response"`
+
+	segments []string
+	literals int
+	role     role
+	// collection is the list path template the operation acts on, for the
+	// list, create, bulk-delete and item roles.
+	collection string
+}
+
+// role is how the fake serves an operation.
+type role int
+
+const (
+	roleOther role = iota
+	roleList
+	roleCreate
+	roleBulkDelete
+	roleItem
+)
+
+// collection describes a paginated list path: the schema of its page items
+// and of a single stored object (the item GET response when the spec has one).
+type collection struct {
+	overview *schema
+	detail   *schema
+}
+
+type spec struct {
+	Version     string             `json:"version"`
+	Operations  []*operation       `json:"operations"`
+	Schemas     map[string]*schema `json:"schemas"`
+	collections map[string]*collection
+}
+
+// Versions returns the controller versions with a bundled spec, oldest first.
+func Versions() []string {
+	entries, _ := specs.ReadDir("specs")
+	out := make([]string, 0, len(entries))
+	for _, e := range entries {
+		name := strings.TrimSuffix(strings.TrimPrefix(e.Name(), "integration-"), ".json")
+		if _, err := goversion.NewVersion(name); err == nil {
+			out = append(out, name)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return goversion.Must(goversion.NewVersion(out[i])).LessThan(goversion.Must(goversion.NewVersion(out[j])))
+	})
+	return out
+}
+
+// VersionFor returns the newest bundled spec version not newer than the
+// controller version v, or "" when v predates the Official API (or does not
+// parse).
+func VersionFor(v string) string {
+	have, err := goversion.NewVersion(v)
+	if err != nil {
+		return ""
+	}
+	best := ""
+	for _, candidate := range Versions() {
+		if goversion.Must(goversion.NewVersion(candidate)).LessThanOrEqual(have) {
+			best = candidate
+		}
+	}
+	return best
+}
+
+func loadSpec(version string) (*spec, error) {
+	raw, err := specs.ReadFile(path.Join("specs", "integration-"+version+".json"))
+	if err != nil {
+		return nil, fmt.Errorf("no bundled spec for version %q (have %s)", version, strings.Join(Versions(), ", "))
+	}
+	var sp spec
+	if err := json.Unmarshal(raw, &sp); err != nil {
+		return nil, fmt.Errorf("parsing bundled spec %s: %w", version, err)
+	}
+	sp.index()
+	return &sp, nil
+}
+
+// index derives routing facts: a GET whose response is a page envelope makes
+// its path a collection; POST and DELETE on that path create and bulk-delete,
+// and <collection>/{param} is its item path.
+func (sp *spec) index() {
+	sp.collections = map[string]*collection{}
+	for _, op := range sp.Operations {
+		op.segments = strings.Split(strings.Trim(op.Path, "/"), "/")
+		for _, seg := range op.segments {
+			if !isParam(seg) {
+				op.literals++
+			}
+		}
+		if op.Method != "GET" {
+			continue
+		}
+		if items := sp.pageItems(op.Response); items != nil {
+			sp.collections[op.Path] = &collection{overview: items, detail: items}
+		}
+	}
+	for _, op := range sp.Operations {
+		parent, last := path.Split(op.Path)
+		parent = strings.TrimSuffix(parent, "/")
+		switch {
+		case sp.collections[op.Path] != nil:
+			op.collection = op.Path
+			switch op.Method {
+			case "GET":
+				op.role = roleList
+			case "POST":
+				op.role = roleCreate
+			case "DELETE":
+				op.role = roleBulkDelete
+			}
+		case sp.collections[parent] != nil && isParam(last):
+			op.collection = parent
+			op.role = roleItem
+			if op.Method == "GET" && op.Response != nil {
+				sp.collections[parent].detail = op.Response
+			}
+		}
+	}
+}
+
+// pageItems returns the data item schema when s is a page envelope.
+func (sp *spec) pageItems(s *schema) *schema {
+	s = sp.resolve(s)
+	if s == nil || s.Properties["totalCount"] == nil {
+		return nil
+	}
+	data := sp.resolve(s.Properties["data"])
+	if data == nil || data.Type != "array" {
+		return nil
+	}
+	return data.Items
+}
+
+// resolve follows $ref chains to the target schema.
+func (sp *spec) resolve(s *schema) *schema {
+	for i := 0; s != nil && s.Ref != "" && i < 16; i++ {
+		s = sp.Schemas[strings.TrimPrefix(s.Ref, schemaRefPrefix)]
+	}
+	return s
+}
+
+// match finds the operation for method and path, preferring the template
+// with the most literal segments (ordering over {firewallPolicyId}). allowed
+// lists the methods of path templates that match when method does not.
+func (sp *spec) match(method, p string) (*operation, map[string]string, []string) {
+	segs := strings.Split(strings.Trim(p, "/"), "/")
+	var (
+		best    *operation
+		params  map[string]string
+		allowed []string
+	)
+	for _, op := range sp.Operations {
+		vals, ok := matchSegments(op.segments, segs)
+		if !ok {
+			continue
+		}
+		if op.Method != method {
+			allowed = append(allowed, op.Method)
+			continue
+		}
+		if best == nil || op.literals > best.literals {
+			best, params = op, vals
+		}
+	}
+	return best, params, allowed
+}
+
+func matchSegments(tmpl, segs []string) (map[string]string, bool) {
+	if len(tmpl) != len(segs) {
+		return nil, false
+	}
+	vals := map[string]string{}
+	for i, t := range tmpl {
+		if isParam(t) {
+			if segs[i] == "" {
+				return nil, false
+			}
+			vals[strings.Trim(t, "{}")] = segs[i]
+			continue
+		}
+		if t != segs[i] {
+			return nil, false
+		}
+	}
+	return vals, true
+}
+
+func isParam(seg string) bool {
+	return strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}")
+}
+
+// expand substitutes params into a path template.
+func expand(tmpl string, params map[string]string) string {
+	segs := strings.Split(tmpl, "/")
+	for i, seg := range segs {
+		if isParam(seg) {
+			segs[i] = params[strings.Trim(seg, "{}")]
+		}
+	}
+	return strings.Join(segs, "/")
+}
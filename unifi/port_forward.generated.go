@@ -16,6 +16,26 @@ var (
 	_ json.Marshaler
 )
 
+// Self-register this resource's kind so the resourceKinds registry in
+// resource_registry.go mirrors the generated Client surface and can never
+// drift from it by hand.
+func init() { //nolint:gochecknoinits
+	registerResourceKind(ResourceKind{
+		Name:       "PortForward",
+		Endpoint:   "portforward",
+		APIVersion: APIVersionV1,
+		IDField:    "_id",
+		NameField:  "name",
+		SiteScoped: true,
+	}, resourceOps[PortForward]{
+		list:   InternalClient.ListPortForward,
+		get:    InternalClient.GetPortForward,
+		create: InternalClient.CreatePortForward,
+		update: InternalClient.UpdatePortForward,
+		delete: InternalClient.DeletePortForward,
+	})
+}
+
 type PortForward struct {
 	ID     string `json:"_id,omitempty"`
 	SiteID string `json:"site_id,omitempty"`
@@ -16,6 +16,26 @@ var (
 	_ json.Marshaler
 )
 
+// Self-register this resource's kind so the resourceKinds registry in
+// resource_registry.go mirrors the generated Client surface and can never
+// drift from it by hand.
+func init() { //nolint:gochecknoinits
+	registerResourceKind(ResourceKind{
+		Name:       "PortProfile",
+		Endpoint:   "portconf",
+		APIVersion: APIVersionV1,
+		IDField:    "_id",
+		NameField:  "name",
+		SiteScoped: true,
+	}, resourceOps[PortProfile]{
+		list:   InternalClient.ListPortProfile,
+		get:    InternalClient.GetPortProfile,
+		create: InternalClient.CreatePortProfile,
+		update: InternalClient.UpdatePortProfile,
+		delete: InternalClient.DeletePortProfile,
+	})
+}
+
 type PortProfile struct {
 	ID     string `json:"_id,omitempty"`
 	SiteID string `json:"site_id,omitempty"`
@@ -16,6 +16,26 @@ var (
 	_ json.Marshaler
 )
 
+// Self-register this resource's kind so the resourceKinds registry in
+// resource_registry.go mirrors the generated Client surface and can never
+// drift from it by hand.
+func init() { //nolint:gochecknoinits
+	registerResourceKind(ResourceKind{
+		Name:       "RADIUSProfile",
+		Endpoint:   "radiusprofile",
+		APIVersion: APIVersionV1,
+		IDField:    "_id",
+		NameField:  "name",
+		SiteScoped: true,
+	}, resourceOps[RADIUSProfile]{
+		list:   InternalClient.ListRADIUSProfile,
+		get:    InternalClient.GetRADIUSProfile,
+		create: InternalClient.CreateRADIUSProfile,
+		update: InternalClient.UpdateRADIUSProfile,
+		delete: InternalClient.DeleteRADIUSProfile,
+	})
+}
+
 type RADIUSProfile struct {
 	ID     string `json:"_id,omitempty"`
 	SiteID string `json:"site_id,omitempty"`
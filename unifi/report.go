@@ -0,0 +1,308 @@
+package unifi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// ErrInvalidReportWindow is returned by Reports().Get, without contacting the
+// controller, for a time window it cannot report on.
+var ErrInvalidReportWindow = errors.New("invalid report window")
+
+// ReportInterval is the granularity of a historical report, i.e. the time
+// between two of its entries.
+type ReportInterval string
+
+const (
+	ReportInterval5Minutes ReportInterval = "5minutes"
+	ReportIntervalHourly   ReportInterval = "hourly"
+	ReportIntervalDaily    ReportInterval = "daily"
+	ReportIntervalMonthly  ReportInterval = "monthly"
+)
+
+// reportIntervals holds the step and the default window (when Reports().Get is
+// given a zero from) of each interval. The controller keeps 5-minute data for
+// a day, hourly for 30 days, daily for 90 days and monthly for a year by
+// default; older entries are simply missing from a report.
+var reportIntervals = map[ReportInterval]struct {
+	step, window time.Duration
+}{
+	ReportInterval5Minutes: {step: 5 * time.Minute, window: 12 * time.Hour},
+	ReportIntervalHourly:   {step: time.Hour, window: 7 * 24 * time.Hour},
+	ReportIntervalDaily:    {step: 24 * time.Hour, window: 30 * 24 * time.Hour},
+	ReportIntervalMonthly:  {step: 28 * 24 * time.Hour, window: 365 * 24 * time.Hour},
+}
+
+// ReportKind is the kind of object a historical report covers.
+type ReportKind string
+
+const (
+	// ReportSite reports site-wide totals.
+	ReportSite ReportKind = "site"
+	// ReportAP reports per access point.
+	ReportAP ReportKind = "ap"
+	// ReportUser reports per client.
+	ReportUser ReportKind = "user"
+	// ReportGateway reports on the gateway.
+	ReportGateway ReportKind = "gw"
+)
+
+// ReportAttribute names a value a report entry carries. The controller accepts
+// more attributes than those declared here; any other name can be converted
+// with ReportAttribute("...").
+type ReportAttribute string
+
+const (
+	ReportBytes      ReportAttribute = "bytes"
+	ReportRxBytes    ReportAttribute = "rx_bytes"
+	ReportTxBytes    ReportAttribute = "tx_bytes"
+	ReportWANRxBytes ReportAttribute = "wan-rx_bytes"
+	ReportWANTxBytes ReportAttribute = "wan-tx_bytes"
+	ReportLANRxBytes ReportAttribute = "lan-rx_bytes"
+	ReportLANTxBytes ReportAttribute = "lan-tx_bytes"
+	ReportWLANBytes  ReportAttribute = "wlan_bytes"
+	ReportNumSta     ReportAttribute = "num_sta"
+	ReportLANNumSta  ReportAttribute = "lan-num_sta"
+	ReportWLANNumSta ReportAttribute = "wlan-num_sta"
+	ReportLatency    ReportAttribute = "latency"
+	ReportCPU        ReportAttribute = "cpu"
+	ReportMem        ReportAttribute = "mem"
+	ReportLoadAvg5   ReportAttribute = "loadavg_5"
+
+	// reportTime is always requested, as every entry needs its timestamp.
+	reportTime ReportAttribute = "time"
+)
+
+// ReportOption selects what a report covers: a ReportAttribute requests that
+// value, ReportFor narrows the report to some objects.
+type ReportOption interface {
+	applyReport(q *reportQuery)
+}
+
+// reportQuery collects the ReportOptions of a Reports().Get call.
+type reportQuery struct {
+	attrs []ReportAttribute
+	macs  []string
+}
+
+func (a ReportAttribute) applyReport(q *reportQuery) { q.attrs = append(q.attrs, a) }
+
+type reportMACs []string
+
+func (m reportMACs) applyReport(q *reportQuery) { q.macs = append(q.macs, m...) }
+
+// ReportFor narrows an AP, client or gateway report to the objects with the
+// given MAC addresses; without it the report covers every object of its kind.
+func ReportFor(macs ...string) ReportOption { //nolint:ireturn
+	return reportMACs(macs)
+}
+
+// defaultReportAttributes are requested when Reports().Get is given none.
+var defaultReportAttributes = map[ReportKind][]ReportAttribute{
+	ReportSite:    {ReportBytes, ReportWANTxBytes, ReportWANRxBytes, ReportWLANBytes, ReportNumSta, ReportLANNumSta, ReportWLANNumSta},
+	ReportAP:      {ReportBytes, ReportNumSta},
+	ReportUser:    {ReportRxBytes, ReportTxBytes},
+	ReportGateway: {ReportWANTxBytes, ReportWANRxBytes, ReportLANRxBytes, ReportLANTxBytes, ReportLatency, ReportCPU, ReportMem, ReportLoadAvg5},
+}
+
+// Report is a historical report: the time series of a site, or of its access
+// points, clients or gateway.
+type Report struct {
+	Interval   ReportInterval
+	Kind       ReportKind
+	From, To   time.Time
+	Attributes []ReportAttribute
+	// Entries are in the order the controller returned them, oldest first for
+	// each object.
+	Entries []ReportEntry
+}
+
+// ReportEntry holds the values of one object at one point in time. The
+// attributes declared as fields are decoded into them when present; Values
+// holds every numeric attribute, including those.
+type ReportEntry struct {
+	Time time.Time
+	// ObjectID identifies the object: the site ID, or the MAC address of the
+	// access point, client or gateway.
+	ObjectID string
+
+	Bytes      float64
+	RxBytes    float64
+	TxBytes    float64
+	WANRxBytes float64
+	WANTxBytes float64
+	LANRxBytes float64
+	LANTxBytes float64
+	WLANBytes  float64
+	NumSta     float64
+	LANNumSta  float64
+	WLANNumSta float64
+	Latency    float64
+	CPU        float64
+	Mem        float64
+	LoadAvg5   float64
+
+	Values map[ReportAttribute]float64
+}
+
+// UnmarshalJSON decodes an entry of the stat/report response, whose time is
+// in milliseconds since the epoch.
+func (e *ReportEntry) UnmarshalJSON(b []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	*e = ReportEntry{Values: make(map[ReportAttribute]float64, len(raw))}
+	for name, value := range raw {
+		var f float64
+		if json.Unmarshal(value, &f) == nil {
+			e.Values[ReportAttribute(name)] = f
+			continue
+		}
+		if name == "oid" {
+			if err := json.Unmarshal(value, &e.ObjectID); err != nil {
+				return fmt.Errorf("unable to decode report object ID: %w", err)
+			}
+		}
+	}
+	if ms, ok := e.Values[reportTime]; ok {
+		e.Time = time.UnixMilli(int64(ms))
+		delete(e.Values, reportTime)
+	}
+	for attr, field := range map[ReportAttribute]*float64{
+		ReportBytes:      &e.Bytes,
+		ReportRxBytes:    &e.RxBytes,
+		ReportTxBytes:    &e.TxBytes,
+		ReportWANRxBytes: &e.WANRxBytes,
+		ReportWANTxBytes: &e.WANTxBytes,
+		ReportLANRxBytes: &e.LANRxBytes,
+		ReportLANTxBytes: &e.LANTxBytes,
+		ReportWLANBytes:  &e.WLANBytes,
+		ReportNumSta:     &e.NumSta,
+		ReportLANNumSta:  &e.LANNumSta,
+		ReportWLANNumSta: &e.WLANNumSta,
+		ReportLatency:    &e.Latency,
+		ReportCPU:        &e.CPU,
+		ReportMem:        &e.Mem,
+		ReportLoadAvg5:   &e.LoadAvg5,
+	} {
+		*field = e.Values[attr]
+	}
+	return nil
+}
+
+// Point is a single value of a report, the unit for charting and export.
+type Point struct {
+	Time      time.Time       `json:"time"`
+	ObjectID  string          `json:"object_id"`
+	Attribute ReportAttribute `json:"attribute"`
+	Value     float64         `json:"value"`
+}
+
+// Series returns the values of attr, one point per entry that has it, in entry
+// order. For a report on several objects (access points, clients) the series
+// of each object are interleaved; filter on Point.ObjectID to chart one.
+func (r *Report) Series(attr ReportAttribute) []Point {
+	var points []Point
+	for _, e := range r.Entries {
+		if v, ok := e.Values[attr]; ok {
+			points = append(points, Point{Time: e.Time, ObjectID: e.ObjectID, Attribute: attr, Value: v})
+		}
+	}
+	return points
+}
+
+// Points returns every value of the report in long form: for each entry in
+// order, one point per requested attribute it has.
+func (r *Report) Points() []Point {
+	var points []Point
+	for _, e := range r.Entries {
+		for _, attr := range r.Attributes {
+			if v, ok := e.Values[attr]; ok {
+				points = append(points, Point{Time: e.Time, ObjectID: e.ObjectID, Attribute: attr, Value: v})
+			}
+		}
+	}
+	return points
+}
+
+/*
+GetReport implements Reports().Get: it returns the historical report of kind
+over [from, to] at interval granularity, as the controller charts it in its UI.
+A zero to means now, a zero from a default window before to (12 hours of
+5-minute data, 7 days hourly, 30 days daily, a year monthly). The
+ReportAttributes among opts select the values to report; without any a default
+set for kind is requested (traffic and client counts, plus LAN traffic,
+latency, CPU, memory and load for the gateway). ReportFor narrows the report to
+some access points, clients or gateways.
+
+The window must end after it starts and span at least one interval, or
+Reports().Get returns an error matching ErrInvalidReportWindow. Entries older than
+the controller's retention for the interval are missing from the report.
+*/
+func (c *client) GetReport(ctx context.Context, site string, interval ReportInterval, kind ReportKind, from, to time.Time, opts ...ReportOption) (*Report, error) {
+	spec, ok := reportIntervals[interval]
+	if !ok {
+		return nil, fmt.Errorf("unknown report interval %q", interval)
+	}
+	if _, ok := defaultReportAttributes[kind]; !ok {
+		return nil, fmt.Errorf("unknown report kind %q", kind)
+	}
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.Add(-spec.window)
+	}
+	if !from.Before(to) {
+		return nil, fmt.Errorf("%w: from %s is not before to %s", ErrInvalidReportWindow, from.Format(time.RFC3339), to.Format(time.RFC3339))
+	}
+	if to.Sub(from) < spec.step {
+		return nil, fmt.Errorf("%w: %s is shorter than the %s interval", ErrInvalidReportWindow, to.Sub(from), interval)
+	}
+	var q reportQuery
+	for _, opt := range opts {
+		opt.applyReport(&q)
+	}
+	if len(q.macs) > 0 && kind == ReportSite {
+		return nil, errors.New("a site report cannot be narrowed to MAC addresses")
+	}
+	attrs := q.attrs
+	if len(attrs) == 0 {
+		attrs = defaultReportAttributes[kind]
+	}
+	attrs = slices.DeleteFunc(slices.Clone(attrs), func(a ReportAttribute) bool { return a == reportTime })
+
+	reqBody := struct {
+		Attrs []ReportAttribute `json:"attrs"`
+		Start int64             `json:"start"`
+		End   int64             `json:"end"`
+		MACs  []string          `json:"macs,omitempty"`
+	}{
+		Attrs: append([]ReportAttribute{reportTime}, attrs...),
+		Start: from.UnixMilli(),
+		End:   to.UnixMilli(),
+		MACs:  q.macs,
+	}
+	var respBody struct {
+		Meta Meta          `json:"meta"`
+		Data []ReportEntry `json:"data"`
+	}
+	err := c.Post(ctx, fmt.Sprintf("s/%s/stat/report/%s.%s", site, interval, kind), reqBody, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Report{
+		Interval:   interval,
+		Kind:       kind,
+		From:       from,
+		To:         to,
+		Attributes: attrs,
+		Entries:    respBody.Data,
+	}, nil
+}
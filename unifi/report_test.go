@@ -0,0 +1,137 @@
+package unifi //nolint: testpackage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetReport(t *testing.T) {
+	t.Parallel()
+	cs := newControllerServer(t, route{apiV1Path("s/default/stat/report/hourly.ap"), func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[
+			{"time":1700000000000,"oid":"aa:bb:cc:dd:ee:01","ap":"aa:bb:cc:dd:ee:01","o":"ap","bytes":1024.5,"num_sta":3},
+			{"time":1700003600000,"oid":"aa:bb:cc:dd:ee:01","ap":"aa:bb:cc:dd:ee:01","o":"ap","bytes":2048,"num_sta":4},
+			{"time":1700003600000,"oid":"aa:bb:cc:dd:ee:02","ap":"aa:bb:cc:dd:ee:02","o":"ap","num_sta":1}
+		]}`))
+	}})
+	c := cs.client()
+	from := time.UnixMilli(1700000000000)
+	to := from.Add(2 * time.Hour)
+
+	report, err := c.GetReport(context.Background(), "default", ReportIntervalHourly, ReportAP, from, to)
+	require.NoError(t, err)
+
+	req := cs.lastRequest()
+	assert.Equal(t, http.MethodPost, req.Method)
+	assert.JSONEq(t, `{"attrs":["time","bytes","num_sta"],"start":1700000000000,"end":1700007200000}`, string(req.Body))
+
+	assert.Equal(t, []ReportAttribute{ReportBytes, ReportNumSta}, report.Attributes)
+	require.Len(t, report.Entries, 3)
+	first := report.Entries[0]
+	assert.True(t, first.Time.Equal(from))
+	assert.Equal(t, "aa:bb:cc:dd:ee:01", first.ObjectID)
+	assert.InDelta(t, 1024.5, first.Bytes, 0)
+	assert.InDelta(t, 3, first.NumSta, 0)
+	assert.NotContains(t, first.Values, reportTime)
+
+	assert.Equal(t, []Point{
+		{Time: from, ObjectID: "aa:bb:cc:dd:ee:01", Attribute: ReportBytes, Value: 1024.5},
+		{Time: from.Add(time.Hour), ObjectID: "aa:bb:cc:dd:ee:01", Attribute: ReportBytes, Value: 2048},
+	}, report.Series(ReportBytes), "entries without the attribute are skipped")
+	assert.Len(t, report.Points(), 5)
+}
+
+func TestGetReportAttributes(t *testing.T) {
+	t.Parallel()
+	cs := newControllerServer(t, route{apiV1Path("s/default/stat/report/5minutes.gw"), func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"time":1700000000000,"oid":"gw1","cpu":12.5,"mem":40,"lan-rx_errors":2}]}`))
+	}})
+	c := cs.client()
+
+	report, err := c.GetReport(context.Background(), "default", ReportInterval5Minutes, ReportGateway, time.Time{}, time.Time{}, ReportCPU, ReportAttribute("lan-rx_errors"), reportTime)
+	require.NoError(t, err)
+
+	var body struct {
+		Attrs      []string `json:"attrs"`
+		Start, End int64
+	}
+	require.NoError(t, json.Unmarshal(cs.lastRequest().Body, &body))
+	assert.Equal(t, []string{"time", "cpu", "lan-rx_errors"}, body.Attrs, "time is requested once")
+	assert.Equal(t, 12*time.Hour, time.Duration(body.End-body.Start)*time.Millisecond, "a zero window defaults by interval")
+	assert.WithinDuration(t, time.Now(), time.UnixMilli(body.End), time.Minute)
+
+	require.Len(t, report.Entries, 1)
+	assert.InDelta(t, 12.5, report.Entries[0].CPU, 0)
+	assert.InDelta(t, 2, report.Entries[0].Values["lan-rx_errors"], 0)
+	assert.Equal(t, []Point{{Time: time.UnixMilli(1700000000000), ObjectID: "gw1", Attribute: ReportCPU, Value: 12.5}, {Time: time.UnixMilli(1700000000000), ObjectID: "gw1", Attribute: "lan-rx_errors", Value: 2}}, report.Points(), "only requested attributes are exported")
+}
+
+func TestGetReportGatewayDefaults(t *testing.T) {
+	t.Parallel()
+	cs := newControllerServer(t, route{apiV1Path("s/default/stat/report/hourly.gw"), func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"time":1700000000000,"oid":"gw1",
+			"wan-tx_bytes":1,"wan-rx_bytes":2,"lan-rx_bytes":3,"lan-tx_bytes":4,"latency":5,"cpu":6,"mem":7,"loadavg_5":8}]}`))
+	}})
+	c := cs.client()
+
+	report, err := c.GetReport(context.Background(), "default", ReportIntervalHourly, ReportGateway, time.Time{}, time.Time{})
+	require.NoError(t, err)
+
+	require.Len(t, report.Entries, 1)
+	e := report.Entries[0]
+	assert.Equal(t, []float64{1, 2, 3, 4, 5, 6, 7, 8}, []float64{e.WANTxBytes, e.WANRxBytes, e.LANRxBytes, e.LANTxBytes, e.Latency, e.CPU, e.Mem, e.LoadAvg5}, "every default attribute has a typed field")
+}
+
+func TestGetReportFor(t *testing.T) {
+	t.Parallel()
+	cs := newControllerServer(t, route{apiV1Path("s/default/stat/report/daily.user"), func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[]}`))
+	}})
+	c := cs.client()
+	from := time.UnixMilli(1700000000000)
+	to := from.Add(48 * time.Hour)
+
+	_, err := c.GetReport(context.Background(), "default", ReportIntervalDaily, ReportUser, from, to,
+		ReportFor("aa:bb:cc:dd:ee:01"), ReportRxBytes, ReportFor("aa:bb:cc:dd:ee:02"))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"attrs":["time","rx_bytes"],"start":1700000000000,"end":1700172800000,"macs":["aa:bb:cc:dd:ee:01","aa:bb:cc:dd:ee:02"]}`, string(cs.lastRequest().Body))
+}
+
+func TestGetReportValidation(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	tests := map[string]struct {
+		interval   ReportInterval
+		kind       ReportKind
+		from, to   time.Time
+		opts       []ReportOption
+		wantWindow bool
+	}{
+		"from after to":         {interval: ReportIntervalDaily, kind: ReportSite, from: now, to: now.Add(-time.Hour), wantWindow: true},
+		"empty window":          {interval: ReportIntervalDaily, kind: ReportSite, from: now, to: now, wantWindow: true},
+		"shorter than a step":   {interval: ReportIntervalDaily, kind: ReportSite, from: now.Add(-time.Hour), to: now, wantWindow: true},
+		"unknown interval":      {interval: "weekly", kind: ReportSite},
+		"unknown kind":          {interval: ReportIntervalDaily, kind: "switch"},
+		"site narrowed to MACs": {interval: ReportIntervalDaily, kind: ReportSite, opts: []ReportOption{ReportFor("aa:bb:cc:dd:ee:01")}},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			cs := newControllerServer(t)
+			c := cs.client()
+
+			_, err := c.GetReport(context.Background(), "default", tt.interval, tt.kind, tt.from, tt.to, tt.opts...)
+			require.Error(t, err)
+			if tt.wantWindow {
+				require.ErrorIs(t, err, ErrInvalidReportWindow)
+			}
+			assert.Zero(t, cs.requestCount())
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package unifi
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultLogBodyBytes is how much of each body RequestLogging keeps when
+// MaxBodyBytes is unset.
+const defaultLogBodyBytes = 4 << 10 // 4 KiB
+
+// redactedValue replaces secret values in logged bodies.
+const redactedValue = "REDACTED"
+
+// defaultRedactedFields are the JSON fields always redacted from logged
+// bodies, matched case-insensitively. UniFi prefixes its secret fields with
+// "x_" (x_passphrase, x_password, x_secret, x_ssh_password, ...), so every
+// such field is redacted as well.
+var defaultRedactedFields = []string{"password", "passphrase", "secret", "token", "apikey", "api_key"}
+
+// IsSecretField reports whether the JSON field name holds a secret: an x_*
+// field or one of password, passphrase, secret, token, apikey and api_key,
+// matched case-insensitively. Request logging and the audit journal redact such
+// fields; unifitest scrubs them from cassettes.
+func IsSecretField(name string) bool {
+	if len(name) >= 2 && strings.EqualFold(name[:2], "x_") {
+		return true
+	}
+	return slices.ContainsFunc(defaultRedactedFields, func(f string) bool { return strings.EqualFold(f, name) })
+}
+
+/*
+RequestLogging configures structured, attribute-based logging of the request
+and response lifecycle, in addition to the printf-style ClientConfig.Logger.
+
+Every controller call emits a "unifi request" event when it is sent and a
+"unifi response" event when it completes. Both carry the attributes method,
+path, surface, resource, operation and (when site-scoped) site; the response
+event adds status (when a response arrived), duration and, on failure, error
+and error_type (see the error.type attribute of ClientConfig.Tracer). With
+LogBodies set, the events also carry request_body and response_body, with
+secrets redacted. Request headers, and so the API key, are never logged.
+*/
+type RequestLogging struct {
+	// Logger receives the events. A nil Logger disables request logging.
+	Logger *slog.Logger
+	// Level is the level of the request event and of successful responses
+	// (the zero value is slog.LevelInfo). Failed calls are logged at
+	// slog.LevelWarn, or at Level when it is higher.
+	Level slog.Level
+	// LogBodies adds the request and response bodies to the events.
+	LogBodies bool
+	// MaxBodyBytes caps the size of a logged body; longer bodies are omitted,
+	// as only a complete JSON body can be redacted. Zero means 4 KiB.
+	MaxBodyBytes int
+	// RedactFields names additional JSON fields whose values are replaced
+	// with "REDACTED", matched case-insensitively at any depth. Fields
+	// prefixed "x_" and the password, passphrase, secret, token, apikey and
+	// api_key fields are always redacted.
+	RedactFields []string
+}
+
+// requestLogger is the resolved form of a RequestLogging. A nil *requestLogger
+// disables request logging.
+type requestLogger struct {
+	logger  *slog.Logger
+	level   slog.Level
+	bodies  bool
+	maxBody int
+	redact  []string
+}
+
+func newRequestLogger(cfg *RequestLogging) *requestLogger {
+	if cfg == nil || cfg.Logger == nil {
+		return nil
+	}
+	l := &requestLogger{logger: cfg.Logger, level: cfg.Level, bodies: cfg.LogBodies, maxBody: cfg.MaxBodyBytes, redact: cfg.RedactFields}
+	if l.maxBody <= 0 {
+		l.maxBody = defaultLogBodyBytes
+	}
+	return l
+}
+
+// bodyValue renders a captured body of total bytes for logging, redacted on
+// its decoded JSON. A body cut short by maxBody, or that is not JSON, cannot be
+// redacted reliably and is omitted, noting its size.
+func (l *requestLogger) bodyValue(body []byte, total int) string {
+	if total > len(body) {
+		return "(omitted truncated body, " + strconv.Itoa(total) + " bytes)"
+	}
+	redacted := redactJSON(body, l.redact)
+	if redacted == nil {
+		return "(omitted non-JSON body, " + strconv.Itoa(total) + " bytes)"
+	}
+	return string(redacted)
+}
+
+// requestLog tracks one in-flight call for logging. Methods on a nil
+// *requestLog are no-ops.
+type requestLog struct {
+	l      *requestLogger
+	attrs  []slog.Attr
+	start  time.Time
+	status int
+	resp   *bodyCapture
+}
+
+// start logs the request event for req, as it is about to be sent, and
+// returns the tracker for its response.
+func (l *requestLogger) start(ctx context.Context, req *http.Request) *requestLog {
+	if l == nil {
+		return nil
+	}
+	op := describeOperation(req.Method, req.URL.Path)
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.String("surface", op.Surface),
+		slog.String("resource", op.Resource),
+		slog.String("operation", op.Operation),
+	}
+	if op.Site != "" {
+		attrs = append(attrs, slog.String("site", op.Site))
+	}
+	if req.URL.RawQuery != "" {
+		attrs = append(attrs, slog.String("query", req.URL.RawQuery))
+	}
+	rl := &requestLog{l: l, attrs: attrs, start: time.Now()}
+
+	event := slices.Clip(attrs)
+	if l.bodies && req.GetBody != nil {
+		if mediaType := req.Header.Get(ContentTypeHeader); !strings.Contains(mediaType, "json") {
+			// Uploads are multipart file content, not worth logging.
+			event = append(event, slog.String("request_body", "(omitted "+mediaType+" body)"))
+		} else if body, err := req.GetBody(); err == nil {
+			capture := &bodyCapture{limit: l.maxBody}
+			_, _ = io.Copy(capture, body)
+			body.Close()
+			event = append(event, slog.String("request_body", l.bodyValue(capture.buf, capture.total)))
+		}
+	}
+	l.logger.LogAttrs(ctx, l.level, "unifi request", event...)
+	return rl
+}
+
+// observe records the response status and, when bodies are logged, tees the
+// response body into a capture as the client reads it.
+func (rl *requestLog) observe(resp *http.Response) {
+	if rl == nil {
+		return
+	}
+	rl.status = resp.StatusCode
+	if rl.l.bodies {
+		rl.resp = &bodyCapture{limit: rl.l.maxBody}
+		resp.Body = teeReadCloser{Reader: io.TeeReader(resp.Body, rl.resp), Closer: resp.Body}
+	}
+}
+
+// end logs the response event for the call's outcome.
+func (rl *requestLog) end(ctx context.Context, err error) {
+	if rl == nil {
+		return
+	}
+	attrs := slices.Clip(rl.attrs)
+	if rl.status != 0 {
+		attrs = append(attrs, slog.Int("status", rl.status))
+	}
+	attrs = append(attrs, slog.Duration("duration", time.Since(rl.start)))
+	if rl.resp != nil && rl.resp.total > 0 {
+		attrs = append(attrs, slog.String("response_body", rl.l.bodyValue(rl.resp.buf, rl.resp.total)))
+	}
+	level := rl.l.level
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()), slog.String("error_type", errorType(err, rl.status)))
+		level = max(level, slog.LevelWarn)
+	}
+	rl.l.logger.LogAttrs(ctx, level, "unifi response", attrs...)
+}
+
+// bodyCapture keeps the first limit bytes written to it and counts the rest.
+type bodyCapture struct {
+	buf   []byte
+	limit int
+	total int
+}
+
+func (c *bodyCapture) Write(p []byte) (int, error) {
+	c.total += len(p)
+	if room := c.limit - len(c.buf); room > 0 {
+		c.buf = append(c.buf, p[:min(room, len(p))]...)
+	}
+	return len(p), nil
+}
+
+type teeReadCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,152 @@
+package unifi //nolint: testpackage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// logEvents decodes the JSON lines written by a slog.JSONHandler.
+func logEvents(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var events []map[string]any
+	for line := range strings.SplitSeq(strings.TrimSpace(buf.String()), "\n") {
+		var e map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &e), line)
+		events = append(events, e)
+	}
+	return events
+}
+
+func withRequestLogging(buf *bytes.Buffer, cfg RequestLogging) func(*ClientConfig) {
+	return func(c *ClientConfig) {
+		cfg.Logger = slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		c.RequestLogging = &cfg
+	}
+}
+
+func TestRequestLoggingEvents(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	cs := newControllerServer(t, route{apiV1Path("s/default/rest/networkconf"), func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[]}`))
+	}})
+	c := cs.clientWith(withRequestLogging(&buf, RequestLogging{Level: slog.LevelDebug}))
+
+	_, err := c.ListNetwork(context.Background(), "default")
+	require.NoError(t, err)
+
+	events := logEvents(t, &buf)
+	require.Len(t, events, 2)
+	req, resp := events[0], events[1]
+	assert.Equal(t, "unifi request", req["msg"])
+	assert.Equal(t, "DEBUG", req["level"])
+	assert.Equal(t, http.MethodGet, req["method"])
+	assert.Equal(t, apiV1Path("s/default/rest/networkconf"), req["path"])
+	assert.Equal(t, "default", req["site"])
+	assert.Equal(t, "Network", req["resource"])
+	assert.Equal(t, "List", req["operation"])
+	assert.NotContains(t, req, "request_body", "bodies are opt-in")
+
+	assert.Equal(t, "unifi response", resp["msg"])
+	assert.Equal(t, "DEBUG", resp["level"])
+	assert.InDelta(t, http.StatusOK, resp["status"], 0)
+	assert.Contains(t, resp, "duration")
+	assert.NotContains(t, resp, "response_body")
+	assert.NotContains(t, resp, "error")
+}
+
+func TestRequestLoggingFailure(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	cs := newControllerServer(t, route{apiV1Path("s/default/rest/networkconf/missing"), func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}})
+	c := cs.clientWith(withRequestLogging(&buf, RequestLogging{}))
+
+	_, err := c.GetNetwork(context.Background(), "default", "missing")
+	require.Error(t, err)
+
+	events := logEvents(t, &buf)
+	require.Len(t, events, 2)
+	assert.Equal(t, "INFO", events[0]["level"], "the zero Level is Info")
+	assert.Equal(t, "WARN", events[1]["level"])
+	assert.InDelta(t, http.StatusNotFound, events[1]["status"], 0)
+	assert.Equal(t, "server", events[1]["error_type"])
+	assert.Equal(t, err.Error(), events[1]["error"])
+}
+
+func TestRequestLoggingBodiesRedacted(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	cs := newControllerServer(t, route{apiV1Path("s/default/rest/wlanconf"), func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"name":"corp","x_passphrase":"s3cret-resp","radius":{"X_Secret":"r4dius"}}]}`))
+	}})
+	c := cs.clientWith(withRequestLogging(&buf, RequestLogging{LogBodies: true, RedactFields: []string{"name"}}))
+
+	body := map[string]any{"name": "corp", "x_passphrase": "s3cret-req", "users": []any{map[string]any{"password": "pw1"}}, "token": 987654321}
+	require.NoError(t, c.Post(context.Background(), "s/default/rest/wlanconf", body, &struct{}{}))
+
+	out := buf.String()
+	for _, secret := range []string{"s3cret-req", "s3cret-resp", "r4dius", "pw1", "corp", "987654321"} {
+		assert.NotContains(t, out, secret)
+	}
+	events := logEvents(t, &buf)
+	require.Len(t, events, 2)
+	assert.JSONEq(t, `{"name":"REDACTED","token":"REDACTED","users":[{"password":"REDACTED"}],"x_passphrase":"REDACTED"}`, events[0]["request_body"].(string))
+	assert.Contains(t, events[1]["response_body"], `"X_Secret":"REDACTED"`)
+}
+
+func TestRequestLoggingTruncatesBodies(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	long := strings.Repeat("a", 100)
+	payload := `{"meta":{"rc":"ok"},"data":[{"x_password":"` + long + `"}]}`
+	cs := newControllerServer(t, route{apiV1Path("s/default/rest/networkconf"), func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(payload))
+	}})
+	c := cs.clientWith(withRequestLogging(&buf, RequestLogging{LogBodies: true, MaxBodyBytes: 50}))
+
+	_, err := c.ListNetwork(context.Background(), "default")
+	require.NoError(t, err)
+
+	events := logEvents(t, &buf)
+	require.Len(t, events, 2)
+	assert.Equal(t, fmt.Sprintf("(omitted truncated body, %d bytes)", len(payload)), events[1]["response_body"],
+		"a cut body cannot be redacted reliably and is omitted")
+	assert.NotContains(t, buf.String(), "aaaa")
+}
+
+func TestRequestLoggingRedact(t *testing.T) {
+	t.Parallel()
+	l := newRequestLogger(&RequestLogging{Logger: slog.Default(), RedactFields: []string{"psk"}})
+	tests := map[string]string{
+		`{"x_passphrase":"a\"b"}`:                    `{"x_passphrase":"REDACTED"}`,
+		`{"x_ssh_password": "p", "n": 1}`:            `{"x_ssh_password":"REDACTED","n":1}`,
+		`{"apiKey":"k","api_key":null}`:              `{"apiKey":"REDACTED","api_key":"REDACTED"}`,
+		`{"Password":12345}`:                         `{"Password":"REDACTED"}`,
+		`{"x_keys":["a","b"],"n":[1,2]}`:             `{"x_keys":"REDACTED","n":[1,2]}`,
+		`{"PSK":{"key":"k"},"nested":[{"token":1}]}`: `{"PSK":"REDACTED","nested":[{"token":"REDACTED"}]}`,
+		`{"name":"password","enabled":true}`:         `{"name":"password","enabled":true}`,
+		`{"big":12345678901234567890}`:               `{"big":12345678901234567890}`,
+	}
+	for in, want := range tests {
+		assert.JSONEq(t, want, l.bodyValue([]byte(in), len(in)), in)
+	}
+	assert.Equal(t, "(omitted non-JSON body, 15 bytes)", l.bodyValue([]byte(`x_password=abc`+"\n"), 15))
+	assert.Equal(t, "(omitted truncated body, 99 bytes)", l.bodyValue([]byte(`{"x_passphrase":"cut sho`), 99))
+}
+
+func TestRequestLoggingDisabled(t *testing.T) {
+	t.Parallel()
+	assert.Nil(t, newRequestLogger(nil))
+	assert.Nil(t, newRequestLogger(&RequestLogging{LogBodies: true}))
+}
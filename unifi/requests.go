@@ -43,12 +43,14 @@ func (c *client) buildRequestURL(apiPath string) (*url.URL, error) {
 	return c.baseURL.ResolveReference(reqURL), nil
 }
 
-// validateRequestBody validates the request body if validation is enabled.
-func (c *client) validateRequestBody(reqBody any) error {
-	if reqBody != nil && c.validationMode != DisableValidation {
+// validateRequestBody validates the request body if validation is enabled for
+// the call.
+func (c *client) validateRequestBody(ctx context.Context, reqBody any) error {
+	mode := c.validationModeFor(ctx)
+	if reqBody != nil && mode != DisableValidation {
 		c.log.Trace("Validating request body")
 		if err := c.validator.Validate(reqBody); err != nil {
-			if c.validationMode == HardValidation {
+			if mode == HardValidation {
 				return fmt.Errorf("failed validating request body: %w", err)
 			} else {
 				c.log.Warnf("failed validating request body: %s", err)
@@ -111,13 +113,27 @@ func (c *client) handleResponse(resp *http.Response, respBody any, method, apiPa
 		c.log.Trace("No response body to decode")
 		return nil
 	}
-	return c.decodeResponseBody(resp, respBody, method, apiPath)
+	limit := maxResponseBodySize
+	if resp.Request != nil {
+		limit = responseBodyLimit(resp.Request.Context())
+	}
+	if stream, ok := respBody.(streamDecoder); ok {
+		c.log.Trace("Streaming response body")
+		err := stream.decodeStream(resp, limit)
+		var serverErr *ServerError
+		if err == nil || errors.As(err, &serverErr) {
+			return err
+		}
+		return fmt.Errorf("unable to decode body: %s %s %w", method, apiPath, err)
+	}
+	return c.decodeResponseBody(resp, respBody, limit, method, apiPath)
 }
 
-// decodeResponseBody buffers the response body once and decodes it into respBody.
+// decodeResponseBody buffers up to limit bytes of the response body once and
+// decodes it into respBody.
 // It also performs the centralized v1 meta rc:error check and the
 // decode-on-body / empty-body handling. respBody is assumed non-nil.
-func (c *client) decodeResponseBody(resp *http.Response, respBody any, method, apiPath string) error {
+func (c *client) decodeResponseBody(resp *http.Response, respBody any, limit int, method, apiPath string) error {
 	// Buffer the body ONCE into a capped []byte so it can be both probed for a v1
 	// meta envelope and decoded into respBody without re-reading the
 	// network stream. The cap bounds memory against a runaway/hostile body while
@@ -126,12 +142,12 @@ func (c *client) decodeResponseBody(resp *http.Response, respBody any, method, a
 	// Read ONE byte past the cap so an over-cap body can be detected
 	// rather than silently truncated (a truncated body would otherwise surface as
 	// an opaque "unable to decode body" JSON error that hides the real cause).
-	body, err := io.ReadAll(io.LimitReader(resp.Body, int64(maxResponseBodySize)+1))
+	body, err := io.ReadAll(io.LimitReader(resp.Body, int64(limit)+1))
 	if err != nil {
 		return fmt.Errorf("unable to read body: %s %s %w", method, apiPath, err)
 	}
-	if len(body) > maxResponseBodySize {
-		return fmt.Errorf("response body exceeded %d bytes: %s %s", maxResponseBodySize, method, apiPath)
+	if len(body) > limit {
+		return fmt.Errorf("response body exceeded %d bytes: %s %s", limit, method, apiPath)
 	}
 
 	if metaErr := metaEnvelopeError(resp, body); metaErr != nil {
@@ -181,31 +197,63 @@ func metaEnvelopeError(resp *http.Response, body []byte) error {
 		return nil
 	}
 	// Enrich the soft-error *ServerError with the response context so it does not
-	// render with a zero status/empty method+URL. resp.Request can be nil on a
-	// hand-built *http.Response (e.g. unit tests), so guard it.
-	var serverErr *ServerError
-	if errors.As(err, &serverErr) {
-		serverErr.StatusCode = resp.StatusCode
-		if resp.Request != nil {
-			serverErr.RequestMethod = resp.Request.Method
-			if resp.Request.URL != nil {
-				serverErr.RequestURL = resp.Request.URL.String()
-			}
-		}
-	}
-	return err
+	// render with a zero status/empty method+URL.
+	return stampSoftError(resp, err)
 }
 
-// executeRequest executes an HTTP request with the given context, method, URL, body, and headers.
+// executeRequest executes an HTTP request with the given context, method, URL, body, and headers,
+// subject to the read-only and dry-run modes and through the response cache when one is configured.
 // It applies interceptors, handles errors, and decodes the response body if provided.
 // Returns an error if the request or response handling fails.
 func (c *client) executeRequest(ctx context.Context, method, apiPath string, body io.Reader, headers http.Header, respBody any) error {
+	if c.readOnly || c.dryRun != nil || c.cache != nil || c.audit != nil {
+		url, err := c.buildRequestURL(apiPath)
+		if err != nil {
+			return fmt.Errorf("unable to create request URL: %w", err)
+		}
+		var read bool
+		if read, body, err = classifyRequest(method, url.Path, body); err != nil {
+			return err
+		}
+		if !read {
+			if handled, err := c.guardMutation(method, url, body, headers, respBody); handled {
+				return err
+			}
+		}
+		if c.cache != nil {
+			if !read {
+				// Invalidate once the mutation completed, so a read racing it
+				// cannot repopulate the cache with the old state.
+				defer c.invalidateCache(method, url)
+			} else if _, streaming := respBody.(streamDecoder); !streaming && body == nil && len(callOptionsFrom(ctx).headers) == 0 {
+				if cached, err := c.cachedGet(ctx, url, apiPath, headers, respBody); cached {
+					return err
+				}
+			}
+		}
+		if c.audit != nil && !read {
+			return c.sendAudited(ctx, method, apiPath, url, body, headers, respBody)
+		}
+	}
+	return c.sendRequest(ctx, method, apiPath, body, headers, respBody)
+}
+
+// sendRequest sends an HTTP request to the controller and handles its response.
+func (c *client) sendRequest(ctx context.Context, method, apiPath string, body io.Reader, headers http.Header, respBody any) (err error) {
 	url, err := c.buildRequestURL(apiPath)
 	if err != nil {
 		return fmt.Errorf("unable to create request URL: %w", err)
 	}
 	c.log.Debugf("Executing request: %s %s", method, url.String())
 
+	ctx, cancel := withCallTimeout(ctx)
+	defer cancel()
+
+	// The observation spans the whole call, so interceptor, transport and
+	// decode failures are all recorded against the logical operation.
+	ctx, obs := c.telemetry.start(ctx, method, url.Path)
+	defer func() { obs.end(ctx, err) }()
+
 	req, err := http.NewRequestWithContext(ctx, method, url.String(), body)
 	if err != nil {
 		return fmt.Errorf("unable to create request: %s %s %w", method, apiPath, err)
@@ -221,13 +269,18 @@ func (c *client) executeRequest(ctx context.Context, method, apiPath string, bod
 	}
 
 	// Set headers if provided overriding any coming from interceptors
-	overrideHeaders(req, headers)
+	overrideHeaders(req, callHeaders(ctx, headers))
+
+	rlog := c.requestLogger.start(ctx, req)
+	defer func() { rlog.end(ctx, err) }()
 
-	resp, err := c.http.Do(req)
+	resp, err := c.httpClientFor(ctx).Do(req)
 	if err != nil {
 		return fmt.Errorf("unable to perform request: %s %s %w", method, apiPath, err)
 	}
 	defer resp.Body.Close()
+	obs.setStatus(resp.StatusCode)
+	rlog.observe(resp)
 
 	return c.handleResponse(resp, respBody, method, apiPath)
 }
@@ -349,7 +402,7 @@ func (c *client) UploadFileFromReader(ctx context.Context, apiPath string, reade
 func (c *client) Do(ctx context.Context, method, apiPath string, reqBody any, respBody any) error {
 	c.log.Tracef("Performing request: %s %s", method, apiPath)
 
-	if err := c.validateRequestBody(reqBody); err != nil {
+	if err := c.validateRequestBody(ctx, reqBody); err != nil {
 		return err
 	}
 
@@ -0,0 +1,208 @@
+package unifi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrUnknownResourceKind is returned when a resource kind is not registered,
+// e.g. Resource[T] for a type that is not a generated CRUD resource.
+var ErrUnknownResourceKind = errors.New("unknown resource kind")
+
+// ErrUnsupportedOperation is returned by a ResourceClient operation the kind
+// does not expose (e.g. Get on ContentFiltering). Check ResourceKind.Supports
+// up front to avoid it.
+var ErrUnsupportedOperation = errors.New("operation not supported by resource kind")
+
+// ResourceClient is the generic CRUD surface of a single resource kind. It
+// forwards to the matching typed InternalClient methods (ListNetwork,
+// GetNetwork, ...), so behavior is identical to calling those directly.
+type ResourceClient[T any] interface {
+	// Kind returns the metadata of the resource kind.
+	Kind() ResourceKind
+	// List lists the resources of the site.
+	List(ctx context.Context, site string) ([]T, error)
+	// Get retrieves a resource by ID.
+	Get(ctx context.Context, site, id string) (*T, error)
+	// Create creates a resource.
+	Create(ctx context.Context, site string, obj *T) (*T, error)
+	// Update updates a resource.
+	Update(ctx context.Context, site string, obj *T) (*T, error)
+	// Delete deletes a resource by ID.
+	Delete(ctx context.Context, site, id string) error
+}
+
+// DynamicResourceClient is the untyped counterpart of ResourceClient, used when
+// iterating kinds reflectively. Objects are pointers to the kind's Go type
+// (*Network for the Network kind); Create and Update reject any other type.
+type DynamicResourceClient interface {
+	// Kind returns the metadata of the resource kind.
+	Kind() ResourceKind
+	// List lists the resources of the site as pointers to the kind's type.
+	List(ctx context.Context, site string) ([]any, error)
+	// Get retrieves a resource by ID.
+	Get(ctx context.Context, site, id string) (any, error)
+	// Create creates a resource from obj.
+	Create(ctx context.Context, site string, obj any) (any, error)
+	// Update updates a resource from obj.
+	Update(ctx context.Context, site string, obj any) (any, error)
+	// Delete deletes a resource by ID.
+	Delete(ctx context.Context, site, id string) error
+}
+
+// Resource returns the generic ResourceClient for T bound to c, e.g.
+//
+//	networks, err := unifi.Resource[unifi.Network](c)
+//	all, err := networks.List(ctx, "default")
+//
+// It returns ErrUnknownResourceKind when T is not a registered resource kind.
+func Resource[T any](c InternalClient) (ResourceClient[T], error) { //nolint:ireturn
+	name := reflect.TypeFor[T]().Name()
+	e, ok := lookupRegisteredKind(name)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownResourceKind, name)
+	}
+	ops, ok := e.ops.(resourceOps[T])
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownResourceKind, reflect.TypeFor[T]())
+	}
+	return resourceClient[T]{c: c, kind: e.kind, ops: ops}, nil
+}
+
+// DynamicResource returns the untyped DynamicResourceClient for the kind named
+// kind (the Go type name, e.g. "Network") bound to c. Combine it with
+// ResourceKinds to write tooling that walks every resource:
+//
+//	for _, k := range unifi.ResourceKinds() {
+//		rc, _ := unifi.DynamicResource(c, k.Name)
+//		objs, err := rc.List(ctx, "default")
+//		...
+//	}
+//
+// It returns ErrUnknownResourceKind when no such kind is registered.
+func DynamicResource(c InternalClient, kind string) (DynamicResourceClient, error) { //nolint:ireturn
+	e, ok := lookupRegisteredKind(kind)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownResourceKind, kind)
+	}
+	return e.dyn(c), nil
+}
+
+// resourceClient is the ResourceClient implementation over a kind's resourceOps.
+type resourceClient[T any] struct {
+	c    InternalClient
+	kind ResourceKind
+	ops  resourceOps[T]
+}
+
+func (r resourceClient[T]) Kind() ResourceKind {
+	return r.kind
+}
+
+// unsupported builds the ErrUnsupportedOperation error for op on this kind.
+func (r resourceClient[T]) unsupported(op ResourceOperation) error {
+	return fmt.Errorf("%w: %s %s", ErrUnsupportedOperation, op, r.kind.Name)
+}
+
+func (r resourceClient[T]) List(ctx context.Context, site string) ([]T, error) {
+	if r.ops.list == nil {
+		return nil, r.unsupported(OperationList)
+	}
+	return r.ops.list(r.c, ctx, site)
+}
+
+func (r resourceClient[T]) Get(ctx context.Context, site, id string) (*T, error) {
+	if r.ops.get == nil {
+		return nil, r.unsupported(OperationGet)
+	}
+	return r.ops.get(r.c, ctx, site, id)
+}
+
+func (r resourceClient[T]) Create(ctx context.Context, site string, obj *T) (*T, error) {
+	if r.ops.create == nil {
+		return nil, r.unsupported(OperationCreate)
+	}
+	return r.ops.create(r.c, ctx, site, obj)
+}
+
+func (r resourceClient[T]) Update(ctx context.Context, site string, obj *T) (*T, error) {
+	if r.ops.update == nil {
+		return nil, r.unsupported(OperationUpdate)
+	}
+	return r.ops.update(r.c, ctx, site, obj)
+}
+
+func (r resourceClient[T]) Delete(ctx context.Context, site, id string) error {
+	if r.ops.delete == nil {
+		return r.unsupported(OperationDelete)
+	}
+	return r.ops.delete(r.c, ctx, site, id)
+}
+
+// dynamicResourceClient adapts a typed resourceClient to DynamicResourceClient.
+type dynamicResourceClient[T any] struct {
+	typed resourceClient[T]
+}
+
+func (d dynamicResourceClient[T]) Kind() ResourceKind {
+	return d.typed.kind
+}
+
+func (d dynamicResourceClient[T]) List(ctx context.Context, site string) ([]any, error) {
+	items, err := d.typed.List(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]any, len(items))
+	for i := range items {
+		out[i] = &items[i]
+	}
+	return out, nil
+}
+
+func (d dynamicResourceClient[T]) Get(ctx context.Context, site, id string) (any, error) {
+	obj, err := d.typed.Get(ctx, site, id)
+	if err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (d dynamicResourceClient[T]) Create(ctx context.Context, site string, obj any) (any, error) {
+	typed, err := d.assert(obj)
+	if err != nil {
+		return nil, err
+	}
+	created, err := d.typed.Create(ctx, site, typed)
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (d dynamicResourceClient[T]) Update(ctx context.Context, site string, obj any) (any, error) {
+	typed, err := d.assert(obj)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := d.typed.Update(ctx, site, typed)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func (d dynamicResourceClient[T]) Delete(ctx context.Context, site, id string) error {
+	return d.typed.Delete(ctx, site, id)
+}
+
+// assert converts obj to *T, rejecting any other dynamic type.
+func (d dynamicResourceClient[T]) assert(obj any) (*T, error) {
+	typed, ok := obj.(*T)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type for %s resource. expected: *%s, received: %T", d.typed.kind.Name, d.typed.kind.Name, obj)
+	}
+	return typed, nil
+}
@@ -0,0 +1,178 @@
+package unifi //nolint: testpackage
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestResourceKindsMetadata pins the generated registry: representative v1, v2
+// and hand-written kinds carry the expected metadata, and settings (which have
+// their own registry) and Client-excluded resources are absent.
+func TestResourceKindsMetadata(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		endpoint   string
+		version    APIVersion
+		nameField  string
+		siteScoped bool
+		ops        []ResourceOperation
+	}{
+		"Network":            {endpoint: "networkconf", version: APIVersionV1, nameField: "name", siteScoped: true, ops: []ResourceOperation{OperationList, OperationGet, OperationCreate, OperationUpdate, OperationDelete}},
+		"FirewallZonePolicy": {endpoint: "firewall-policies", version: APIVersionV2, nameField: "name", siteScoped: true, ops: []ResourceOperation{OperationList, OperationGet, OperationCreate, OperationUpdate, OperationDelete}},
+		"ContentFiltering":   {endpoint: "content-filtering", version: APIVersionV2, nameField: "name", siteScoped: true, ops: []ResourceOperation{OperationList, OperationCreate, OperationUpdate, OperationDelete}},
+		"Site":               {endpoint: "self/sites", version: APIVersionV1, nameField: "name", siteScoped: false, ops: []ResourceOperation{OperationList, OperationGet}},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			k, ok := LookupResourceKind(name)
+			require.True(t, ok)
+			assert.Equal(t, name, k.Name)
+			assert.Equal(t, tc.endpoint, k.Endpoint)
+			assert.Equal(t, tc.version, k.APIVersion)
+			assert.Equal(t, "_id", k.IDField)
+			assert.Equal(t, tc.nameField, k.NameField)
+			assert.Equal(t, tc.siteScoped, k.SiteScoped)
+			assert.Equal(t, tc.ops, k.Operations)
+			assert.Equal(t, name, k.Type.Name())
+		})
+	}
+
+	for _, absent := range []string{"SettingMgmt", "DescribedFeature", "FirewallZoneMatrix"} {
+		_, ok := LookupResourceKind(absent)
+		assert.False(t, ok, "%s must not be a registered resource kind", absent)
+	}
+}
+
+// TestResourceKindsCoverClientSurface asserts every CRUD resource on
+// InternalClient (one with both ListX and CreateX) has a matching kind, so the
+// registry cannot silently lag the Client, and that ResourceKinds is sorted.
+func TestResourceKindsCoverClientSurface(t *testing.T) {
+	t.Parallel()
+
+	kinds := ResourceKinds()
+	names := make(map[string]bool, len(kinds))
+	for i, k := range kinds {
+		names[k.Name] = true
+		if i > 0 {
+			assert.Less(t, kinds[i-1].Name, k.Name, "ResourceKinds must be sorted by Name")
+		}
+	}
+
+	it := reflect.TypeFor[InternalClient]()
+	for i := range it.NumMethod() {
+		kind, ok := strings.CutPrefix(it.Method(i).Name, "List")
+		if !ok {
+			continue
+		}
+		if _, hasCreate := it.MethodByName("Create" + kind); hasCreate {
+			assert.True(t, names[kind], "InternalClient.List%s has no registered resource kind", kind)
+		}
+	}
+}
+
+// TestResourceClientForwardsToTypedMethods drives a ResourceClient over a
+// ClientMock: each generic operation must land on the typed method with the
+// same arguments.
+func TestResourceClientForwardsToTypedMethods(t *testing.T) {
+	t.Parallel()
+
+	mock := &ClientMock{
+		ListNetworkFunc: func(_ context.Context, site string) ([]Network, error) {
+			return []Network{{ID: "n1", Name: "LAN"}}, nil
+		},
+		GetNetworkFunc: func(_ context.Context, site, id string) (*Network, error) {
+			return &Network{ID: id}, nil
+		},
+		CreateNetworkFunc: func(_ context.Context, site string, n *Network) (*Network, error) {
+			created := *n
+			created.ID = "new"
+			return &created, nil
+		},
+		UpdateNetworkFunc: func(_ context.Context, site string, n *Network) (*Network, error) {
+			return n, nil
+		},
+		DeleteNetworkFunc: func(_ context.Context, site, id string) error {
+			return nil
+		},
+	}
+
+	rc, err := Resource[Network](mock)
+	require.NoError(t, err)
+	assert.Equal(t, "Network", rc.Kind().Name)
+
+	ctx := context.Background()
+	list, err := rc.List(ctx, "default")
+	require.NoError(t, err)
+	assert.Equal(t, "LAN", list[0].Name)
+
+	got, err := rc.Get(ctx, "default", "n1")
+	require.NoError(t, err)
+	assert.Equal(t, "n1", got.ID)
+
+	created, err := rc.Create(ctx, "default", &Network{Name: "IoT"})
+	require.NoError(t, err)
+	assert.Equal(t, "new", created.ID)
+
+	_, err = rc.Update(ctx, "default", created)
+	require.NoError(t, err)
+	require.NoError(t, rc.Delete(ctx, "default", "new"))
+
+	assert.Len(t, mock.ListNetworkCalls(), 1)
+	assert.Equal(t, "n1", mock.GetNetworkCalls()[0].ID)
+	assert.Equal(t, "IoT", mock.CreateNetworkCalls()[0].N.Name)
+	assert.Equal(t, "default", mock.UpdateNetworkCalls()[0].Site)
+	assert.Equal(t, "new", mock.DeleteNetworkCalls()[0].ID)
+}
+
+// TestResourceClientUnsupportedAndUnknown pins the two error sentinels: an
+// operation the kind lacks, and a type that is not a resource kind.
+func TestResourceClientUnsupportedAndUnknown(t *testing.T) {
+	t.Parallel()
+
+	rc, err := Resource[ContentFiltering](&ClientMock{})
+	require.NoError(t, err)
+	_, err = rc.Get(context.Background(), "default", "x")
+	require.ErrorIs(t, err, ErrUnsupportedOperation)
+	assert.False(t, rc.Kind().Supports(OperationGet))
+
+	_, err = Resource[SysInfo](&ClientMock{})
+	require.ErrorIs(t, err, ErrUnknownResourceKind)
+
+	_, err = DynamicResource(&ClientMock{}, "Nope")
+	require.ErrorIs(t, err, ErrUnknownResourceKind)
+}
+
+// TestDynamicResourceOverWire lists a v1 kind through the untyped client against
+// the mock controller: the request hits the kind's endpoint and items come back
+// as pointers whose ID/name are readable through the kind metadata.
+func TestDynamicResourceOverWire(t *testing.T) {
+	t.Parallel()
+
+	cs := newControllerServer(t, route{apiV1Path("s/default/rest/tag"), func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"_id":"t1","name":"cams"}]}`))
+	}})
+	c := cs.client()
+
+	rc, err := DynamicResource(c, "Tag")
+	require.NoError(t, err)
+	objs, err := rc.List(context.Background(), "default")
+	require.NoError(t, err)
+	require.Len(t, objs, 1)
+
+	tag, ok := objs[0].(*Tag)
+	require.True(t, ok)
+	assert.Equal(t, "cams", tag.Name)
+	assert.Equal(t, "t1", rc.Kind().ObjectID(objs[0]))
+	assert.Equal(t, "cams", rc.Kind().ObjectName(objs[0]))
+
+	_, err = rc.Create(context.Background(), "default", &Network{})
+	require.ErrorContains(t, err, "expected: *Tag")
+}
@@ -0,0 +1,211 @@
+package unifi
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// APIVersion identifies which controller API tree a resource kind lives under.
+type APIVersion int
+
+const (
+	// APIVersionV1 is the classic Network API: s/{site}/rest/<endpoint>
+	// (stat/<endpoint> for reads of some kinds), wrapped in a {meta,data} envelope.
+	APIVersionV1 APIVersion = iota + 1
+	// APIVersionV2 is the v2 API: v2/api/site/{site}/<endpoint>, returning bare
+	// JSON objects and arrays.
+	APIVersionV2
+)
+
+// String returns "v1" or "v2".
+func (v APIVersion) String() string {
+	switch v {
+	case APIVersionV1:
+		return "v1"
+	case APIVersionV2:
+		return "v2"
+	default:
+		return fmt.Sprintf("APIVersion(%d)", int(v))
+	}
+}
+
+// ResourceOperation is one of the standard CRUD operations a kind may support.
+type ResourceOperation string
+
+const (
+	OperationList   ResourceOperation = "List"
+	OperationGet    ResourceOperation = "Get"
+	OperationCreate ResourceOperation = "Create"
+	OperationUpdate ResourceOperation = "Update"
+	OperationDelete ResourceOperation = "Delete"
+)
+
+// ResourceKind describes one CRUD resource reachable through the Client, so
+// generic tooling (export, diff, bulk delete) can be written once instead of per
+// type. Kinds are registered by the generated *.generated.go files (Site, whose
+// operations are hand-written, registers in sites.go) and are enumerated with
+// ResourceKinds.
+type ResourceKind struct {
+	// Name is the Go type name of the resource, e.g. "Network".
+	Name string
+	// Endpoint is the controller path segment of the resource under its site
+	// scope, e.g. "networkconf" (v1) or "firewall-policies" (v2).
+	Endpoint string
+	// APIVersion is the API tree the resource lives under.
+	APIVersion APIVersion
+	// IDField is the JSON wire name of the identifier field, e.g. "_id".
+	IDField string
+	// NameField is the JSON wire name of the human-readable name field, or ""
+	// when the resource has none.
+	NameField string
+	// SiteScoped reports whether the resource lives under a site. Operations on
+	// a kind that is not site-scoped ignore the site argument.
+	SiteScoped bool
+	// Type is the Go struct type of the resource (not a pointer).
+	Type reflect.Type
+	// Operations lists the operations supported by the kind, in CRUD order.
+	Operations []ResourceOperation
+}
+
+// Supports reports whether the kind exposes op.
+func (k ResourceKind) Supports(op ResourceOperation) bool {
+	return slices.Contains(k.Operations, op)
+}
+
+// New returns a pointer to a fresh zero value of the kind's Go type.
+func (k ResourceKind) New() any {
+	return reflect.New(k.Type).Interface()
+}
+
+// ObjectID returns the value of the IDField of obj (a value or pointer of the
+// kind's type), or "" when it cannot be read.
+func (k ResourceKind) ObjectID(obj any) string {
+	return stringFieldByJSONName(obj, k.IDField)
+}
+
+// ObjectName returns the value of the NameField of obj (a value or pointer of
+// the kind's type), or "" when the kind has no name field.
+func (k ResourceKind) ObjectName(obj any) string {
+	return stringFieldByJSONName(obj, k.NameField)
+}
+
+// stringFieldByJSONName reads the string field of struct obj whose json tag
+// names jsonName. Non-struct values, an empty jsonName and non-string fields all
+// yield "".
+func stringFieldByJSONName(obj any, jsonName string) string {
+	if jsonName == "" || obj == nil {
+		return ""
+	}
+	v := reflect.ValueOf(obj)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+	t := v.Type()
+	for i := range t.NumField() {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name == jsonName && v.Field(i).Kind() == reflect.String {
+			return v.Field(i).String()
+		}
+	}
+	return ""
+}
+
+// resourceOps binds a kind's operations to InternalClient methods. The
+// generated registrations use method expressions (InternalClient.ListNetwork),
+// so any InternalClient — the real client or a ClientMock — can be driven
+// generically. A nil operation is unsupported for the kind.
+type resourceOps[T any] struct {
+	list   func(InternalClient, context.Context, string) ([]T, error)
+	get    func(InternalClient, context.Context, string, string) (*T, error)
+	create func(InternalClient, context.Context, string, *T) (*T, error)
+	update func(InternalClient, context.Context, string, *T) (*T, error)
+	delete func(InternalClient, context.Context, string, string) error
+}
+
+// operations returns the supported operations in CRUD order.
+func (o resourceOps[T]) operations() []ResourceOperation {
+	var ops []ResourceOperation
+	if o.list != nil {
+		ops = append(ops, OperationList)
+	}
+	if o.get != nil {
+		ops = append(ops, OperationGet)
+	}
+	if o.create != nil {
+		ops = append(ops, OperationCreate)
+	}
+	if o.update != nil {
+		ops = append(ops, OperationUpdate)
+	}
+	if o.delete != nil {
+		ops = append(ops, OperationDelete)
+	}
+	return ops
+}
+
+// registeredKind is a registry entry: the public kind metadata plus its typed
+// operations, stored as any and asserted back to resourceOps[T] by Resource.
+type registeredKind struct {
+	kind ResourceKind
+	ops  any
+	dyn  func(InternalClient) DynamicResourceClient
+}
+
+// resourceKinds maps a kind name to its entry. It is populated by the
+// registerResourceKind calls emitted from the generated *.generated.go files
+// (one init() per resource on the Client) plus the hand-written Site kind, and
+// is read-only once package initialization completes.
+var resourceKinds = map[string]registeredKind{}
+
+// registerResourceKind wires a kind to its typed operations. It is called from
+// the generated per-resource init() functions. A duplicate kind name panics at
+// init time so a collision in the generated catalog fails loudly.
+func registerResourceKind[T any](kind ResourceKind, ops resourceOps[T]) {
+	kind.Type = reflect.TypeFor[T]()
+	kind.Operations = ops.operations()
+	if _, exists := resourceKinds[kind.Name]; exists {
+		panic("unifi: duplicate resource kind registration: " + kind.Name)
+	}
+	resourceKinds[kind.Name] = registeredKind{
+		kind: kind,
+		ops:  ops,
+		dyn: func(c InternalClient) DynamicResourceClient {
+			return dynamicResourceClient[T]{resourceClient[T]{c: c, kind: kind, ops: ops}}
+		},
+	}
+}
+
+// ResourceKinds returns every registered resource kind, sorted by Name.
+func ResourceKinds() []ResourceKind {
+	kinds := make([]ResourceKind, 0, len(resourceKinds))
+	for _, e := range resourceKinds {
+		k := e.kind
+		k.Operations = slices.Clone(k.Operations)
+		kinds = append(kinds, k)
+	}
+	slices.SortFunc(kinds, func(a, b ResourceKind) int { return cmp.Compare(a.Name, b.Name) })
+	return kinds
+}
+
+// LookupResourceKind returns the kind registered under name (the Go type name,
+// e.g. "Network").
+func LookupResourceKind(name string) (ResourceKind, bool) {
+	e, ok := resourceKinds[name]
+	return e.kind, ok
+}
+
+// lookupRegisteredKind returns the full registry entry for name.
+func lookupRegisteredKind(name string) (registeredKind, bool) {
+	e, ok := resourceKinds[name]
+	return e, ok
+}
@@ -0,0 +1,212 @@
+package unifi
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+// RogueAP is a neighboring access point one of the site's APs heard while
+// scanning: any BSSID that is not one of the site's own radios.
+type RogueAP struct {
+	ID     string `json:"_id,omitempty"`
+	SiteID string `json:"site_id,omitempty"`
+
+	BSSID string `json:"bssid"`
+	ESSID string `json:"essid"`
+	// OUI is the vendor registered for the BSSID's prefix.
+	OUI       string `json:"oui,omitempty"`
+	Channel   int    `json:"channel"`
+	Frequency int    `json:"freq,omitempty"`
+	// Radio is the band it was heard on: "ng" (2.4 GHz), "na" (5 GHz) or "6e".
+	Radio     string `json:"radio,omitempty"`
+	RadioName string `json:"radio_name,omitempty"`
+	// RSSI is the signal strength above the noise floor; Signal and Noise are
+	// in dBm.
+	RSSI     int    `json:"rssi"`
+	Signal   int    `json:"signal,omitempty"`
+	Noise    int    `json:"noise,omitempty"`
+	Security string `json:"security,omitempty"`
+	// APMAC is the MAC of the site's AP that reported it.
+	APMAC string `json:"ap_mac"`
+	// IsRogue is set when the controller found the AP on the site's wired
+	// network, not only on the air.
+	IsRogue  bool  `json:"is_rogue"`
+	IsAdhoc  bool  `json:"is_adhoc,omitempty"`
+	IsUbnt   bool  `json:"is_ubnt,omitempty"`
+	LastSeen int64 `json:"last_seen,omitempty"`
+}
+
+// LastSeenTime returns when the AP was last heard, or the zero time when the
+// controller did not report it.
+func (ap RogueAP) LastSeenTime() time.Time {
+	if ap.LastSeen == 0 {
+		return time.Time{}
+	}
+	return time.Unix(ap.LastSeen, 0)
+}
+
+// KnownRogueAP is a neighboring AP marked as known, e.g. a neighbor's network,
+// so it is no longer flagged.
+type KnownRogueAP struct {
+	ID     string `json:"_id,omitempty"`
+	SiteID string `json:"site_id,omitempty"`
+
+	BSSID string `json:"bssid"`
+	ESSID string `json:"essid,omitempty"`
+}
+
+// ListRogueAPs implements RogueAPs().List: it returns the neighboring APs the
+// site's APs heard in the last withinHours hours, or in the controller's default
+// window (24 hours) when withinHours <= 0.
+func (c *client) ListRogueAPs(ctx context.Context, site string, withinHours int) ([]RogueAP, error) {
+	reqBody := struct {
+		Within int `json:"within,omitempty"`
+	}{Within: max(withinHours, 0)}
+	var respBody struct {
+		Meta Meta      `json:"meta"`
+		Data []RogueAP `json:"data"`
+	}
+
+	err := c.Post(ctx, fmt.Sprintf("s/%s/stat/rogueap", site), reqBody, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return respBody.Data, nil
+}
+
+// ListKnownRogueAPs implements RogueAPs().ListKnown: it returns the
+// neighboring APs marked as known.
+func (c *client) ListKnownRogueAPs(ctx context.Context, site string) ([]KnownRogueAP, error) {
+	var respBody struct {
+		Meta Meta           `json:"meta"`
+		Data []KnownRogueAP `json:"data"`
+	}
+
+	err := c.Get(ctx, fmt.Sprintf("s/%s/rest/rogueknown", site), nil, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return respBody.Data, nil
+}
+
+// MarkRogueAPKnown implements RogueAPs().MarkKnown: it marks the neighboring
+// AP as known.
+func (c *client) MarkRogueAPKnown(ctx context.Context, site string, ap *RogueAP) (*KnownRogueAP, error) {
+	reqBody := KnownRogueAP{BSSID: strings.ToLower(ap.BSSID), ESSID: ap.ESSID}
+	var respBody struct {
+		Meta Meta           `json:"meta"`
+		Data []KnownRogueAP `json:"data"`
+	}
+
+	err := c.Post(ctx, fmt.Sprintf("s/%s/rest/rogueknown", site), reqBody, &respBody)
+	if err != nil {
+		return nil, err
+	}
+	if len(respBody.Data) != 1 {
+		return nil, ErrNotFound
+	}
+
+	return &respBody.Data[0], nil
+}
+
+// UnmarkRogueAPKnown implements RogueAPs().UnmarkKnown: it removes the known
+// AP with the given ID, so it is flagged again.
+func (c *client) UnmarkRogueAPKnown(ctx context.Context, site, id string) error {
+	return c.Delete(ctx, fmt.Sprintf("s/%s/rest/rogueknown/%s", site, id), struct{}{}, nil)
+}
+
+/*
+ListSSIDImpersonators implements RogueAPs().ListSSIDImpersonators: it returns
+the neighboring APs heard in the last withinHours hours (see RogueAPs().List)
+that broadcast one of the site's own WLAN names from a BSSID that is not one of
+the site's devices — possible evil twins. APs marked
+as known are left out, so a legitimate neighbor sharing an SSID can be silenced
+with RogueAPs().MarkKnown.
+
+The site's BSSIDs are its devices' MACs and the BSSIDs of their virtual APs;
+see FindSSIDImpersonators.
+*/
+func (c *client) ListSSIDImpersonators(ctx context.Context, site string, withinHours int) ([]RogueAP, error) {
+	wlans, err := c.ListWLAN(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+	ssids := make([]string, 0, len(wlans))
+	for _, w := range wlans {
+		ssids = append(ssids, w.Name)
+	}
+	bssids, err := c.listSiteBSSIDs(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+	own := slices.Collect(maps.Keys(bssids))
+	known, err := c.ListKnownRogueAPs(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range known {
+		own = append(own, k.BSSID)
+	}
+	rogues, err := c.ListRogueAPs(ctx, site, withinHours)
+	if err != nil {
+		return nil, err
+	}
+	return FindSSIDImpersonators(rogues, ssids, own), nil
+}
+
+// listSiteBSSIDs maps the MACs of the site's devices and the BSSIDs of their
+// virtual APs, which the Device type does not decode, to the device's MAC. All
+// are lowercase.
+func (c *client) listSiteBSSIDs(ctx context.Context, site string) (map[string]string, error) {
+	var respBody struct {
+		Meta Meta `json:"meta"`
+		Data []struct {
+			MAC      string `json:"mac"`
+			VAPTable []struct {
+				BSSID string `json:"bssid"`
+			} `json:"vap_table"`
+		} `json:"data"`
+	}
+
+	err := c.Get(ctx, fmt.Sprintf("s/%s/stat/device", site), nil, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	bssids := map[string]string{}
+	for _, d := range respBody.Data {
+		mac := strings.ToLower(d.MAC)
+		bssids[mac] = mac
+		for _, vap := range d.VAPTable {
+			bssids[strings.ToLower(vap.BSSID)] = mac
+		}
+	}
+	return bssids, nil
+}
+
+// FindSSIDImpersonators returns the rogues that broadcast one of ssids from a
+// BSSID not in own. SSIDs are compared exactly, as clients do; BSSIDs ignoring
+// case.
+func FindSSIDImpersonators(rogues []RogueAP, ssids, own []string) []RogueAP {
+	ownSet := make(map[string]struct{}, len(own))
+	for _, mac := range own {
+		ownSet[strings.ToLower(mac)] = struct{}{}
+	}
+	var out []RogueAP
+	for _, r := range rogues {
+		if r.ESSID == "" || !slices.Contains(ssids, r.ESSID) {
+			continue
+		}
+		if _, ok := ownSet[strings.ToLower(r.BSSID)]; ok {
+			continue
+		}
+		out = append(out, r)
+	}
+	return out
+}
@@ -0,0 +1,110 @@
+package unifi //nolint: testpackage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rogueAPRoutes serve a site with one AP (MAC ...:01, virtual AP ...:a1)
+// broadcasting "Office", which hears itself, an evil twin, a known neighbor
+// sharing the SSID and an unrelated network.
+func rogueAPRoutes() []route {
+	return []route{
+		{apiV1Path("s/default/stat/rogueap"), func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[
+				{"bssid":"AA:BB:CC:00:00:A1","essid":"Office","channel":36,"radio":"na","rssi":50,"ap_mac":"aa:bb:cc:00:00:01"},
+				{"bssid":"de:ad:be:ef:00:01","essid":"Office","channel":6,"radio":"ng","rssi":40,"signal":-56,"security":"open","ap_mac":"aa:bb:cc:00:00:01","last_seen":1700000000},
+				{"bssid":"12:34:56:00:00:01","essid":"Office","channel":11,"rssi":10,"ap_mac":"aa:bb:cc:00:00:01"},
+				{"bssid":"12:34:56:00:00:02","essid":"Neighbor","channel":1,"rssi":20,"ap_mac":"aa:bb:cc:00:00:01","is_rogue":true}
+			]}`))
+		}},
+		{apiV1Path("s/default/rest/rogueknown"), func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost {
+				var body KnownRogueAP
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				body.ID = "k2"
+				_ = json.NewEncoder(w).Encode(map[string]any{"meta": map[string]string{"rc": "ok"}, "data": []KnownRogueAP{body}})
+				return
+			}
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"_id":"k1","bssid":"12:34:56:00:00:01"}]}`))
+		}},
+		{apiV1Path("s/default/rest/rogueknown/k1"), func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[]}`))
+		}},
+		{apiV1Path("s/default/rest/wlanconf"), func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"_id":"w1","name":"Office"}]}`))
+		}},
+		{apiV1Path("s/default/stat/device"), func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"mac":"aa:bb:cc:00:00:01","vap_table":[{"bssid":"aa:bb:cc:00:00:a1"}]}]}`))
+		}},
+	}
+}
+
+func TestListRogueAPs(t *testing.T) {
+	t.Parallel()
+	cs := newControllerServer(t, rogueAPRoutes()...)
+	c := cs.client()
+
+	rogues, err := c.ListRogueAPs(context.Background(), "default", 12)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"within":12}`, string(cs.lastRequest().Body))
+	require.Len(t, rogues, 4)
+	assert.Equal(t, RogueAP{
+		BSSID: "de:ad:be:ef:00:01", ESSID: "Office", Channel: 6, Radio: "ng", RSSI: 40, Signal: -56,
+		Security: "open", APMAC: "aa:bb:cc:00:00:01", LastSeen: 1700000000,
+	}, rogues[1])
+	assert.Equal(t, int64(1700000000), rogues[1].LastSeenTime().Unix())
+	assert.True(t, rogues[0].LastSeenTime().IsZero())
+	assert.True(t, rogues[3].IsRogue)
+
+	_, err = c.RogueAPs().List(context.Background(), "default", 0)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{}`, string(cs.lastRequest().Body), "the controller's default window")
+}
+
+func TestKnownRogueAPs(t *testing.T) {
+	t.Parallel()
+	cs := newControllerServer(t, rogueAPRoutes()...)
+	c := cs.client()
+	ctx := context.Background()
+
+	known, err := c.ListKnownRogueAPs(ctx, "default")
+	require.NoError(t, err)
+	assert.Equal(t, []KnownRogueAP{{ID: "k1", BSSID: "12:34:56:00:00:01"}}, known)
+
+	marked, err := c.MarkRogueAPKnown(ctx, "default", &RogueAP{BSSID: "12:34:56:00:00:02", ESSID: "Neighbor"})
+	require.NoError(t, err)
+	assert.Equal(t, "k2", marked.ID)
+	assert.JSONEq(t, `{"bssid":"12:34:56:00:00:02","essid":"Neighbor"}`, string(cs.lastRequest().Body))
+
+	require.NoError(t, c.UnmarkRogueAPKnown(ctx, "default", "k1"))
+	assert.Equal(t, http.MethodDelete, cs.lastRequest().Method)
+}
+
+func TestListSSIDImpersonators(t *testing.T) {
+	t.Parallel()
+	cs := newControllerServer(t, rogueAPRoutes()...)
+
+	twins, err := cs.client().ListSSIDImpersonators(context.Background(), "default", 24)
+	require.NoError(t, err)
+	require.Len(t, twins, 1, "own and known BSSIDs and other SSIDs are left out")
+	assert.Equal(t, "de:ad:be:ef:00:01", twins[0].BSSID)
+}
+
+func TestFindSSIDImpersonators(t *testing.T) {
+	t.Parallel()
+	rogues := []RogueAP{
+		{BSSID: "00:00:00:00:00:01", ESSID: "office"},
+		{BSSID: "00:00:00:00:00:02", ESSID: ""},
+		{BSSID: "00:00:00:00:00:03", ESSID: "Office"},
+		{BSSID: "00:00:00:00:00:04", ESSID: "Office"},
+	}
+	found := FindSSIDImpersonators(rogues, []string{"Office", ""}, []string{"00:00:00:00:00:04"})
+	require.Len(t, found, 1, "SSIDs are case-sensitive and hidden networks never match")
+	assert.Equal(t, "00:00:00:00:00:03", found[0].BSSID)
+}
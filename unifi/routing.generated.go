@@ -16,6 +16,26 @@ var (
 	_ json.Marshaler
 )
 
+// Self-register this resource's kind so the resourceKinds registry in
+// resource_registry.go mirrors the generated Client surface and can never
+// drift from it by hand.
+func init() { //nolint:gochecknoinits
+	registerResourceKind(ResourceKind{
+		Name:       "Routing",
+		Endpoint:   "routing",
+		APIVersion: APIVersionV1,
+		IDField:    "_id",
+		NameField:  "name",
+		SiteScoped: true,
+	}, resourceOps[Routing]{
+		list:   InternalClient.ListRouting,
+		get:    InternalClient.GetRouting,
+		create: InternalClient.CreateRouting,
+		update: InternalClient.UpdateRouting,
+		delete: InternalClient.DeleteRouting,
+	})
+}
+
 type Routing struct {
 	ID     string `json:"_id,omitempty"`
 	SiteID string `json:"site_id,omitempty"`
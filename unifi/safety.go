@@ -0,0 +1,213 @@
+package unifi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrReadOnly is matched by the *ReadOnlyError a client configured with
+// ClientConfig.ReadOnly returns for any request that could change the
+// controller.
+var ErrReadOnly = errors.New("client is read-only")
+
+// ReadOnlyError is returned, without contacting the controller, for a request
+// a read-only client refused to send. It matches ErrReadOnly.
+type ReadOnlyError struct {
+	// Method and Path are the request method and path (with query).
+	Method string
+	Path   string
+	// Operation describes the refused request, as for tracing.
+	Operation Operation
+}
+
+func (e *ReadOnlyError) Error() string {
+	return fmt.Sprintf("read-only client refused %s %s (%s.%s)", e.Method, e.Path, e.Operation.Resource, e.Operation.Operation)
+}
+
+// Is reports whether target is ErrReadOnly.
+func (e *ReadOnlyError) Is(target error) bool {
+	return target == ErrReadOnly
+}
+
+// isReadRequest reports whether a request cannot change the controller: a GET
+// (or HEAD, OPTIONS), a v1 POST to a stat/, list/ or get/ endpoint, which the
+// controller uses to filter a read (e.g. stat/device with a macs filter), a
+// POST to a v2 query endpoint such as traffic-flows, or one of the readCommands
+// with body. Everything else, other cmd/ commands included, is a mutation.
+func isReadRequest(method, urlPath string, body []byte) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	case http.MethodPost:
+		op := describeOperation(method, urlPath)
+		if op.Surface != "internal" {
+			return false
+		}
+		if op.Operation == "Command" {
+			return isReadCommand(op.Resource, body)
+		}
+		return op.Operation == string(OperationList) || op.Operation == string(OperationGet)
+	}
+	return false
+}
+
+// readCommands are the v1 commands, by command manager, that only report
+// state.
+var readCommands = map[string][]string{
+	"devmgr": {"speedtest-status"},
+}
+
+// isReadCommand reports whether body is one of the readCommands of manager.
+func isReadCommand(manager string, body []byte) bool {
+	cmds, ok := readCommands[manager]
+	if !ok {
+		return false
+	}
+	var req struct {
+		Cmd string `json:"cmd"`
+	}
+	if json.Unmarshal(body, &req) != nil {
+		return false
+	}
+	return slices.Contains(cmds, req.Cmd)
+}
+
+// classifyRequest reports whether a request is a read, as isReadRequest. The
+// body of a command is buffered to tell read commands apart, and returned as a
+// new reader to send.
+func classifyRequest(method, urlPath string, body io.Reader) (bool, io.Reader, error) {
+	if body == nil || method != http.MethodPost || describeOperation(method, urlPath).Operation != "Command" {
+		return isReadRequest(method, urlPath, nil), body, nil
+	}
+	raw, err := io.ReadAll(body)
+	if err != nil {
+		return false, nil, fmt.Errorf("unable to read request body: %w", err)
+	}
+	return isReadRequest(method, urlPath, raw), bytes.NewReader(raw), nil
+}
+
+/*
+DryRunJournal records the mutating requests of a client configured with
+ClientConfig.DryRun instead of sending them. Reads are still sent, so code that
+looks objects up before changing them runs unchanged; every create, update,
+delete and command, on the Internal and Official API alike, is recorded with
+its body as it would have been sent (after validation) and reported as
+successful.
+
+A dry-run call decodes its own request body as the response, as the controller
+echoes the object it created or updated, so callers see the object they sent —
+without the IDs and defaults the controller would have filled in. Code that
+depends on those (e.g. creating an object and then referencing its ID) sees
+them empty.
+
+The zero value is ready to use; a DryRunJournal is safe for concurrent use.
+*/
+type DryRunJournal struct {
+	mu       sync.Mutex
+	requests []DryRunRequest
+}
+
+// DryRunRequest is one request a dry-run client did not send.
+type DryRunRequest struct {
+	// Time is when the request was recorded.
+	Time time.Time
+	// Method is the HTTP method, e.g. POST.
+	Method string
+	// Path is the request path, with the query, as it would have been sent.
+	Path string
+	// Operation describes the request, as for tracing.
+	Operation Operation
+	// ContentType is the Content-Type of Body, e.g. application/json.
+	ContentType string
+	// Body is the request body as it would have been sent; nil for a request
+	// without one.
+	Body []byte
+}
+
+// Requests returns the recorded requests, oldest first.
+func (j *DryRunJournal) Requests() []DryRunRequest {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return slices.Clone(j.requests)
+}
+
+// Reset drops the recorded requests.
+func (j *DryRunJournal) Reset() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.requests = nil
+}
+
+func (j *DryRunJournal) record(r DryRunRequest) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.requests = append(j.requests, r)
+}
+
+// guardMutation enforces ClientConfig.ReadOnly and ClientConfig.DryRun for a
+// request that is not a read. It reports whether the request was handled (and
+// must not be sent), with its outcome.
+func (c *client) guardMutation(method string, reqURL *url.URL, body io.Reader, headers http.Header, respBody any) (bool, error) {
+	if !c.readOnly && c.dryRun == nil {
+		return false, nil
+	}
+	op := describeOperation(method, reqURL.Path)
+	if c.readOnly {
+		return true, &ReadOnlyError{Method: method, Path: reqURL.RequestURI(), Operation: op}
+	}
+	var raw []byte
+	if body != nil {
+		var err error
+		if raw, err = io.ReadAll(body); err != nil {
+			return true, fmt.Errorf("unable to read request body: %w", err)
+		}
+	}
+	c.log.Debugf("Dry run, not sending request: %s %s", method, reqURL.String())
+	c.dryRun.record(DryRunRequest{
+		Time:        time.Now(),
+		Method:      method,
+		Path:        reqURL.RequestURI(),
+		Operation:   op,
+		ContentType: headers.Get("Content-Type"),
+		Body:        raw,
+	})
+	if strings.HasPrefix(headers.Get("Content-Type"), "application/json") {
+		echoRequestBody(raw, respBody)
+	}
+	return true, nil
+}
+
+// echoRequestBody decodes a dry-run request body into respBody, as the
+// controller's response to a create or update: wrapped in the data array of a
+// v1 envelope when respBody has one, as is otherwise. It is best effort; a
+// body that does not fit respBody leaves it as decoded so far.
+func echoRequestBody(body []byte, respBody any) {
+	if len(body) == 0 || respBody == nil {
+		return
+	}
+	if hasDataArray(respBody) {
+		body = slices.Concat([]byte(`{"data":[`), body, []byte(`]}`))
+	}
+	_ = json.Unmarshal(body, respBody)
+}
+
+// hasDataArray reports whether respBody points to a struct with a "data"
+// slice field, the shape of a v1 {meta, data} response.
+func hasDataArray(respBody any) bool {
+	t := reflect.TypeOf(respBody)
+	if t.Kind() != reflect.Pointer || t.Elem().Kind() != reflect.Struct {
+		return false
+	}
+	f, ok := structFieldNames(t.Elem()).byWire["data"]
+	return ok && f.typ.Kind() == reflect.Slice
+}
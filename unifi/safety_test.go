@@ -0,0 +1,158 @@
+package unifi //nolint: testpackage
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// safetyServer accepts every request and answers with an empty v1 envelope.
+func safetyServer(t *testing.T) *controllerServer {
+	t.Helper()
+	return newControllerServer(t, route{"/", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[]}`))
+	}})
+}
+
+func TestReadOnly(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		call    func(ctx context.Context, c *client) error
+		wantErr bool
+	}{
+		"list": {call: func(ctx context.Context, c *client) error {
+			_, err := c.ListUserGroup(ctx, "default")
+			return err
+		}},
+		"POST read": {call: func(ctx context.Context, c *client) error {
+			_, err := c.listDeviceByMAC(ctx, "default", "aa:bb:cc:dd:ee:01")
+			return err
+		}},
+		"create": {wantErr: true, call: func(ctx context.Context, c *client) error {
+			_, err := c.CreateUserGroup(ctx, "default", &UserGroup{Name: "g"})
+			return err
+		}},
+		"delete": {wantErr: true, call: func(ctx context.Context, c *client) error {
+			return c.DeleteUserGroup(ctx, "default", "1")
+		}},
+		"command": {wantErr: true, call: func(ctx context.Context, c *client) error {
+			return c.AdoptDevice(ctx, "default", "aa:bb:cc:dd:ee:01")
+		}},
+		"read command": {call: func(ctx context.Context, c *client) error {
+			_, err := c.GetSpeedTestStatus(ctx, "default")
+			if errors.Is(err, ErrNotFound) {
+				return nil
+			}
+			return err
+		}},
+		"command named like a read command": {wantErr: true, call: func(ctx context.Context, c *client) error {
+			return c.Post(ctx, "s/default/cmd/stamgr", map[string]string{"cmd": "speedtest-status"}, nil)
+		}},
+		"setting": {wantErr: true, call: func(ctx context.Context, c *client) error {
+			_, err := c.UpdateSettingMgmt(ctx, "default", &SettingMgmt{})
+			return err
+		}},
+		"v2 create": {wantErr: true, call: func(ctx context.Context, c *client) error {
+			_, err := c.CreateDNSRecord(ctx, "default", &DNSRecord{Key: "a.lan", RecordType: "A"})
+			return err
+		}},
+		"official API": {wantErr: true, call: func(ctx context.Context, c *client) error {
+			return c.Post(ctx, integrationV1Path+"/sites/s1/devices", struct{}{}, nil)
+		}},
+		"upload": {wantErr: true, call: func(ctx context.Context, c *client) error {
+			return c.UploadFileFromReader(ctx, "upload/backup", strings.NewReader("x"), "b.unf", "", nil)
+		}},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			cs := safetyServer(t)
+			journal := &DryRunJournal{}
+			c := cs.clientWith(func(cfg *ClientConfig) {
+				cfg.ReadOnly = true
+				cfg.DryRun = journal
+			})
+
+			err := tt.call(context.Background(), c)
+			if !tt.wantErr {
+				require.NoError(t, err)
+				assert.Equal(t, 1, cs.requestCount())
+				return
+			}
+			require.ErrorIs(t, err, ErrReadOnly)
+			var roErr *ReadOnlyError
+			require.ErrorAs(t, err, &roErr)
+			assert.NotEqual(t, http.MethodGet, roErr.Method)
+			assert.NotEmpty(t, roErr.Path)
+			assert.Zero(t, cs.requestCount(), "nothing reaches the controller")
+			assert.Empty(t, journal.Requests(), "read-only takes precedence over dry run")
+		})
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	t.Parallel()
+	cs := safetyServer(t)
+	journal := &DryRunJournal{}
+	c := cs.clientWith(func(cfg *ClientConfig) { cfg.DryRun = journal })
+	ctx := context.Background()
+
+	_, err := c.ListUserGroup(ctx, "default")
+	require.NoError(t, err)
+
+	group, err := c.CreateUserGroup(ctx, "default", &UserGroup{Name: "guests", QOSRateMaxDown: 1000})
+	require.NoError(t, err)
+	assert.Equal(t, UserGroup{Name: "guests", QOSRateMaxDown: 1000}, *group, "the v1 response echoes the body")
+
+	record, err := c.CreateDNSRecord(ctx, "default", &DNSRecord{Key: "nas.lan", RecordType: "A", Value: "10.0.0.2"})
+	require.NoError(t, err)
+	assert.Equal(t, "nas.lan", record.Key, "the v2 response echoes the body")
+
+	require.NoError(t, c.AdoptDevice(ctx, "default", "aa:bb:cc:dd:ee:01"))
+	require.NoError(t, c.DeleteUserGroup(ctx, "default", "g1"))
+	require.NoError(t, c.UploadFileFromReader(ctx, "upload/backup", strings.NewReader("x"), "b.unf", "", nil))
+
+	assert.Equal(t, 1, cs.requestCount(), "only the read is sent")
+	requests := journal.Requests()
+	require.Len(t, requests, 5)
+
+	assert.Equal(t, http.MethodPost, requests[0].Method)
+	assert.Equal(t, apiV1Path("s/default/rest/usergroup"), requests[0].Path)
+	assert.Equal(t, Operation{Surface: "internal", Resource: "UserGroup", Operation: "Create", Site: "default"}, requests[0].Operation)
+	assert.Equal(t, "application/json", requests[0].ContentType)
+	assert.JSONEq(t, `{"name":"guests","qos_rate_max_down":1000}`, string(requests[0].Body))
+
+	assert.Equal(t, apiV2("site/default/static-dns"), requests[1].Path)
+	assert.Equal(t, "Command", requests[2].Operation.Operation)
+	assert.JSONEq(t, `{"cmd":"adopt","mac":"aa:bb:cc:dd:ee:01"}`, string(requests[2].Body))
+	assert.Equal(t, http.MethodDelete, requests[3].Method)
+	assert.Equal(t, apiV1Path("s/default/rest/usergroup/g1"), requests[3].Path)
+	assert.True(t, strings.HasPrefix(requests[4].ContentType, "multipart/form-data"))
+	assert.Contains(t, string(requests[4].Body), `filename="b.unf"`)
+	for _, r := range requests {
+		assert.False(t, r.Time.IsZero())
+	}
+
+	journal.Reset()
+	assert.Empty(t, journal.Requests())
+}
+
+func TestDryRunRecordsValidatedBody(t *testing.T) {
+	t.Parallel()
+	cs := safetyServer(t)
+	journal := &DryRunJournal{}
+	c := cs.clientWith(func(cfg *ClientConfig) {
+		cfg.DryRun = journal
+		cfg.ValidationMode = HardValidation
+	})
+
+	_, err := c.CreateDNSRecord(context.Background(), "default", &DNSRecord{RecordType: "BOGUS"})
+	require.ErrorIs(t, err, ErrValidationFailed)
+	assert.Empty(t, journal.Requests(), "an invalid request is not recorded")
+	assert.Zero(t, cs.requestCount())
+}
@@ -1,5 +1,10 @@
 package unifi
 
+import (
+	"maps"
+	"slices"
+)
+
 // settingFactories maps a setting key to a constructor for its concrete fields
 // type. It is populated exclusively by registerSetting calls emitted from the
 // generated setting_*.generated.go files (one init() per setting), so the
@@ -22,3 +27,8 @@ func registerSetting(key string, f func() any) {
 	}
 	settingFactories[key] = f
 }
+
+// SettingKeys returns the key of every generated setting (e.g. "mgmt"), sorted.
+func SettingKeys() []string {
+	return slices.Sorted(maps.Keys(settingFactories))
+}
@@ -3,6 +3,7 @@ package unifi //nolint: testpackage
 import (
 	"fmt"
 	"reflect"
+	"slices"
 	"testing"
 )
 
@@ -35,6 +36,25 @@ func TestSettingFactoriesSelfRegistered(t *testing.T) {
 	}
 }
 
+// TestSettingKeysMatchesRegistry pins SettingKeys to the registry: every
+// registered key, once, in sorted order.
+func TestSettingKeysMatchesRegistry(t *testing.T) {
+	t.Parallel()
+
+	keys := SettingKeys()
+	if len(keys) != len(settingFactories) {
+		t.Fatalf("SettingKeys returned %d keys, registry has %d", len(keys), len(settingFactories))
+	}
+	if !slices.IsSorted(keys) {
+		t.Fatalf("SettingKeys is not sorted: %v", keys)
+	}
+	for _, key := range keys {
+		if _, ok := settingFactories[key]; !ok {
+			t.Fatalf("SettingKeys returned unregistered key %q", key)
+		}
+	}
+}
+
 // TestSettingDriftSentinelKeysRegistered locks in the exact regression the W0
 // patch fixed. If any of the three previously-dropped keys is missing from the
 // registry, this fails the BUILD — the codegen self-registration must keep them
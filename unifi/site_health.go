@@ -0,0 +1,218 @@
+package unifi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// Subsystems reported by Sites().GetHealth.
+const (
+	SubsystemWAN  = "wan"
+	SubsystemLAN  = "lan"
+	SubsystemWLAN = "wlan"
+	SubsystemWWW  = "www"
+	SubsystemVPN  = "vpn"
+)
+
+// HealthStatus is the status of a subsystem, as the controller colors it on
+// its dashboard.
+type HealthStatus string
+
+const (
+	HealthOK      HealthStatus = "ok"
+	HealthWarning HealthStatus = "warning"
+	HealthError   HealthStatus = "error"
+	// HealthUnknown is reported for a subsystem the site does not use, e.g.
+	// wan without a gateway or vpn without a VPN.
+	HealthUnknown HealthStatus = "unknown"
+)
+
+// healthSeverity orders statuses for SiteHealth.Status; an unrecognized status
+// ranks as a warning.
+var healthSeverity = map[HealthStatus]int{
+	HealthUnknown: 0,
+	HealthOK:      1,
+	HealthWarning: 2,
+	HealthError:   3,
+}
+
+// SiteHealth is the health of a site's subsystems, from stat/health.
+type SiteHealth struct {
+	// Site is the site name the health was fetched for, e.g. "default".
+	Site       string
+	Subsystems []SubsystemHealth
+}
+
+// Subsystem returns the health of the named subsystem (SubsystemWAN, ...).
+func (h *SiteHealth) Subsystem(name string) (*SubsystemHealth, bool) {
+	for i := range h.Subsystems {
+		if h.Subsystems[i].Subsystem == name {
+			return &h.Subsystems[i], true
+		}
+	}
+	return nil, false
+}
+
+// Status returns the worst status of the site's subsystems: HealthError if any
+// is in error, else HealthWarning, else HealthOK. Subsystems the site does not
+// use (HealthUnknown) are ignored; a site without any other reports
+// HealthUnknown.
+func (h *SiteHealth) Status() HealthStatus {
+	worst := HealthUnknown
+	for _, s := range h.Subsystems {
+		status := s.Status
+		if _, ok := healthSeverity[status]; !ok {
+			status = HealthWarning
+		}
+		if healthSeverity[status] > healthSeverity[worst] {
+			worst = status
+		}
+	}
+	return worst
+}
+
+// SubsystemHealth is the health of one subsystem of a site. Which fields are
+// set depends on the subsystem: device counts for wan, lan and wlan, client
+// counts and throughput for lan, wlan and vpn, ISP and gateway details for
+// wan, internet latency and speed test results for www.
+type SubsystemHealth struct {
+	Subsystem string       `json:"subsystem"`
+	Status    HealthStatus `json:"status"`
+
+	NumAdopted      int `json:"num_adopted"`
+	NumDisconnected int `json:"num_disconnected"`
+	NumPending      int `json:"num_pending"`
+	NumDisabled     int `json:"num_disabled"`
+	NumAP           int `json:"num_ap"`
+	NumSW           int `json:"num_sw"`
+	NumGW           int `json:"num_gw"`
+	NumUser         int `json:"num_user"`
+	NumGuest        int `json:"num_guest"`
+	NumIot          int `json:"num_iot"`
+
+	// TxBytesRate and RxBytesRate are the current throughput in bytes per
+	// second.
+	TxBytesRate float64 `json:"tx_bytes-r"`
+	RxBytesRate float64 `json:"rx_bytes-r"`
+
+	WANIP           string   `json:"wan_ip"`
+	ISPName         string   `json:"isp_name"`
+	ISPOrganization string   `json:"isp_organization"`
+	Nameservers     []string `json:"nameservers"`
+	GatewayMAC      string   `json:"gw_mac"`
+	GatewayName     string   `json:"gw_name"`
+	GatewayVersion  string   `json:"gw_version"`
+	// GatewaySystemStats holds the gateway's CPU and memory use, in percent,
+	// and uptime, in seconds.
+	GatewaySystemStats *GatewaySystemStats `json:"gw_system-stats,omitempty"`
+
+	// Latency is the internet latency in milliseconds, Uptime the time the
+	// internet connection has been up in seconds.
+	Latency float64 `json:"latency"`
+	Uptime  int64   `json:"uptime"`
+	Drops   int     `json:"drops"`
+	// XputUp and XputDown are the last speed test results in Mbps.
+	XputUp          float64 `json:"xput_up"`
+	XputDown        float64 `json:"xput_down"`
+	SpeedtestStatus string  `json:"speedtest_status"`
+	SpeedtestPing   float64 `json:"speedtest_ping"`
+	// SpeedtestLastRun is when the last speed test ran, in seconds since the
+	// epoch.
+	SpeedtestLastRun int64 `json:"speedtest_lastrun"`
+
+	RemoteUserEnabled   bool `json:"remote_user_enabled"`
+	RemoteUserNumActive int  `json:"remote_user_num_active"`
+	SiteToSiteEnabled   bool `json:"site_to_site_enabled"`
+}
+
+// GatewaySystemStats are the gateway's system stats of the wan subsystem, as
+// the controller reports them: decimal strings (or numbers, on some versions).
+type GatewaySystemStats struct {
+	CPU    string `json:"cpu"`
+	Mem    string `json:"mem"`
+	Uptime string `json:"uptime"`
+}
+
+func (dst *GatewaySystemStats) UnmarshalJSON(b []byte) error {
+	var aux struct {
+		CPU    numberOrString `json:"cpu"`
+		Mem    numberOrString `json:"mem"`
+		Uptime numberOrString `json:"uptime"`
+	}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return fmt.Errorf("unable to unmarshal gateway system stats: %w", err)
+	}
+	dst.CPU = string(aux.CPU)
+	dst.Mem = string(aux.Mem)
+	dst.Uptime = string(aux.Uptime)
+	return nil
+}
+
+// GetSiteHealth implements Sites().GetHealth: it returns the health of the
+// site's subsystems.
+func (c *client) GetSiteHealth(ctx context.Context, site string) (*SiteHealth, error) {
+	var respBody struct {
+		Meta Meta              `json:"meta"`
+		Data []SubsystemHealth `json:"data"`
+	}
+
+	err := c.Get(ctx, fmt.Sprintf("s/%s/stat/health", site), nil, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SiteHealth{Site: site, Subsystems: respBody.Data}, nil
+}
+
+// defaultSiteHealthConcurrency bounds Sites().GetAllHealth when it is given no
+// concurrency.
+const defaultSiteHealthConcurrency = 4
+
+// SiteHealthResult is the health of one site, or why it could not be fetched.
+type SiteHealthResult struct {
+	Site   Site
+	Health *SiteHealth
+	Err    error
+}
+
+/*
+GetAllSitesHealth implements Sites().GetAllHealth: it returns the health of
+every site the client can see, fetching at most concurrency sites at once (4
+when concurrency <= 0). Results are in the
+order of ListSites.
+
+A site whose health cannot be fetched carries the error in its result rather
+than failing the call, so one unreachable site does not hide the others; the
+returned error is only that of listing the sites.
+*/
+func (c *client) GetAllSitesHealth(ctx context.Context, concurrency int) ([]SiteHealthResult, error) {
+	sites, err := c.ListSites(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if concurrency <= 0 {
+		concurrency = defaultSiteHealthConcurrency
+	}
+
+	results := make([]SiteHealthResult, len(sites))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, site := range sites {
+		results[i].Site = site
+		wg.Go(func() {
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				results[i].Err = ctx.Err()
+				return
+			}
+			results[i].Health, results[i].Err = c.GetSiteHealth(ctx, site.Name)
+		})
+	}
+	wg.Wait()
+
+	return results, nil
+}
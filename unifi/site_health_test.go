@@ -0,0 +1,137 @@
+package unifi //nolint: testpackage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const siteHealthBody = `{"meta":{"rc":"ok"},"data":[
+	{"subsystem":"wlan","status":"ok","num_adopted":3,"num_disconnected":1,"num_user":12,"tx_bytes-r":1500.5},
+	{"subsystem":"wan","status":"warning","wan_ip":"203.0.113.7","isp_name":"Example ISP","gw_mac":"aa:bb:cc:dd:ee:ff",
+	 "gw_system-stats":{"cpu":"4.2","mem":"51","uptime":86400}},
+	{"subsystem":"www","status":"ok","latency":12,"uptime":3600,"xput_down":940.1},
+	{"subsystem":"vpn","status":"unknown"}
+]}`
+
+func TestGetSiteHealth(t *testing.T) {
+	t.Parallel()
+	cs := newControllerServer(t, route{apiV1Path("s/default/stat/health"), func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(siteHealthBody))
+	}})
+
+	health, err := cs.client().GetSiteHealth(context.Background(), "default")
+	require.NoError(t, err)
+	assert.Equal(t, "default", health.Site)
+	require.Len(t, health.Subsystems, 4)
+	assert.Equal(t, HealthWarning, health.Status())
+
+	wlan, ok := health.Subsystem(SubsystemWLAN)
+	require.True(t, ok)
+	assert.Equal(t, 3, wlan.NumAdopted)
+	assert.Equal(t, 1, wlan.NumDisconnected)
+	assert.InDelta(t, 1500.5, wlan.TxBytesRate, 0)
+
+	wan, ok := health.Subsystem(SubsystemWAN)
+	require.True(t, ok)
+	assert.Equal(t, "Example ISP", wan.ISPName)
+	require.NotNil(t, wan.GatewaySystemStats)
+	assert.Equal(t, GatewaySystemStats{CPU: "4.2", Mem: "51", Uptime: "86400"}, *wan.GatewaySystemStats)
+
+	www, ok := health.Subsystem(SubsystemWWW)
+	require.True(t, ok)
+	assert.InDelta(t, 12, www.Latency, 0)
+
+	_, ok = health.Subsystem(SubsystemLAN)
+	assert.False(t, ok)
+}
+
+func TestSiteHealthStatus(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		statuses []HealthStatus
+		want     HealthStatus
+	}{
+		"all ok":          {statuses: []HealthStatus{HealthOK, HealthOK}, want: HealthOK},
+		"unknown ignored": {statuses: []HealthStatus{HealthOK, HealthUnknown}, want: HealthOK},
+		"error wins":      {statuses: []HealthStatus{HealthWarning, HealthError, HealthOK}, want: HealthError},
+		"unrecognized":    {statuses: []HealthStatus{HealthOK, "degraded"}, want: HealthWarning},
+		"nothing used":    {statuses: []HealthStatus{HealthUnknown}, want: HealthUnknown},
+		"no subsystems":   {want: HealthUnknown},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			h := SiteHealth{}
+			for _, s := range tt.statuses {
+				h.Subsystems = append(h.Subsystems, SubsystemHealth{Status: s})
+			}
+			assert.Equal(t, tt.want, h.Status())
+		})
+	}
+}
+
+func TestGetAllSitesHealth(t *testing.T) {
+	t.Parallel()
+	const numSites = 10
+	var inFlight, maxInFlight atomic.Int32
+	cs := newControllerServer(t,
+		route{apiV1Path("self/sites"), func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"meta":{"rc":"ok"},"data":[`)
+			for i := range numSites {
+				if i > 0 {
+					fmt.Fprint(w, ",")
+				}
+				fmt.Fprintf(w, `{"_id":"id%d","name":"site%d"}`, i, i)
+			}
+			fmt.Fprint(w, `]}`)
+		}},
+		route{apiV1Path("s/{site}/stat/health"), func(w http.ResponseWriter, r *http.Request) {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				highest := maxInFlight.Load()
+				if n <= highest || maxInFlight.CompareAndSwap(highest, n) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			if r.PathValue("site") == "site3" {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			_, _ = w.Write([]byte(siteHealthBody))
+		}},
+	)
+
+	results, err := cs.client().GetAllSitesHealth(context.Background(), 3)
+	require.NoError(t, err)
+	require.Len(t, results, numSites)
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(3))
+	for i, r := range results {
+		assert.Equal(t, fmt.Sprintf("site%d", i), r.Site.Name, "results keep the order of ListSites")
+		if i == 3 {
+			require.Error(t, r.Err, "a failing site carries its error")
+			assert.Nil(t, r.Health)
+			continue
+		}
+		require.NoError(t, r.Err)
+		assert.Equal(t, r.Site.Name, r.Health.Site)
+	}
+}
+
+func TestGetAllSitesHealthListError(t *testing.T) {
+	t.Parallel()
+	cs := newControllerServer(t, route{apiV1Path("self/sites"), func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}})
+
+	_, err := cs.client().GetAllSitesHealth(context.Background(), 0)
+	require.ErrorIs(t, err, ErrForbidden)
+}
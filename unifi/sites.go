@@ -19,6 +19,27 @@ type Site struct {
 	// Role string `json:"role"`
 }
 
+// Register the Site kind by hand: sites are not a generated resource and are
+// not site-scoped, so only List and Get map onto the generic CRUD shape (the
+// site argument is ignored).
+func init() { //nolint:gochecknoinits
+	registerResourceKind(ResourceKind{
+		Name:       "Site",
+		Endpoint:   "self/sites",
+		APIVersion: APIVersionV1,
+		IDField:    "_id",
+		NameField:  "name",
+		SiteScoped: false,
+	}, resourceOps[Site]{
+		list: func(c InternalClient, ctx context.Context, _ string) ([]Site, error) {
+			return c.ListSites(ctx)
+		},
+		get: func(c InternalClient, ctx context.Context, _, id string) (*Site, error) {
+			return c.GetSite(ctx, id)
+		},
+	})
+}
+
 func (c *client) ListSites(ctx context.Context) ([]Site, error) {
 	var respBody struct {
 		Meta Meta   `json:"Meta"`
@@ -33,6 +54,8 @@ func (c *client) ListSites(ctx context.Context) ([]Site, error) {
 	return respBody.Data, nil
 }
 
+// GetSite filters ListSites: the controller has no single-site endpoint, and
+// the sites list is short.
 func (c *client) GetSite(ctx context.Context, id string) (*Site, error) {
 	sites, err := c.ListSites(ctx)
 	if err != nil {
@@ -16,6 +16,26 @@ var (
 	_ json.Marshaler
 )
 
+// Self-register this resource's kind so the resourceKinds registry in
+// resource_registry.go mirrors the generated Client surface and can never
+// drift from it by hand.
+func init() { //nolint:gochecknoinits
+	registerResourceKind(ResourceKind{
+		Name:       "SpatialRecord",
+		Endpoint:   "spatialrecord",
+		APIVersion: APIVersionV1,
+		IDField:    "_id",
+		NameField:  "name",
+		SiteScoped: true,
+	}, resourceOps[SpatialRecord]{
+		list:   InternalClient.ListSpatialRecord,
+		get:    InternalClient.GetSpatialRecord,
+		create: InternalClient.CreateSpatialRecord,
+		update: InternalClient.UpdateSpatialRecord,
+		delete: InternalClient.DeleteSpatialRecord,
+	})
+}
+
 type SpatialRecord struct {
 	ID     string `json:"_id,omitempty"`
 	SiteID string `json:"site_id,omitempty"`
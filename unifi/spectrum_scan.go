@@ -0,0 +1,172 @@
+package unifi
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// defaultSpectrumScanPollInterval is how often SpectrumScans().Wait polls when
+// it is given no interval.
+const defaultSpectrumScanPollInterval = 5 * time.Second
+
+// spectrumScanStartPolls is how many polls SpectrumScans().Wait waits for a
+// scan to show as running before it takes the scan as already finished. The AP
+// only reports a scan some seconds after the start command.
+const spectrumScanStartPolls = 6
+
+// SpectrumChannel is the RF environment of one channel, as an AP's spectrum
+// scan measured it.
+type SpectrumChannel struct {
+	// Radio is the band: "ng" (2.4 GHz), "na" (5 GHz) or "6e", as in
+	// DeviceRadioTable.Radio.
+	Radio     string `json:"radio"`
+	Channel   int    `json:"channel"`
+	Frequency int    `json:"freq,omitempty"`
+	// Width is the channel width in MHz, as in DeviceRadioTable.Ht.
+	Width int  `json:"width,omitempty"`
+	DFS   bool `json:"dfs,omitempty"`
+	// Utilization is the percentage of airtime the channel was busy with
+	// Wi-Fi traffic, Interference the percentage lost to non-Wi-Fi energy.
+	Utilization  float64 `json:"utilization"`
+	Interference float64 `json:"interference"`
+	// InterferenceType names the kind of non-Wi-Fi energy, when detected.
+	InterferenceType string `json:"interference_type,omitempty"`
+}
+
+// Busy returns the percentage of airtime unavailable on the channel: its
+// utilization and interference, at most 100.
+func (ch SpectrumChannel) Busy() float64 {
+	return min(ch.Utilization+ch.Interference, 100)
+}
+
+// SpectrumScanResult is the state and last result of an AP's spectrum scan.
+type SpectrumScanResult struct {
+	MAC string `json:"mac"`
+	// Scanning is set while a scan is running; Channels then holds the
+	// previous scan's results, if any.
+	Scanning bool              `json:"spectrum_scanning"`
+	Channels []SpectrumChannel `json:"spectrum_table"`
+}
+
+// Radios returns the bands the scan covered, in the order first measured.
+func (r *SpectrumScanResult) Radios() []string {
+	var radios []string
+	for _, ch := range r.Channels {
+		if !slices.Contains(radios, ch.Radio) {
+			radios = append(radios, ch.Radio)
+		}
+	}
+	return radios
+}
+
+// Radio returns the scanned channels of one band.
+func (r *SpectrumScanResult) Radio(radio string) []SpectrumChannel {
+	var channels []SpectrumChannel
+	for _, ch := range r.Channels {
+		if ch.Radio == radio {
+			channels = append(channels, ch)
+		}
+	}
+	return channels
+}
+
+// CleanestChannels returns the n channels of the band with the least busy
+// airtime, or all of them when n <= 0. Ties go to the channel with less
+// interference, which no channel plan can move away, then to the lower
+// channel.
+func (r *SpectrumScanResult) CleanestChannels(radio string, n int) []SpectrumChannel {
+	channels := r.Radio(radio)
+	slices.SortFunc(channels, func(a, b SpectrumChannel) int {
+		return cmp.Or(
+			cmp.Compare(a.Busy(), b.Busy()),
+			cmp.Compare(a.Interference, b.Interference),
+			cmp.Compare(a.Channel, b.Channel))
+	})
+	if n > 0 && len(channels) > n {
+		channels = channels[:n]
+	}
+	return channels
+}
+
+/*
+StartSpectrumScan implements SpectrumScans().Start: it starts a spectrum scan on
+the AP with the given MAC. The scan takes a few minutes, during which the AP's
+radios stop serving clients; follow it with SpectrumScans().Get or
+SpectrumScans().Wait.
+*/
+func (c *client) StartSpectrumScan(ctx context.Context, site, apMAC string) error {
+	reqBody := struct {
+		Cmd string `json:"cmd"`
+		MAC string `json:"mac"`
+	}{
+		Cmd: "spectrum-scan",
+		MAC: strings.ToLower(apMAC),
+	}
+
+	var respBody struct {
+		Meta Meta `json:"meta"`
+	}
+
+	return c.Post(ctx, fmt.Sprintf("s/%s/cmd/devmgr", site), reqBody, &respBody)
+}
+
+// GetSpectrumScan implements SpectrumScans().Get: it returns the state and last
+// result of the AP's spectrum scan.
+func (c *client) GetSpectrumScan(ctx context.Context, site, apMAC string) (*SpectrumScanResult, error) {
+	var respBody struct {
+		Meta Meta                 `json:"meta"`
+		Data []SpectrumScanResult `json:"data"`
+	}
+
+	err := c.Get(ctx, fmt.Sprintf("s/%s/stat/spectrum-scan/%s", site, strings.ToLower(apMAC)), nil, &respBody)
+	if err != nil {
+		return nil, err
+	}
+	if len(respBody.Data) != 1 {
+		return nil, ErrNotFound
+	}
+
+	return &respBody.Data[0], nil
+}
+
+/*
+WaitSpectrumScan implements SpectrumScans().Wait: it polls the AP's spectrum
+scan every pollInterval (5 seconds when pollInterval <= 0) until it has run and
+finished, and returns its result. As the AP reports a scan only some seconds
+after it is started, a scan that is not running yet is polled again; one that
+does not show as running within six polls is taken as already finished. Bound the wait with ctx:
+
+	if err := c.SpectrumScans().Start(ctx, "default", mac); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Minute)
+	defer cancel()
+	scan, err := c.SpectrumScans().Wait(ctx, "default", mac, 0)
+*/
+func (c *client) WaitSpectrumScan(ctx context.Context, site, apMAC string, pollInterval time.Duration) (*SpectrumScanResult, error) {
+	if pollInterval <= 0 {
+		pollInterval = defaultSpectrumScanPollInterval
+	}
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	started := false
+	for poll := 1; ; poll++ {
+		scan, err := c.GetSpectrumScan(ctx, site, apMAC)
+		if err != nil {
+			return nil, err
+		}
+		started = started || scan.Scanning
+		if !scan.Scanning && (started || poll >= spectrumScanStartPolls) {
+			return scan, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("spectrum scan of %s still running: %w", apMAC, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
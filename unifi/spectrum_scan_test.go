@@ -0,0 +1,126 @@
+package unifi //nolint: testpackage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const spectrumScanBody = `{"meta":{"rc":"ok"},"data":[{"mac":"aa:bb:cc:00:00:01","spectrum_scanning":%s,"spectrum_table":[
+	{"radio":"ng","channel":1,"width":20,"utilization":40,"interference":5},
+	{"radio":"ng","channel":6,"width":20,"utilization":10,"interference":20},
+	{"radio":"ng","channel":11,"width":20,"utilization":25,"interference":0},
+	{"radio":"na","channel":36,"width":80,"utilization":12.5,"interference":0},
+	{"radio":"na","channel":100,"width":80,"dfs":true,"utilization":2,"interference":0,"interference_type":""}
+]}]}`
+
+// spectrumScanServer reports a scan that, once started, still shows the
+// previous result for the first delay status requests and is then running for
+// the next polls.
+func spectrumScanServer(t *testing.T, delay, polls int32) *controllerServer {
+	t.Helper()
+	var started atomic.Bool
+	var seen atomic.Int32
+	return newControllerServer(t,
+		route{apiV1Path("s/default/cmd/devmgr"), func(w http.ResponseWriter, _ *http.Request) {
+			seen.Store(0)
+			started.Store(true)
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[]}`))
+		}},
+		route{apiV1Path("s/default/stat/spectrum-scan/aa:bb:cc:00:00:01"), func(w http.ResponseWriter, _ *http.Request) {
+			scanning := "false"
+			if n := seen.Add(1); started.Load() && n > delay && n <= delay+polls {
+				scanning = "true"
+			}
+			_, _ = w.Write([]byte(fmt.Sprintf(spectrumScanBody, scanning)))
+		}},
+	)
+}
+
+func TestSpectrumScan(t *testing.T) {
+	t.Parallel()
+	cs := spectrumScanServer(t, 2, 2)
+	c := cs.clientWith(withCache(&ResponseCache{DefaultTTL: time.Hour}))
+	ctx := context.Background()
+
+	before, err := c.GetSpectrumScan(ctx, "default", "aa:bb:cc:00:00:01")
+	require.NoError(t, err)
+	assert.False(t, before.Scanning)
+
+	require.NoError(t, c.StartSpectrumScan(ctx, "default", "AA:BB:CC:00:00:01"))
+	assert.JSONEq(t, `{"cmd":"spectrum-scan","mac":"aa:bb:cc:00:00:01"}`, string(cs.lastRequest().Body))
+
+	scan, err := c.WaitSpectrumScan(ctx, "default", "aa:bb:cc:00:00:01", time.Millisecond)
+	require.NoError(t, err)
+	assert.False(t, scan.Scanning)
+	assert.Equal(t, 6, cs.countRequestsTo(apiV1Path("s/default/stat/spectrum-scan/aa:bb:cc:00:00:01")),
+		"the scan state is never cached, and Wait polls until the scan has run")
+
+	assert.Equal(t, []string{"ng", "na"}, scan.Radios())
+	assert.Len(t, scan.Radio("na"), 2)
+	assert.Equal(t, SpectrumChannel{Radio: "na", Channel: 100, Width: 80, DFS: true, Utilization: 2}, scan.Radio("na")[1])
+}
+
+func TestSpectrumScanWaitTimeout(t *testing.T) {
+	t.Parallel()
+	cs := spectrumScanServer(t, 0, 1000)
+	c := cs.client()
+	require.NoError(t, c.StartSpectrumScan(context.Background(), "default", "aa:bb:cc:00:00:01"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := c.SpectrumScans().Wait(ctx, "default", "aa:bb:cc:00:00:01", 5*time.Millisecond)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+// TestSpectrumScanWaitNeverRunning proves Wait gives up waiting for a scan to
+// show as running after a few polls.
+func TestSpectrumScanWaitNeverRunning(t *testing.T) {
+	t.Parallel()
+	cs := spectrumScanServer(t, 0, 0)
+	c := cs.client()
+	require.NoError(t, c.StartSpectrumScan(context.Background(), "default", "aa:bb:cc:00:00:01"))
+
+	scan, err := c.WaitSpectrumScan(context.Background(), "default", "aa:bb:cc:00:00:01", time.Millisecond)
+	require.NoError(t, err)
+	assert.False(t, scan.Scanning)
+	assert.Equal(t, spectrumScanStartPolls, cs.countRequestsTo(apiV1Path("s/default/stat/spectrum-scan/aa:bb:cc:00:00:01")))
+}
+
+func TestSpectrumScanNotFound(t *testing.T) {
+	t.Parallel()
+	cs := newControllerServer(t, route{apiV1Path("s/default/stat/spectrum-scan/aa:bb:cc:00:00:01"), func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[]}`))
+	}})
+	_, err := cs.client().GetSpectrumScan(context.Background(), "default", "aa:bb:cc:00:00:01")
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestSpectrumScanCleanestChannels(t *testing.T) {
+	t.Parallel()
+	scan := &SpectrumScanResult{Channels: []SpectrumChannel{
+		{Radio: "ng", Channel: 1, Utilization: 40, Interference: 5},
+		{Radio: "ng", Channel: 6, Utilization: 10, Interference: 20},
+		{Radio: "ng", Channel: 11, Utilization: 30, Interference: 0},
+		{Radio: "ng", Channel: 3, Utilization: 90, Interference: 50},
+		{Radio: "na", Channel: 36, Utilization: 1},
+	}}
+
+	ranked := scan.CleanestChannels("ng", 0)
+	require.Len(t, ranked, 4)
+	assert.Equal(t, []int{11, 6, 1, 3}, []int{ranked[0].Channel, ranked[1].Channel, ranked[2].Channel, ranked[3].Channel},
+		"equally busy channels prefer less interference")
+	assert.InDelta(t, 100, ranked[3].Busy(), 0)
+
+	top := scan.CleanestChannels("ng", 1)
+	require.Len(t, top, 1)
+	assert.Equal(t, 11, top[0].Channel)
+	assert.Empty(t, scan.CleanestChannels("6e", 3))
+	assert.Equal(t, 1, scan.Channels[0].Channel, "ranking does not reorder the result")
+}
@@ -0,0 +1,267 @@
+package unifi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// defaultSpeedTestWindow is the history SpeedTests().ListResults returns when it
+// is given a zero from.
+const defaultSpeedTestWindow = 30 * 24 * time.Hour
+
+// speedTestAttributes are requested from the speed test archive.
+var speedTestAttributes = []string{"time", "xput_download", "xput_upload", "latency", "interface_name", "server"}
+
+// SpeedTestServer is the server a speed test measured against.
+type SpeedTestServer struct {
+	Provider    string  `json:"provider,omitempty"`
+	ProviderURL string  `json:"provider_url,omitempty"`
+	City        string  `json:"city,omitempty"`
+	Country     string  `json:"country,omitempty"`
+	CountryCode string  `json:"cc,omitempty"`
+	Lat         float64 `json:"lat,omitempty"`
+	Lon         float64 `json:"lon,omitempty"`
+}
+
+// SpeedTestStatus is the progress and last result of the gateway's speed test.
+type SpeedTestStatus struct {
+	// StatusSummary is 0 when no test is running; StatusPing, StatusDownload
+	// and StatusUpload are the stages of a running test, 0 once done.
+	StatusSummary  int `json:"status_summary"`
+	StatusPing     int `json:"status_ping"`
+	StatusDownload int `json:"status_download"`
+	StatusUpload   int `json:"status_upload"`
+
+	// XputDownload and XputUpload are the throughput in Mbps, Latency the
+	// round trip in milliseconds.
+	XputDownload float64 `json:"xput_download"`
+	XputUpload   float64 `json:"xput_upload"`
+	Latency      float64 `json:"latency"`
+	// RunDate is when the last test ran, in seconds since the epoch; Runtime
+	// how long it took, in seconds.
+	RunDate int64            `json:"rundate"`
+	Runtime int              `json:"runtime"`
+	Server  *SpeedTestServer `json:"server,omitempty"`
+}
+
+// Running reports whether a speed test is in progress.
+func (s *SpeedTestStatus) Running() bool {
+	return s.StatusSummary != 0
+}
+
+// RunDateTime returns when the last test ran, or the zero time when no test
+// has run.
+func (s *SpeedTestStatus) RunDateTime() time.Time {
+	if s.RunDate == 0 {
+		return time.Time{}
+	}
+	return time.Unix(s.RunDate, 0)
+}
+
+// SpeedTestResult is one archived speed test.
+type SpeedTestResult struct {
+	Time time.Time
+	// Download and Upload are the throughput in Mbps, Latency the round trip
+	// in milliseconds.
+	Download float64
+	Upload   float64
+	Latency  float64
+	// Interface is the WAN interface the test ran on, when the controller
+	// reports it.
+	Interface string
+	Server    *SpeedTestServer
+}
+
+// UnmarshalJSON decodes an entry of the speed test archive, whose time is in
+// milliseconds since the epoch.
+func (r *SpeedTestResult) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		Time          int64            `json:"time"`
+		XputDownload  float64          `json:"xput_download"`
+		XputUpload    float64          `json:"xput_upload"`
+		Latency       float64          `json:"latency"`
+		InterfaceName string           `json:"interface_name"`
+		Server        *SpeedTestServer `json:"server"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	*r = SpeedTestResult{
+		Time:      time.UnixMilli(raw.Time),
+		Download:  raw.XputDownload,
+		Upload:    raw.XputUpload,
+		Latency:   raw.Latency,
+		Interface: raw.InterfaceName,
+		Server:    raw.Server,
+	}
+	return nil
+}
+
+/*
+RunSpeedTest implements SpeedTests().Run: it starts a speed test on the site's
+gateway. The test takes up to a minute and saturates the WAN link while it
+runs; follow it with SpeedTests().GetStatus, and find the result in
+SpeedTests().ListResults once done.
+*/
+func (c *client) RunSpeedTest(ctx context.Context, site string) error {
+	reqBody := struct {
+		Cmd string `json:"cmd"`
+	}{Cmd: "speedtest"}
+
+	var respBody struct {
+		Meta Meta `json:"meta"`
+	}
+
+	return c.Post(ctx, fmt.Sprintf("s/%s/cmd/devmgr", site), reqBody, &respBody)
+}
+
+// GetSpeedTestStatus implements SpeedTests().GetStatus: it returns the
+// progress and last result of the gateway's speed test. The controller serves
+// it as a command, which the client still treats as a read: read-only and
+// dry-run clients send it, it is not audited and it does not invalidate cached
+// devices.
+func (c *client) GetSpeedTestStatus(ctx context.Context, site string) (*SpeedTestStatus, error) {
+	reqBody := struct {
+		Cmd string `json:"cmd"`
+	}{Cmd: "speedtest-status"}
+
+	var respBody struct {
+		Meta Meta              `json:"meta"`
+		Data []SpeedTestStatus `json:"data"`
+	}
+
+	err := c.Post(ctx, fmt.Sprintf("s/%s/cmd/devmgr", site), reqBody, &respBody)
+	if err != nil {
+		return nil, err
+	}
+	if len(respBody.Data) != 1 {
+		return nil, ErrNotFound
+	}
+
+	return &respBody.Data[0], nil
+}
+
+/*
+ListSpeedTestResults implements SpeedTests().ListResults: it returns the speed
+tests archived over [from, to], oldest first. A zero to means now, a zero from
+30 days before to. Both scheduled tests
+(SettingAutoSpeedtest) and those started with SpeedTests().Run are archived.
+
+The window must end after it starts, or an error matching
+ErrInvalidReportWindow is returned.
+*/
+func (c *client) ListSpeedTestResults(ctx context.Context, site string, from, to time.Time) ([]SpeedTestResult, error) {
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.Add(-defaultSpeedTestWindow)
+	}
+	if !from.Before(to) {
+		return nil, fmt.Errorf("%w: from %s is not before to %s", ErrInvalidReportWindow, from.Format(time.RFC3339), to.Format(time.RFC3339))
+	}
+
+	reqBody := struct {
+		Attrs []string `json:"attrs"`
+		Start int64    `json:"start"`
+		End   int64    `json:"end"`
+	}{
+		Attrs: speedTestAttributes,
+		Start: from.UnixMilli(),
+		End:   to.UnixMilli(),
+	}
+	var respBody struct {
+		Meta Meta              `json:"meta"`
+		Data []SpeedTestResult `json:"data"`
+	}
+	err := c.Post(ctx, fmt.Sprintf("s/%s/stat/report/archive.speedtest", site), reqBody, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	results := respBody.Data
+	slices.SortStableFunc(results, func(a, b SpeedTestResult) int { return a.Time.Compare(b.Time) })
+	return results, nil
+}
+
+// SpeedTestStats summarizes one measurement over a number of speed tests.
+type SpeedTestStats struct {
+	Min    float64 `json:"min"`
+	Max    float64 `json:"max"`
+	Mean   float64 `json:"mean"`
+	Median float64 `json:"median"`
+}
+
+// SpeedTestMonth summarizes the speed tests of one calendar month.
+type SpeedTestMonth struct {
+	// Month is midnight of the month's first day.
+	Month    time.Time      `json:"month"`
+	Count    int            `json:"count"`
+	Download SpeedTestStats `json:"download"`
+	Upload   SpeedTestStats `json:"upload"`
+	Latency  SpeedTestStats `json:"latency"`
+}
+
+/*
+SpeedTestsByMonth groups results by calendar month in loc (UTC when nil) and
+summarizes each month's download, upload and latency, oldest month first.
+Months without tests are left out.
+
+	results, err := c.SpeedTests().ListResults(ctx, "branch-12", start, end)
+	if err != nil {
+		return err
+	}
+	for _, m := range unifi.SpeedTestsByMonth(results, nil) {
+		fmt.Printf("%s %d tests, median %.0f/%.0f Mbps\n",
+			m.Month.Format("2006-01"), m.Count, m.Download.Median, m.Upload.Median)
+	}
+*/
+func SpeedTestsByMonth(results []SpeedTestResult, loc *time.Location) []SpeedTestMonth {
+	if loc == nil {
+		loc = time.UTC
+	}
+	byMonth := map[time.Time][]SpeedTestResult{}
+	for _, r := range results {
+		t := r.Time.In(loc)
+		month := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+		byMonth[month] = append(byMonth[month], r)
+	}
+
+	months := make([]SpeedTestMonth, 0, len(byMonth))
+	for month, rs := range byMonth {
+		months = append(months, SpeedTestMonth{
+			Month:    month,
+			Count:    len(rs),
+			Download: speedTestStats(rs, func(r SpeedTestResult) float64 { return r.Download }),
+			Upload:   speedTestStats(rs, func(r SpeedTestResult) float64 { return r.Upload }),
+			Latency:  speedTestStats(rs, func(r SpeedTestResult) float64 { return r.Latency }),
+		})
+	}
+	slices.SortFunc(months, func(a, b SpeedTestMonth) int { return a.Month.Compare(b.Month) })
+	return months
+}
+
+// speedTestStats summarizes the value of results, which must not be empty.
+func speedTestStats(results []SpeedTestResult, value func(SpeedTestResult) float64) SpeedTestStats {
+	values := make([]float64, len(results))
+	var sum float64
+	for i, r := range results {
+		values[i] = value(r)
+		sum += values[i]
+	}
+	slices.Sort(values)
+
+	median := values[len(values)/2]
+	if len(values)%2 == 0 {
+		median = (values[len(values)/2-1] + median) / 2
+	}
+	return SpeedTestStats{
+		Min:    values[0],
+		Max:    values[len(values)-1],
+		Mean:   sum / float64(len(values)),
+		Median: median,
+	}
+}
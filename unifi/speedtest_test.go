@@ -0,0 +1,175 @@
+package unifi //nolint: testpackage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunSpeedTest(t *testing.T) {
+	t.Parallel()
+	cs := newControllerServer(t, route{apiV1Path("s/default/cmd/devmgr"), func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[]}`))
+	}})
+
+	require.NoError(t, cs.client().SpeedTests().Run(context.Background(), "default"))
+
+	req := cs.lastRequest()
+	assert.Equal(t, http.MethodPost, req.Method)
+	assert.JSONEq(t, `{"cmd":"speedtest"}`, string(req.Body))
+}
+
+func TestGetSpeedTestStatus(t *testing.T) {
+	t.Parallel()
+	cs := newControllerServer(t, route{apiV1Path("s/default/cmd/devmgr"), func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{
+			"status_summary":2,"status_ping":0,"status_download":2,"status_upload":0,
+			"xput_download":512.4,"xput_upload":98.1,"latency":11,"rundate":1700000000,"runtime":42,
+			"server":{"provider":"Example ISP","city":"Berlin","country":"Germany","cc":"DE","lat":52.52,"lon":13.4}
+		}]}`))
+	}})
+
+	status, err := cs.client().GetSpeedTestStatus(context.Background(), "default")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"cmd":"speedtest-status"}`, string(cs.lastRequest().Body))
+
+	assert.True(t, status.Running())
+	assert.InDelta(t, 512.4, status.XputDownload, 0)
+	assert.InDelta(t, 98.1, status.XputUpload, 0)
+	assert.True(t, status.RunDateTime().Equal(time.Unix(1700000000, 0)))
+	require.NotNil(t, status.Server)
+	assert.Equal(t, "DE", status.Server.CountryCode)
+	assert.Equal(t, "Example ISP", status.Server.Provider)
+
+	assert.True(t, (&SpeedTestStatus{}).RunDateTime().IsZero())
+	assert.False(t, (&SpeedTestStatus{}).Running())
+}
+
+// TestGetSpeedTestStatusIsRead proves the status command is treated as a read:
+// it is not audited and does not invalidate cached devices.
+func TestGetSpeedTestStatusIsRead(t *testing.T) {
+	t.Parallel()
+	cs := newControllerServer(t,
+		route{apiV1Path("s/default/cmd/devmgr"), func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"status_summary":0}]}`))
+		}},
+		route{apiV1Path("s/default/stat/device"), func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[]}`))
+		}},
+	)
+	sink := &recordingAuditSink{}
+	rc := &ResponseCache{}
+	c := cs.clientWith(withCache(rc), withAudit(&Audit{Sink: sink}))
+	ctx := context.Background()
+
+	_, err := c.ListDevice(ctx, "default")
+	require.NoError(t, err)
+	_, err = c.SpeedTests().GetStatus(ctx, "default")
+	require.NoError(t, err)
+	_, err = c.ListDevice(ctx, "default")
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, cs.countRequestsTo(apiV1Path("s/default/stat/device")), "the device list stays cached")
+	assert.Empty(t, sink.all(), "a status poll is not audited")
+	assert.JSONEq(t, `{"cmd":"speedtest-status"}`, string(cs.lastRequest().Body), "the buffered body is sent")
+}
+
+func TestGetSpeedTestStatusNotFound(t *testing.T) {
+	t.Parallel()
+	cs := newControllerServer(t, route{apiV1Path("s/default/cmd/devmgr"), func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[]}`))
+	}})
+
+	_, err := cs.client().GetSpeedTestStatus(context.Background(), "default")
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestListSpeedTestResults(t *testing.T) {
+	t.Parallel()
+	cs := newControllerServer(t, route{apiV1Path("s/default/stat/report/archive.speedtest"), func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[
+			{"time":1700086400000,"xput_download":480,"xput_upload":95.5,"latency":12,"interface_name":"wan"},
+			{"time":1700000000000,"xput_download":500,"xput_upload":100,"latency":10,"server":{"provider":"Example ISP","cc":"DE"}}
+		]}`))
+	}})
+	from := time.UnixMilli(1699990000000)
+	to := from.Add(48 * time.Hour)
+
+	results, err := cs.client().SpeedTests().ListResults(context.Background(), "default", from, to)
+	require.NoError(t, err)
+
+	req := cs.lastRequest()
+	assert.Equal(t, http.MethodPost, req.Method)
+	assert.JSONEq(t, `{"attrs":["time","xput_download","xput_upload","latency","interface_name","server"],"start":1699990000000,"end":1700162800000}`, string(req.Body))
+
+	require.Len(t, results, 2)
+	assert.True(t, results[0].Time.Equal(time.UnixMilli(1700000000000)), "results are sorted oldest first")
+	assert.InDelta(t, 500, results[0].Download, 0)
+	assert.InDelta(t, 100, results[0].Upload, 0)
+	assert.InDelta(t, 10, results[0].Latency, 0)
+	require.NotNil(t, results[0].Server)
+	assert.Equal(t, "Example ISP", results[0].Server.Provider)
+	assert.Equal(t, "wan", results[1].Interface)
+	assert.Nil(t, results[1].Server)
+}
+
+func TestListSpeedTestResultsWindow(t *testing.T) {
+	t.Parallel()
+	cs := newControllerServer(t, route{apiV1Path("s/default/stat/report/archive.speedtest"), func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[]}`))
+	}})
+	c := cs.client()
+	now := time.Now()
+
+	_, err := c.ListSpeedTestResults(context.Background(), "default", now, now.Add(-time.Hour))
+	require.ErrorIs(t, err, ErrInvalidReportWindow)
+	assert.Zero(t, cs.countRequestsTo(apiV1Path("s/default/stat/report/archive.speedtest")), "an invalid window is not sent")
+
+	results, err := c.ListSpeedTestResults(context.Background(), "default", time.Time{}, time.Time{})
+	require.NoError(t, err)
+	assert.Empty(t, results)
+	var body struct{ Start, End int64 }
+	require.NoError(t, json.Unmarshal(cs.lastRequest().Body, &body))
+	assert.Equal(t, 30*24*time.Hour, time.Duration(body.End-body.Start)*time.Millisecond, "a zero window defaults to 30 days")
+	assert.WithinDuration(t, now, time.UnixMilli(body.End), time.Minute)
+}
+
+func TestSpeedTestsByMonth(t *testing.T) {
+	t.Parallel()
+	at := func(month time.Month, day int) time.Time { return time.Date(2026, month, day, 12, 0, 0, 0, time.UTC) }
+	results := []SpeedTestResult{
+		{Time: at(time.March, 3), Download: 300, Upload: 50, Latency: 20},
+		{Time: at(time.January, 5), Download: 500, Upload: 100, Latency: 10},
+		{Time: at(time.January, 20), Download: 400, Upload: 80, Latency: 14},
+		{Time: at(time.January, 31), Download: 100, Upload: 20, Latency: 40},
+		{Time: at(time.January, 12), Download: 450, Upload: 90, Latency: 12},
+	}
+
+	months := SpeedTestsByMonth(results, nil)
+	require.Len(t, months, 2, "months without tests are left out")
+
+	jan := months[0]
+	assert.Equal(t, time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), jan.Month)
+	assert.Equal(t, 4, jan.Count)
+	assert.Equal(t, SpeedTestStats{Min: 100, Max: 500, Mean: 362.5, Median: 425}, jan.Download)
+	assert.Equal(t, SpeedTestStats{Min: 20, Max: 100, Mean: 72.5, Median: 85}, jan.Upload)
+	assert.Equal(t, SpeedTestStats{Min: 10, Max: 40, Mean: 19, Median: 13}, jan.Latency)
+
+	mar := months[1]
+	assert.Equal(t, time.March, mar.Month.Month())
+	assert.Equal(t, 1, mar.Count)
+	assert.Equal(t, SpeedTestStats{Min: 300, Max: 300, Mean: 300, Median: 300}, mar.Download)
+
+	tokyo := time.FixedZone("JST", 9*60*60)
+	late := []SpeedTestResult{{Time: time.Date(2026, time.January, 31, 20, 0, 0, 0, time.UTC), Download: 1}}
+	local := SpeedTestsByMonth(late, tokyo)
+	require.Len(t, local, 1)
+	assert.Equal(t, time.Date(2026, time.February, 1, 0, 0, 0, 0, tokyo), local[0].Month, "months follow loc")
+
+	assert.Empty(t, SpeedTestsByMonth(nil, nil))
+}
@@ -0,0 +1,171 @@
+package unifi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+)
+
+// ListUserSeq implements Users().ListSeq: it streams the users (known clients)
+// of site, decoding the response element by element instead of buffering the
+// whole list like ListUser. The request is sent when iteration starts and the
+// response is read as the loop advances; breaking out of the loop closes it. An
+// error ends the sequence.
+func (c *client) ListUserSeq(ctx context.Context, site string) iter.Seq2[User, error] {
+	return streamList[User](ctx, c, http.MethodGet, fmt.Sprintf("s/%s/rest/user", site), nil)
+}
+
+// ListDeviceSeq implements Devices().ListSeq: it streams the devices of site,
+// decoding the response element by element instead of buffering the whole list
+// like ListDevice. It behaves like Users().ListSeq.
+func (c *client) ListDeviceSeq(ctx context.Context, site string) iter.Seq2[Device, error] {
+	return streamList[Device](ctx, c, http.MethodGet, fmt.Sprintf("s/%s/stat/device", site), nil)
+}
+
+// streamList returns a sequence over the elements of the list response to a
+// request. The request goes through Do, so validation, interceptors,
+// instrumentation and error handling apply as for buffered calls.
+func streamList[T any](ctx context.Context, c *client, method, apiPath string, reqBody any) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		sink := &listStream[T]{yield: yield}
+		if err := c.Do(ctx, method, apiPath, reqBody, sink); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
+
+// streamDecoder is a response body that decodes itself from the response
+// stream instead of being decoded from the buffered body. handleResponse hands
+// the response to it once the error checks have passed.
+type streamDecoder interface {
+	decodeStream(resp *http.Response, limit int) error
+}
+
+// listStream decodes a list response element by element, passing each to
+// yield. It accepts a v1 {meta, data: [...]} envelope, any object carrying a
+// data array (the v2 paged responses), and a bare v2 array.
+type listStream[T any] struct {
+	yield func(T, error) bool
+	// stopped records that the consumer broke out of the loop; decoding ends
+	// without an error and the rest of the response is discarded.
+	stopped bool
+}
+
+func (s *listStream[T]) decodeStream(resp *http.Response, limit int) error {
+	r := &elementLimitReader{r: resp.Body, limit: int64(limit)}
+	dec := json.NewDecoder(r)
+	tok, err := dec.Token()
+	if errors.Is(err, io.EOF) {
+		// An empty body is an empty list, as for buffered calls.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	switch tok {
+	case json.Delim('['):
+		return s.decodeElements(dec, r)
+	case json.Delim('{'):
+	default:
+		return fmt.Errorf("expected a JSON array or object, got %v", tok)
+	}
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch key {
+		case "meta":
+			var meta Meta
+			if err := dec.Decode(&meta); err != nil {
+				return err
+			}
+			// The controller writes meta first, so a soft rc:error is
+			// reported before any element is yielded.
+			if err := stampSoftError(resp, meta.error()); err != nil {
+				return err
+			}
+		case "data":
+			if tok, err := dec.Token(); err != nil {
+				return err
+			} else if tok != json.Delim('[') {
+				return fmt.Errorf("expected data to be a JSON array, got %v", tok)
+			}
+			if err := s.decodeElements(dec, r); err != nil || s.stopped {
+				return err
+			}
+		default:
+			// Paging fields and the like; skip them.
+			if err := dec.Decode(&json.RawMessage{}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// decodeElements decodes the elements of the array whose opening bracket dec
+// has just read, up to and including its closing bracket.
+func (s *listStream[T]) decodeElements(dec *json.Decoder, r *elementLimitReader) error {
+	for dec.More() {
+		r.startElement(dec.InputOffset())
+		var v T
+		if err := dec.Decode(&v); err != nil {
+			return err
+		}
+		if !s.yield(v, nil) {
+			s.stopped = true
+			return nil
+		}
+	}
+	_, err := dec.Token()
+	return err
+}
+
+// elementLimitReader fails once the JSON decoder has read more than limit
+// bytes past the start of the current element: the decoder only asks for more
+// input while the value it is decoding is incomplete, so the element itself is
+// larger than limit.
+type elementLimitReader struct {
+	r     io.Reader
+	limit int64
+	read  int64
+	start int64
+}
+
+// startElement marks the input offset of the next element.
+func (l *elementLimitReader) startElement(offset int64) {
+	l.start = offset
+}
+
+func (l *elementLimitReader) Read(p []byte) (int, error) {
+	if l.read-l.start > l.limit {
+		return 0, fmt.Errorf("list element exceeded %d bytes", l.limit)
+	}
+	n, err := l.r.Read(p)
+	l.read += int64(n)
+	return n, err
+}
+
+// stampSoftError enriches a soft rc:error *ServerError with the response
+// context, so it renders like the errors of non-2xx responses. resp.Request
+// can be nil on a hand-built *http.Response (e.g. unit tests), so it is
+// guarded.
+func stampSoftError(resp *http.Response, err error) error {
+	var serverErr *ServerError
+	if errors.As(err, &serverErr) {
+		serverErr.StatusCode = resp.StatusCode
+		if resp.Request != nil {
+			serverErr.RequestMethod = resp.Request.Method
+			if resp.Request.URL != nil {
+				serverErr.RequestURL = resp.Request.URL.String()
+			}
+		}
+	}
+	return err
+}
@@ -0,0 +1,218 @@
+package unifi //nolint: testpackage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// collectSeq drains a sequence into its values and the error that ended it.
+func collectSeq[T any](t *testing.T, seq func(func(T, error) bool)) ([]T, error) {
+	t.Helper()
+	var out []T
+	for v, err := range seq {
+		if err != nil {
+			return out, err
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+func TestListUserSeq(t *testing.T) {
+	t.Parallel()
+	cs := newControllerServer(t, route{apiV1Path("s/default/rest/user"), func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"_id":"1","name":"a"},{"_id":"2","name":"b"},{"_id":"3","name":"c"}]}`))
+	}})
+	c := cs.client()
+
+	users, err := collectSeq(t, c.Users().ListSeq(context.Background(), "default"))
+	require.NoError(t, err)
+	require.Len(t, users, 3)
+	assert.Equal(t, []string{"a", "b", "c"}, []string{users[0].Name, users[1].Name, users[2].Name})
+
+	users, err = collectSeq(t, c.Users().ListSeq(context.Background(), "default"))
+	require.NoError(t, err)
+	assert.Len(t, users, 3, "the grouped method forwards to the flat one")
+}
+
+// TestListDeviceSeqIsIncremental proves elements are yielded as they arrive:
+// the server holds the rest of the body back until the consumer has seen the
+// first device.
+func TestListDeviceSeqIsIncremental(t *testing.T) {
+	t.Parallel()
+	seen := make(chan struct{})
+	cs := newControllerServer(t, route{apiV1Path("s/default/stat/device"), func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"_id":"1","mac":"aa:aa:aa:aa:aa:01"}`))
+		w.(http.Flusher).Flush()
+		select {
+		case <-seen:
+		case <-r.Context().Done():
+			return
+		}
+		_, _ = w.Write([]byte(`,{"_id":"2","mac":"aa:aa:aa:aa:aa:02"}]}`))
+	}})
+
+	var macs []string
+	for d, err := range cs.client().ListDeviceSeq(context.Background(), "default") {
+		require.NoError(t, err)
+		if len(macs) == 0 {
+			close(seen)
+		}
+		macs = append(macs, d.MAC)
+	}
+	assert.Equal(t, []string{"aa:aa:aa:aa:aa:01", "aa:aa:aa:aa:aa:02"}, macs)
+}
+
+func TestListSeqBreak(t *testing.T) {
+	t.Parallel()
+	var body strings.Builder
+	body.WriteString(`{"meta":{"rc":"ok"},"data":[`)
+	for i := range 1000 {
+		if i > 0 {
+			body.WriteString(",")
+		}
+		fmt.Fprintf(&body, `{"_id":"%d"}`, i)
+	}
+	body.WriteString(`]}`)
+	cs := newControllerServer(t, route{apiV1Path("s/default/rest/user"), func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(body.String()))
+	}})
+
+	calls := 0
+	for _, err := range cs.client().ListUserSeq(context.Background(), "default") {
+		require.NoError(t, err)
+		calls++
+		if calls == 2 {
+			break
+		}
+	}
+	assert.Equal(t, 2, calls, "the sequence must honor a break without yielding again")
+}
+
+func TestListSeqErrors(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		status    int
+		body      string
+		wantLen   int
+		wantIs    error
+		wantError string
+	}{
+		"soft rc:error before data": {
+			status: http.StatusOK,
+			body:   `{"meta":{"rc":"error","msg":"api.err.NoPermission"},"data":[{"_id":"1"}]}`,
+			wantIs: ErrForbidden,
+		},
+		"not found": {
+			status: http.StatusNotFound,
+			wantIs: ErrNotFound,
+		},
+		"truncated body": {
+			status:    http.StatusOK,
+			body:      `{"meta":{"rc":"ok"},"data":[{"_id":"1"},{"_id":`,
+			wantLen:   1,
+			wantError: "unable to decode body",
+		},
+		"data is not an array": {
+			status:    http.StatusOK,
+			body:      `{"meta":{"rc":"ok"},"data":{"_id":"1"}}`,
+			wantError: "expected data to be a JSON array",
+		},
+		"scalar body": {
+			status:    http.StatusOK,
+			body:      `"nope"`,
+			wantError: "expected a JSON array or object",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			cs := newControllerServer(t, route{apiV1Path("s/default/rest/user"), func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}})
+
+			users, err := collectSeq(t, cs.client().ListUserSeq(context.Background(), "default"))
+			require.Error(t, err)
+			assert.Len(t, users, tt.wantLen)
+			if tt.wantIs != nil {
+				require.ErrorIs(t, err, tt.wantIs)
+				var serverErr *ServerError
+				require.ErrorAs(t, err, &serverErr)
+				assert.Equal(t, tt.status, serverErr.StatusCode)
+				assert.Equal(t, http.MethodGet, serverErr.RequestMethod)
+			}
+			if tt.wantError != "" {
+				assert.ErrorContains(t, err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestListSeqEnvelopes(t *testing.T) {
+	t.Parallel()
+	tests := map[string]string{
+		"bare v2 array":   `[{"_id":"1"},{"_id":"2"}]`,
+		"paged v2 object": `{"has_next":false,"page":{"n":1},"data":[{"_id":"1"},{"_id":"2"}],"total_element_count":2}`,
+		"meta after data": `{"data":[{"_id":"1"},{"_id":"2"}],"meta":{"rc":"ok"}}`,
+		"whitespace":      " \n[ {\"_id\":\"1\"} ,\n {\"_id\":\"2\"} ]\n",
+	}
+	for name, body := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			cs := newControllerServer(t, route{apiV2("site/default/things"), func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(body))
+			}})
+			c := cs.client()
+
+			users, err := collectSeq(t, streamList[User](context.Background(), c, http.MethodGet, apiV2("site/default/things"), nil))
+			require.NoError(t, err)
+			require.Len(t, users, 2)
+			assert.Equal(t, "2", users[1].ID)
+		})
+	}
+
+	cs := newControllerServer(t, route{apiV1Path("s/default/rest/user"), func(http.ResponseWriter, *http.Request) {}})
+	users, err := collectSeq(t, cs.client().ListUserSeq(context.Background(), "default"))
+	require.NoError(t, err, "an empty body is an empty list")
+	assert.Empty(t, users)
+}
+
+func TestResponseBodyLimit(t *testing.T) {
+	t.Parallel()
+	small := `{"_id":"1","name":"small"}`
+	large := `{"_id":"2","name":"` + strings.Repeat("x", 4096) + `"}`
+	cs := newControllerServer(t, route{apiV1Path("s/default/rest/user"), func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[` + small + `,` + small + `,` + large + `,` + small + `]}`))
+	}})
+	c := cs.client()
+
+	t.Run("stream caps each element", func(t *testing.T) {
+		t.Parallel()
+		ctx := WithResponseBodyLimit(context.Background(), 1024)
+		users, err := collectSeq(t, c.ListUserSeq(ctx, "default"))
+		require.ErrorContains(t, err, "list element exceeded 1024 bytes")
+		assert.Len(t, users, 2, "the elements before the oversized one are yielded")
+	})
+	t.Run("stream within the cap", func(t *testing.T) {
+		t.Parallel()
+		ctx := WithResponseBodyLimit(context.Background(), 8192)
+		users, err := collectSeq(t, c.ListUserSeq(ctx, "default"))
+		require.NoError(t, err)
+		assert.Len(t, users, 4, "the body as a whole may exceed the per-element cap")
+	})
+	t.Run("buffered call caps the body", func(t *testing.T) {
+		t.Parallel()
+		_, err := c.ListUser(WithResponseBodyLimit(context.Background(), 1024), "default")
+		require.ErrorContains(t, err, "response body exceeded 1024 bytes")
+		users, err := c.ListUser(WithResponseBodyLimit(context.Background(), 0), "default")
+		require.NoError(t, err, "a non-positive limit keeps the default")
+		assert.Len(t, users, 4)
+	})
+}
@@ -180,6 +180,21 @@ func (cs *controllerServer) lastRequest() recordedRequest {
 	return cs.requests[len(cs.requests)-1]
 }
 
+// lastRequestTo returns the most recently recorded request to path, failing the
+// test if none was served.
+func (cs *controllerServer) lastRequestTo(path string) recordedRequest {
+	cs.t.Helper()
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	for i := len(cs.requests) - 1; i >= 0; i-- {
+		if cs.requests[i].Path == path {
+			return cs.requests[i]
+		}
+	}
+	require.Failf(cs.t, "no request", "expected a request to %s to reach the mock controller", path)
+	return recordedRequest{}
+}
+
 // requestCount returns the number of requests recorded so far, read under mu.
 func (cs *controllerServer) requestCount() int {
 	cs.mu.Lock()
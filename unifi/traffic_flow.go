@@ -3,6 +3,9 @@ package unifi
 import (
 	"context"
 	"fmt"
+	"iter"
+	"strings"
+	"time"
 )
 
 // TrafficFlowsRequest represents the request payload for fetching traffic flows.
@@ -69,6 +72,59 @@ type TrafficFlow struct {
 	TrafficData TrafficFlowTrafficData `json:"traffic_data"`
 }
 
+// IsBlocked reports whether the gateway blocked the flow.
+func (f TrafficFlow) IsBlocked() bool {
+	return strings.EqualFold(f.Action, string(TrafficFlowBlocked))
+}
+
+// TrafficFlowRisk is the risk level the controller assigns a flow, as in
+// TrafficFlow.Risk and TrafficFlowsRequest.Risk.
+type TrafficFlowRisk string
+
+const (
+	TrafficFlowRiskLow        TrafficFlowRisk = "low"
+	TrafficFlowRiskMedium     TrafficFlowRisk = "medium"
+	TrafficFlowRiskHigh       TrafficFlowRisk = "high"
+	TrafficFlowRiskConcerning TrafficFlowRisk = "concerning"
+)
+
+// TrafficFlowAction is what the gateway did with a flow, as in
+// TrafficFlow.Action and TrafficFlowsRequest.Action.
+type TrafficFlowAction string
+
+const (
+	TrafficFlowAllowed TrafficFlowAction = "allowed"
+	TrafficFlowBlocked TrafficFlowAction = "blocked"
+)
+
+// TrafficFlowDirection is the direction of a flow relative to the site, as in
+// TrafficFlow.Direction and TrafficFlowsRequest.Direction.
+type TrafficFlowDirection string
+
+const (
+	TrafficFlowIncoming TrafficFlowDirection = "incoming"
+	TrafficFlowOutgoing TrafficFlowDirection = "outgoing"
+	// TrafficFlowInternal is traffic between the site's own networks.
+	TrafficFlowInternal TrafficFlowDirection = "internal"
+)
+
+// TrafficFlowProtocol is the IP protocol of a flow, as in
+// TrafficFlow.Protocol and TrafficFlowsRequest.Protocol.
+type TrafficFlowProtocol string
+
+const (
+	TrafficFlowTCP    TrafficFlowProtocol = "tcp"
+	TrafficFlowUDP    TrafficFlowProtocol = "udp"
+	TrafficFlowICMP   TrafficFlowProtocol = "icmp"
+	TrafficFlowICMPv6 TrafficFlowProtocol = "icmpv6"
+)
+
+// hasPorts reports whether flows of the protocol may have ports to filter on;
+// only ICMP flows have none.
+func (p TrafficFlowProtocol) hasPorts() bool {
+	return p != TrafficFlowICMP && p != TrafficFlowICMPv6
+}
+
 // TrafficFlowTarget represents the source or destination of a traffic flow.
 type TrafficFlowTarget struct {
 	ClientFingerprint *TrafficFlowClientFingerprint `json:"client_fingerprint,omitempty"`
@@ -114,7 +170,9 @@ type TrafficFlowPolicy struct {
 // TrafficFlowTrafficData represents the traffic statistics of a traffic flow.
 type TrafficFlowTrafficData struct {
 	BytesRx   int64 `json:"bytes_rx"`
+	BytesTx   int64 `json:"bytes_tx"`
 	PacketsRx int64 `json:"packets_rx"`
+	PacketsTx int64 `json:"packets_tx"`
 }
 
 // GetTrafficFlows fetches traffic flows using the provided request payload.
@@ -128,3 +186,87 @@ func (c *client) GetTrafficFlows(ctx context.Context, site string, req *TrafficF
 
 	return &respBody, nil
 }
+
+// defaultTrafficFlowsPageSize is the page size TrafficFlows().ListSeq requests
+// when the request sets none.
+const defaultTrafficFlowsPageSize = 100
+
+// NewTrafficFlowsRequest returns a request for the flows between from and to,
+// without filters.
+func NewTrafficFlowsRequest(from, to time.Time) *TrafficFlowsRequest {
+	return (&TrafficFlowsRequest{}).SetWindow(from, to)
+}
+
+// SetWindow sets the time window of the request, converting from and to to the
+// Unix milliseconds the controller expects, and returns the request. A zero
+// time leaves that bound unset.
+func (r *TrafficFlowsRequest) SetWindow(from, to time.Time) *TrafficFlowsRequest {
+	r.TimestampFrom, r.TimestampTo = 0, 0
+	if !from.IsZero() {
+		r.TimestampFrom = from.UnixMilli()
+	}
+	if !to.IsZero() {
+		r.TimestampTo = to.UnixMilli()
+	}
+	return r
+}
+
+// Window returns the time window of the request; an unset bound is the zero
+// time.
+func (r *TrafficFlowsRequest) Window() (from, to time.Time) {
+	if r.TimestampFrom != 0 {
+		from = time.UnixMilli(r.TimestampFrom)
+	}
+	if r.TimestampTo != 0 {
+		to = time.UnixMilli(r.TimestampTo)
+	}
+	return from, to
+}
+
+/*
+ListTrafficFlowsSeq implements TrafficFlows().ListSeq: it returns the flows
+matching req across all pages, fetching the next page as iteration reaches it;
+breaking out of the loop stops paging. An error ends the sequence.
+
+Paging starts at req.PageNumber with req.PageSize flows per page (100 when
+unset). A request without TimestampTo is pinned to the time iteration starts,
+so flows logged while paging do not shift the pages. req is not modified; a
+nil req lists every flow up to now.
+
+	for flow, err := range c.TrafficFlows().ListSeq(ctx, "default", unifi.NewTrafficFlowsRequest(from, to)) {
+		if err != nil {
+			return err
+		}
+		...
+	}
+*/
+func (c *client) ListTrafficFlowsSeq(ctx context.Context, site string, req *TrafficFlowsRequest) iter.Seq2[TrafficFlow, error] {
+	return func(yield func(TrafficFlow, error) bool) {
+		page := TrafficFlowsRequest{}
+		if req != nil {
+			page = *req
+		}
+		if page.PageSize <= 0 {
+			page.PageSize = defaultTrafficFlowsPageSize
+		}
+		if page.TimestampTo == 0 {
+			page.TimestampTo = time.Now().UnixMilli()
+		}
+		for {
+			resp, err := c.GetTrafficFlows(ctx, site, &page)
+			if err != nil {
+				yield(TrafficFlow{}, fmt.Errorf("failed fetching traffic flows page %d: %w", page.PageNumber, err))
+				return
+			}
+			for _, flow := range resp.Data {
+				if !yield(flow, nil) {
+					return
+				}
+			}
+			if !resp.HasNext || len(resp.Data) == 0 {
+				return
+			}
+			page.PageNumber++
+		}
+	}
+}
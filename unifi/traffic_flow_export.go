@@ -0,0 +1,223 @@
+package unifi
+
+import (
+	"cmp"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TrafficFlowColumns are the columns of a TrafficFlowRecord, in the order the
+// CSV writer emits them. They are stable: new columns are only ever appended.
+var TrafficFlowColumns = []string{
+	"time", "id", "action", "direction", "protocol", "service", "risk", "count",
+	"src_ip", "src_mac", "src_port", "src_host", "src_client", "src_zone", "src_network", "src_region",
+	"dst_ip", "dst_mac", "dst_port", "dst_host", "dst_client", "dst_zone", "dst_network", "dst_region", "dst_domains",
+	"policies", "bytes_rx", "bytes_tx", "packets_rx", "packets_tx",
+}
+
+// TrafficFlowRecord is a TrafficFlow flattened into the columns of
+// TrafficFlowColumns, one JSON field per column, for export to CSV, JSON
+// lines or a SIEM. Zones and networks are named, falling back to their ID.
+type TrafficFlowRecord struct {
+	Time      time.Time `json:"time"`
+	ID        string    `json:"id"`
+	Action    string    `json:"action"`
+	Direction string    `json:"direction"`
+	Protocol  string    `json:"protocol"`
+	Service   string    `json:"service"`
+	Risk      string    `json:"risk"`
+	Count     int       `json:"count"`
+
+	SrcIP      string `json:"src_ip"`
+	SrcMAC     string `json:"src_mac"`
+	SrcPort    int    `json:"src_port"`
+	SrcHost    string `json:"src_host"`
+	SrcClient  string `json:"src_client"`
+	SrcZone    string `json:"src_zone"`
+	SrcNetwork string `json:"src_network"`
+	SrcRegion  string `json:"src_region"`
+
+	DstIP      string   `json:"dst_ip"`
+	DstMAC     string   `json:"dst_mac"`
+	DstPort    int      `json:"dst_port"`
+	DstHost    string   `json:"dst_host"`
+	DstClient  string   `json:"dst_client"`
+	DstZone    string   `json:"dst_zone"`
+	DstNetwork string   `json:"dst_network"`
+	DstRegion  string   `json:"dst_region"`
+	DstDomains []string `json:"dst_domains"`
+
+	// Policies are the names (or IDs, for unnamed policies) of the policies
+	// that matched the flow.
+	Policies  []string `json:"policies"`
+	BytesRx   int64    `json:"bytes_rx"`
+	BytesTx   int64    `json:"bytes_tx"`
+	PacketsRx int64    `json:"packets_rx"`
+	PacketsTx int64    `json:"packets_tx"`
+}
+
+// Record flattens the flow into a TrafficFlowRecord.
+func (f TrafficFlow) Record() TrafficFlowRecord {
+	policies := make([]string, 0, len(f.Policies))
+	for _, p := range f.Policies {
+		policies = append(policies, cmp.Or(p.Name, p.ID))
+	}
+	domains := f.Destination.Domains
+	if domains == nil {
+		domains = []string{}
+	}
+	return TrafficFlowRecord{
+		Time:       time.UnixMilli(f.Time).UTC(),
+		ID:         f.ID,
+		Action:     f.Action,
+		Direction:  f.Direction,
+		Protocol:   f.Protocol,
+		Service:    f.Service,
+		Risk:       f.Risk,
+		Count:      f.Count,
+		SrcIP:      f.Source.IP,
+		SrcMAC:     f.Source.MAC,
+		SrcPort:    f.Source.Port,
+		SrcHost:    f.Source.HostName,
+		SrcClient:  f.Source.ClientName,
+		SrcZone:    cmp.Or(f.Source.ZoneName, f.Source.ZoneID),
+		SrcNetwork: cmp.Or(f.Source.NetworkName, f.Source.NetworkID),
+		SrcRegion:  f.Source.Region,
+		DstIP:      f.Destination.IP,
+		DstMAC:     f.Destination.MAC,
+		DstPort:    f.Destination.Port,
+		DstHost:    f.Destination.HostName,
+		DstClient:  f.Destination.ClientName,
+		DstZone:    cmp.Or(f.Destination.ZoneName, f.Destination.ZoneID),
+		DstNetwork: cmp.Or(f.Destination.NetworkName, f.Destination.NetworkID),
+		DstRegion:  f.Destination.Region,
+		DstDomains: domains,
+		Policies:   policies,
+		BytesRx:    f.TrafficData.BytesRx,
+		BytesTx:    f.TrafficData.BytesTx,
+		PacketsRx:  f.TrafficData.PacketsRx,
+		PacketsTx:  f.TrafficData.PacketsTx,
+	}
+}
+
+// Values returns the record's columns as strings, in TrafficFlowColumns order.
+// Lists are joined with ";", the time is RFC 3339 in UTC and a zero port is
+// empty.
+func (r TrafficFlowRecord) Values() []string {
+	port := func(p int) string {
+		if p == 0 {
+			return ""
+		}
+		return strconv.Itoa(p)
+	}
+	return []string{
+		r.Time.Format(time.RFC3339Nano), r.ID, r.Action, r.Direction, r.Protocol, r.Service, r.Risk, strconv.Itoa(r.Count),
+		r.SrcIP, r.SrcMAC, port(r.SrcPort), r.SrcHost, r.SrcClient, r.SrcZone, r.SrcNetwork, r.SrcRegion,
+		r.DstIP, r.DstMAC, port(r.DstPort), r.DstHost, r.DstClient, r.DstZone, r.DstNetwork, r.DstRegion, strings.Join(r.DstDomains, ";"),
+		strings.Join(r.Policies, ";"),
+		strconv.FormatInt(r.BytesRx, 10), strconv.FormatInt(r.BytesTx, 10),
+		strconv.FormatInt(r.PacketsRx, 10), strconv.FormatInt(r.PacketsTx, 10),
+	}
+}
+
+// TrafficFlowWriter writes flattened traffic flows in some format. Flush
+// writes out anything buffered; call it when done.
+type TrafficFlowWriter interface {
+	WriteFlow(f TrafficFlow) error
+	Flush() error
+}
+
+// TrafficFlowCSVWriter writes traffic flows as CSV, with a header row of
+// TrafficFlowColumns before the first flow. An export without flows is the
+// header alone.
+type TrafficFlowCSVWriter struct {
+	w           *csv.Writer
+	wroteHeader bool
+}
+
+// NewTrafficFlowCSVWriter returns a CSV writer to w.
+func NewTrafficFlowCSVWriter(w io.Writer) *TrafficFlowCSVWriter {
+	return &TrafficFlowCSVWriter{w: csv.NewWriter(w)}
+}
+
+// WriteFlow writes the flow as a CSV row, preceded by the header on the first
+// call.
+func (w *TrafficFlowCSVWriter) WriteFlow(f TrafficFlow) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	return w.w.Write(f.Record().Values())
+}
+
+func (w *TrafficFlowCSVWriter) writeHeader() error {
+	if w.wroteHeader {
+		return nil
+	}
+	w.wroteHeader = true
+	return w.w.Write(TrafficFlowColumns)
+}
+
+// Flush writes any buffered rows to the underlying writer, and the header if
+// no flow was written.
+func (w *TrafficFlowCSVWriter) Flush() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	w.w.Flush()
+	return w.w.Error()
+}
+
+// TrafficFlowJSONLWriter writes traffic flows as JSON lines, one
+// TrafficFlowRecord object per line.
+type TrafficFlowJSONLWriter struct {
+	enc *json.Encoder
+}
+
+// NewTrafficFlowJSONLWriter returns a JSON lines writer to w.
+func NewTrafficFlowJSONLWriter(w io.Writer) *TrafficFlowJSONLWriter {
+	return &TrafficFlowJSONLWriter{enc: json.NewEncoder(w)}
+}
+
+// WriteFlow writes the flow as one line of JSON.
+func (w *TrafficFlowJSONLWriter) WriteFlow(f TrafficFlow) error {
+	return w.enc.Encode(f.Record())
+}
+
+// Flush does nothing: each line is written as it is encoded.
+func (w *TrafficFlowJSONLWriter) Flush() error {
+	return nil
+}
+
+/*
+ExportTrafficFlows writes every flow of the sequence to w and flushes it,
+returning the number of flows written. Used with TrafficFlows().ListSeq it streams
+page by page, holding one page in memory:
+
+	n, err := unifi.ExportTrafficFlows(unifi.NewTrafficFlowCSVWriter(os.Stdout),
+		c.TrafficFlows().ListSeq(ctx, "default", req))
+
+It stops at the first error of the sequence or of w, after flushing what was
+written.
+*/
+func ExportTrafficFlows(w TrafficFlowWriter, flows iter.Seq2[TrafficFlow, error]) (int, error) {
+	n := 0
+	for f, err := range flows {
+		if err == nil {
+			err = w.WriteFlow(f)
+		}
+		if err != nil {
+			if ferr := w.Flush(); ferr != nil {
+				return n, fmt.Errorf("%w (flush: %w)", err, ferr)
+			}
+			return n, err
+		}
+		n++
+	}
+	return n, w.Flush()
+}
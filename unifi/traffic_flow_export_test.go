@@ -0,0 +1,161 @@
+package unifi //nolint: testpackage
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"iter"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func exportTestFlow() TrafficFlow {
+	return TrafficFlow{
+		ID:        "f1",
+		Time:      1700000000123,
+		Action:    string(TrafficFlowBlocked),
+		Direction: string(TrafficFlowOutgoing),
+		Protocol:  string(TrafficFlowTCP),
+		Service:   "HTTPS",
+		Risk:      string(TrafficFlowRiskHigh),
+		Count:     3,
+		Source: TrafficFlowTarget{
+			IP: "10.0.0.1", MAC: "aa:bb:cc:dd:ee:01", Port: 51000, ClientName: "laptop",
+			ZoneName: "Internal", NetworkID: "net1",
+		},
+		Destination: TrafficFlowTarget{
+			IP: "1.1.1.1", Port: 443, ZoneID: "zone2", Region: "AU",
+			Domains: []string{"one.one.one.one", "cloudflare-dns.com"},
+		},
+		Policies:    []TrafficFlowPolicy{{ID: "p1", Name: "Block ads"}, {ID: "p2"}},
+		TrafficData: TrafficFlowTrafficData{BytesRx: 100, BytesTx: 20, PacketsRx: 4, PacketsTx: 2},
+	}
+}
+
+func flowSeq(flows []TrafficFlow, err error) iter.Seq2[TrafficFlow, error] {
+	return func(yield func(TrafficFlow, error) bool) {
+		for _, f := range flows {
+			if !yield(f, nil) {
+				return
+			}
+		}
+		if err != nil {
+			yield(TrafficFlow{}, err)
+		}
+	}
+}
+
+func TestTrafficFlowRecord(t *testing.T) {
+	t.Parallel()
+	r := exportTestFlow().Record()
+	assert.Equal(t, "2023-11-14T22:13:20.123Z", r.Values()[0])
+	assert.Equal(t, "Internal", r.SrcZone)
+	assert.Equal(t, "net1", r.SrcNetwork, "unnamed networks fall back to the ID")
+	assert.Equal(t, "zone2", r.DstZone)
+	assert.Equal(t, []string{"Block ads", "p2"}, r.Policies)
+	require.Len(t, r.Values(), len(TrafficFlowColumns))
+
+	// Every column is a JSON field of the record, in order.
+	b, err := json.Marshal(r)
+	require.NoError(t, err)
+	dec := json.NewDecoder(bytes.NewReader(b))
+	var keys []string
+	_, _ = dec.Token()
+	for dec.More() {
+		tok, err := dec.Token()
+		require.NoError(t, err)
+		keys = append(keys, tok.(string))
+		var v json.RawMessage
+		require.NoError(t, dec.Decode(&v))
+	}
+	assert.Equal(t, TrafficFlowColumns, keys)
+
+	empty := TrafficFlow{}.Record()
+	assert.Equal(t, "", empty.Values()[10], "a zero port is empty")
+	assert.NotNil(t, empty.Policies)
+	assert.NotNil(t, empty.DstDomains)
+}
+
+func TestExportTrafficFlowsCSV(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	n, err := ExportTrafficFlows(NewTrafficFlowCSVWriter(&buf), flowSeq([]TrafficFlow{exportTestFlow(), {ID: "f2"}}, nil))
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	assert.Equal(t, TrafficFlowColumns, rows[0])
+	row := map[string]string{}
+	for i, col := range TrafficFlowColumns {
+		row[col] = rows[1][i]
+	}
+	assert.Equal(t, "10.0.0.1", row["src_ip"])
+	assert.Equal(t, "443", row["dst_port"])
+	assert.Equal(t, "one.one.one.one;cloudflare-dns.com", row["dst_domains"])
+	assert.Equal(t, "Block ads;p2", row["policies"])
+	assert.Equal(t, "20", row["bytes_tx"])
+	assert.Equal(t, "f2", rows[2][1])
+}
+
+func TestExportTrafficFlowsCSVEmpty(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	n, err := ExportTrafficFlows(NewTrafficFlowCSVWriter(&buf), flowSeq(nil, nil))
+	require.NoError(t, err)
+	assert.Zero(t, n)
+	rows, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{TrafficFlowColumns}, rows, "an empty export is the header alone")
+
+	buf.Reset()
+	w := NewTrafficFlowCSVWriter(&buf)
+	require.NoError(t, w.Flush())
+	require.NoError(t, w.Flush())
+	require.NoError(t, w.WriteFlow(TrafficFlow{ID: "f1"}))
+	require.NoError(t, w.Flush())
+	rows, err = csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	assert.Len(t, rows, 2, "the header is written once")
+}
+
+func TestExportTrafficFlowsJSONL(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	boom := errors.New("boom")
+	n, err := ExportTrafficFlows(NewTrafficFlowJSONLWriter(&buf), flowSeq([]TrafficFlow{exportTestFlow()}, boom))
+	require.ErrorIs(t, err, boom)
+	assert.Equal(t, 1, n)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 1, "flows before the error are written")
+	var r TrafficFlowRecord
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &r))
+	assert.Equal(t, exportTestFlow().Record(), r)
+}
+
+func TestExportTrafficFlowsStreamsPages(t *testing.T) {
+	t.Parallel()
+	cs, requests := trafficFlowsServer(t, 5)
+	req := &TrafficFlowsRequest{PageSize: 2}
+
+	var buf bytes.Buffer
+	n, err := ExportTrafficFlows(NewTrafficFlowJSONLWriter(&buf), cs.client().ListTrafficFlowsSeq(context.Background(), "default", req))
+	require.NoError(t, err)
+	assert.Equal(t, 5, n)
+	assert.Len(t, *requests, 3)
+	assert.Equal(t, 5, strings.Count(buf.String(), "\n"))
+
+	cs = newControllerServer(t, route{apiV2("site/default/traffic-flows"), func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}})
+	_, err = ExportTrafficFlows(NewTrafficFlowCSVWriter(&buf), cs.client().ListTrafficFlowsSeq(context.Background(), "default", nil))
+	require.ErrorIs(t, err, ErrForbidden)
+}
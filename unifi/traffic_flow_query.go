@@ -0,0 +1,279 @@
+package unifi
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// ErrInvalidTrafficFlowsQuery is returned by TrafficFlowsQuery.Build for a
+// combination of filters or a time window the controller would reject or
+// silently ignore.
+var ErrInvalidTrafficFlowsQuery = errors.New("invalid traffic flows query")
+
+// TrafficFlowPolicyType is the kind of policy that matched a flow, for
+// TrafficFlowsRequest.PolicyType.
+type TrafficFlowPolicyType string
+
+const (
+	TrafficFlowPolicyFirewall      TrafficFlowPolicyType = "FIREWALL"
+	TrafficFlowPolicyTrafficRule   TrafficFlowPolicyType = "TRAFFIC_RULE"
+	TrafficFlowPolicyTrafficRoute  TrafficFlowPolicyType = "TRAFFIC_ROUTE"
+	TrafficFlowPolicyContentFilter TrafficFlowPolicyType = "CONTENT_FILTERING"
+	TrafficFlowPolicyAdBlocking    TrafficFlowPolicyType = "AD_BLOCKING"
+	TrafficFlowPolicyRegionBlock   TrafficFlowPolicyType = "REGION_BLOCKING"
+	TrafficFlowPolicyThreat        TrafficFlowPolicyType = "THREAT_MANAGEMENT"
+	TrafficFlowPolicyHoneypot      TrafficFlowPolicyType = "HONEYPOT"
+)
+
+// maxTrafficFlowsPageSize is the largest page TrafficFlowsQuery accepts; the
+// controller answers larger pages slowly, if at all.
+const maxTrafficFlowsPageSize = 1000
+
+/*
+TrafficFlowsQuery builds a TrafficFlowsRequest from typed filter values. Each
+method adds to its filter and returns the query; Build validates the whole query
+and returns the request, ready for GetTrafficFlows or TrafficFlows().ListSeq:
+
+	req, err := unifi.NewTrafficFlowsQuery().
+		Last(24 * time.Hour).
+		Actions(unifi.TrafficFlowBlocked).
+		Risks(unifi.TrafficFlowRiskHigh, unifi.TrafficFlowRiskConcerning).
+		Build()
+
+Filters are combined with AND, the values of one filter with OR. Values the
+typed constants do not cover, e.g. from a newer controller, can be converted
+(TrafficFlowRisk("...")) and are sent as they are. Filters without a typed
+method can be set on the built request.
+*/
+type TrafficFlowsQuery struct {
+	req         TrafficFlowsRequest
+	from, to    time.Time
+	last        time.Duration
+	useLast     bool
+	risks       []TrafficFlowRisk
+	actions     []TrafficFlowAction
+	directions  []TrafficFlowDirection
+	protocols   []TrafficFlowProtocol
+	policyTypes []TrafficFlowPolicyType
+}
+
+// NewTrafficFlowsQuery returns a query for every flow, in pages of 100.
+func NewTrafficFlowsQuery() *TrafficFlowsQuery {
+	return &TrafficFlowsQuery{req: TrafficFlowsRequest{PageSize: defaultTrafficFlowsPageSize}}
+}
+
+// Between restricts the query to flows between from and to. A zero to means
+// up to the time of the request.
+func (q *TrafficFlowsQuery) Between(from, to time.Time) *TrafficFlowsQuery {
+	q.from, q.to, q.last, q.useLast = from, to, 0, false
+	return q
+}
+
+// Last restricts the query to flows of the last d, counted from Build.
+func (q *TrafficFlowsQuery) Last(d time.Duration) *TrafficFlowsQuery {
+	q.from, q.to, q.last, q.useLast = time.Time{}, time.Time{}, d, true
+	return q
+}
+
+// Risks restricts the query to flows of the given risk levels.
+func (q *TrafficFlowsQuery) Risks(risks ...TrafficFlowRisk) *TrafficFlowsQuery {
+	q.risks = append(q.risks, risks...)
+	return q
+}
+
+// Actions restricts the query to flows the gateway allowed or blocked.
+func (q *TrafficFlowsQuery) Actions(actions ...TrafficFlowAction) *TrafficFlowsQuery {
+	q.actions = append(q.actions, actions...)
+	return q
+}
+
+// Directions restricts the query to flows in the given directions.
+func (q *TrafficFlowsQuery) Directions(directions ...TrafficFlowDirection) *TrafficFlowsQuery {
+	q.directions = append(q.directions, directions...)
+	return q
+}
+
+// Protocols restricts the query to flows of the given IP protocols.
+func (q *TrafficFlowsQuery) Protocols(protocols ...TrafficFlowProtocol) *TrafficFlowsQuery {
+	q.protocols = append(q.protocols, protocols...)
+	return q
+}
+
+// PolicyTypes restricts the query to flows matched by the given kinds of
+// policy.
+func (q *TrafficFlowsQuery) PolicyTypes(types ...TrafficFlowPolicyType) *TrafficFlowsQuery {
+	q.policyTypes = append(q.policyTypes, types...)
+	return q
+}
+
+// Policies restricts the query to flows matched by the policies with the given
+// IDs.
+func (q *TrafficFlowsQuery) Policies(ids ...string) *TrafficFlowsQuery {
+	q.req.Policy = append(q.req.Policy, ids...)
+	return q
+}
+
+// Services restricts the query to flows of the given services (applications).
+func (q *TrafficFlowsQuery) Services(services ...string) *TrafficFlowsQuery {
+	q.req.Service = append(q.req.Service, services...)
+	return q
+}
+
+// SourceIPs restricts the query to flows from the given addresses.
+func (q *TrafficFlowsQuery) SourceIPs(ips ...string) *TrafficFlowsQuery {
+	q.req.SourceIP = append(q.req.SourceIP, ips...)
+	return q
+}
+
+// DestinationIPs restricts the query to flows to the given addresses.
+func (q *TrafficFlowsQuery) DestinationIPs(ips ...string) *TrafficFlowsQuery {
+	q.req.DestinationIP = append(q.req.DestinationIP, ips...)
+	return q
+}
+
+// SourceMACs restricts the query to flows from the clients with the given MAC
+// addresses.
+func (q *TrafficFlowsQuery) SourceMACs(macs ...string) *TrafficFlowsQuery {
+	q.req.SourceMAC = append(q.req.SourceMAC, macs...)
+	return q
+}
+
+// DestinationMACs restricts the query to flows to the clients with the given
+// MAC addresses.
+func (q *TrafficFlowsQuery) DestinationMACs(macs ...string) *TrafficFlowsQuery {
+	q.req.DestinationMAC = append(q.req.DestinationMAC, macs...)
+	return q
+}
+
+// DestinationPorts restricts the query to flows to the given ports. It requires
+// a port protocol: a query for ICMP flows only is rejected.
+func (q *TrafficFlowsQuery) DestinationPorts(ports ...int) *TrafficFlowsQuery {
+	q.req.DestinationPort = append(q.req.DestinationPort, ports...)
+	return q
+}
+
+// SourceNetworks restricts the query to flows from the networks with the given
+// IDs.
+func (q *TrafficFlowsQuery) SourceNetworks(ids ...string) *TrafficFlowsQuery {
+	q.req.SourceNetworkID = append(q.req.SourceNetworkID, ids...)
+	return q
+}
+
+// DestinationNetworks restricts the query to flows to the networks with the
+// given IDs.
+func (q *TrafficFlowsQuery) DestinationNetworks(ids ...string) *TrafficFlowsQuery {
+	q.req.DestinationNetworkID = append(q.req.DestinationNetworkID, ids...)
+	return q
+}
+
+// SourceZones restricts the query to flows from the firewall zones with the
+// given IDs.
+func (q *TrafficFlowsQuery) SourceZones(ids ...string) *TrafficFlowsQuery {
+	q.req.SourceZoneID = append(q.req.SourceZoneID, ids...)
+	return q
+}
+
+// DestinationZones restricts the query to flows to the firewall zones with the
+// given IDs.
+func (q *TrafficFlowsQuery) DestinationZones(ids ...string) *TrafficFlowsQuery {
+	q.req.DestinationZoneID = append(q.req.DestinationZoneID, ids...)
+	return q
+}
+
+// Search restricts the query to flows matching the controller's free-text
+// search.
+func (q *TrafficFlowsQuery) Search(text string) *TrafficFlowsQuery {
+	q.req.SearchText = text
+	return q
+}
+
+// PageSize sets the number of flows per page, between 1 and 1000.
+func (q *TrafficFlowsQuery) PageSize(n int) *TrafficFlowsQuery {
+	q.req.PageSize = n
+	return q
+}
+
+/*
+Build validates the query and returns a new request for it. It fails with
+ErrInvalidTrafficFlowsQuery when:
+  - the window ends before it starts, or starts in the future,
+  - Last is not positive,
+  - destination ports are filtered while only portless protocols (ICMP) are,
+  - the page size is outside 1 to 1000.
+
+All problems are reported together.
+*/
+func (q *TrafficFlowsQuery) Build() (*TrafficFlowsRequest, error) {
+	var errs []error
+	invalid := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%w: "+format, append([]any{ErrInvalidTrafficFlowsQuery}, args...)...))
+	}
+
+	req := q.req
+	now := time.Now()
+	switch {
+	case q.useLast && q.last <= 0:
+		invalid("window length %s is not positive", q.last)
+	case q.useLast:
+		req.SetWindow(now.Add(-q.last), now)
+	default:
+		if !q.from.IsZero() && !q.to.IsZero() && !q.from.Before(q.to) {
+			invalid("window start %s is not before its end %s", q.from.Format(time.RFC3339), q.to.Format(time.RFC3339))
+		}
+		if q.from.After(now) {
+			invalid("window start %s is in the future", q.from.Format(time.RFC3339))
+		}
+		req.SetWindow(q.from, q.to)
+	}
+
+	req.Risk = trafficFlowValues(q.risks)
+	req.Action = trafficFlowValues(q.actions)
+	req.Direction = trafficFlowValues(q.directions)
+	req.Protocol = trafficFlowValues(q.protocols)
+	req.PolicyType = trafficFlowValues(q.policyTypes)
+
+	if len(req.DestinationPort) > 0 && len(q.protocols) > 0 && !slices.ContainsFunc(q.protocols, TrafficFlowProtocol.hasPorts) {
+		invalid("destination ports filtered with portless protocols %v", q.protocols)
+	}
+	for _, p := range req.DestinationPort {
+		if p < 1 || p > 65535 {
+			invalid("destination port %d out of range", p)
+		}
+	}
+	if req.PageSize < 1 || req.PageSize > maxTrafficFlowsPageSize {
+		invalid("page size %d outside 1 to %d", req.PageSize, maxTrafficFlowsPageSize)
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	req.Policy = slices.Clone(req.Policy)
+	req.Service = slices.Clone(req.Service)
+	req.SourceIP = slices.Clone(req.SourceIP)
+	req.DestinationIP = slices.Clone(req.DestinationIP)
+	req.SourceMAC = slices.Clone(req.SourceMAC)
+	req.DestinationMAC = slices.Clone(req.DestinationMAC)
+	req.DestinationPort = slices.Clone(req.DestinationPort)
+	req.SourceNetworkID = slices.Clone(req.SourceNetworkID)
+	req.DestinationNetworkID = slices.Clone(req.DestinationNetworkID)
+	req.SourceZoneID = slices.Clone(req.SourceZoneID)
+	req.DestinationZoneID = slices.Clone(req.DestinationZoneID)
+	return &req, nil
+}
+
+// trafficFlowValues converts typed filter values to the request's strings,
+// dropping duplicates.
+func trafficFlowValues[T ~string](values []T) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		if !slices.Contains(out, string(v)) {
+			out = append(out, string(v))
+		}
+	}
+	return out
+}
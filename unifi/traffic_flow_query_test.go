@@ -0,0 +1,114 @@
+package unifi //nolint: testpackage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrafficFlowsQueryBuild(t *testing.T) {
+	t.Parallel()
+	from := time.Now().Add(-2 * time.Hour)
+	to := time.Now().Add(-time.Hour)
+	q := NewTrafficFlowsQuery().
+		Between(from, to).
+		Actions(TrafficFlowBlocked, TrafficFlowBlocked).
+		Risks(TrafficFlowRiskHigh, TrafficFlowRiskConcerning).
+		Directions(TrafficFlowOutgoing).
+		Protocols(TrafficFlowTCP, TrafficFlowICMP).
+		PolicyTypes(TrafficFlowPolicyFirewall).
+		DestinationPorts(443).
+		SourceIPs("10.0.0.1").
+		Search("dns")
+
+	req, err := q.Build()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"blocked"}, req.Action, "duplicates are dropped")
+	assert.Equal(t, []string{"high", "concerning"}, req.Risk)
+	assert.Equal(t, []string{"outgoing"}, req.Direction)
+	assert.Equal(t, []string{"tcp", "icmp"}, req.Protocol)
+	assert.Equal(t, []string{"FIREWALL"}, req.PolicyType)
+	assert.Equal(t, []int{443}, req.DestinationPort)
+	assert.Equal(t, []string{"10.0.0.1"}, req.SourceIP)
+	assert.Equal(t, "dns", req.SearchText)
+	assert.Equal(t, from.UnixMilli(), req.TimestampFrom)
+	assert.Equal(t, to.UnixMilli(), req.TimestampTo)
+	assert.Equal(t, defaultTrafficFlowsPageSize, req.PageSize)
+
+	q.SourceIPs("10.0.0.2")
+	assert.Equal(t, []string{"10.0.0.1"}, req.SourceIP, "built requests do not share the query's slices")
+
+	last, err := NewTrafficFlowsQuery().Last(time.Hour).PageSize(500).Build()
+	require.NoError(t, err)
+	gotFrom, gotTo := last.Window()
+	assert.InDelta(t, time.Hour.Milliseconds(), gotTo.Sub(gotFrom).Milliseconds(), 1)
+	assert.WithinDuration(t, time.Now(), gotTo, time.Second)
+	assert.Equal(t, 500, last.PageSize)
+	assert.Nil(t, last.Action, "unset filters stay nil")
+}
+
+func TestTrafficFlowsQueryInvalid(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	tests := map[string]*TrafficFlowsQuery{
+		"reversed window":      NewTrafficFlowsQuery().Between(now, now.Add(-time.Hour)),
+		"empty window":         NewTrafficFlowsQuery().Between(now.Add(-time.Hour), now.Add(-time.Hour)),
+		"future window":        NewTrafficFlowsQuery().Between(now.Add(time.Hour), time.Time{}),
+		"non-positive last":    NewTrafficFlowsQuery().Last(0),
+		"ports without ports":  NewTrafficFlowsQuery().Protocols(TrafficFlowICMP, TrafficFlowICMPv6).DestinationPorts(53),
+		"port out of range":    NewTrafficFlowsQuery().DestinationPorts(70000),
+		"zero page size":       NewTrafficFlowsQuery().PageSize(0),
+		"oversized page size":  NewTrafficFlowsQuery().PageSize(maxTrafficFlowsPageSize + 1),
+		"several problems too": NewTrafficFlowsQuery().Last(-time.Hour).PageSize(-1),
+	}
+	for name, q := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			req, err := q.Build()
+			require.ErrorIs(t, err, ErrInvalidTrafficFlowsQuery)
+			assert.Nil(t, req)
+		})
+	}
+}
+
+func TestTrafficFlowsQueryUnknownValues(t *testing.T) {
+	t.Parallel()
+	req, err := NewTrafficFlowsQuery().
+		Actions(TrafficFlowAction("dropped")).
+		Risks(TrafficFlowRisk("critical")).
+		Directions(TrafficFlowDirection("transit")).
+		Protocols(TrafficFlowProtocol("sctp")).
+		PolicyTypes(TrafficFlowPolicyType("QOS_RULE")).
+		DestinationPorts(9899).
+		Build()
+	require.NoError(t, err, "values newer controllers know are sent as they are")
+	assert.Equal(t, []string{"dropped"}, req.Action)
+	assert.Equal(t, []string{"critical"}, req.Risk)
+	assert.Equal(t, []string{"transit"}, req.Direction)
+	assert.Equal(t, []string{"sctp"}, req.Protocol, "an unknown protocol may have ports")
+	assert.Equal(t, []string{"QOS_RULE"}, req.PolicyType)
+}
+
+func TestTrafficFlowsQueryWithGetTrafficFlows(t *testing.T) {
+	t.Parallel()
+	cs, requests := trafficFlowsServer(t, 3)
+	req, err := NewTrafficFlowsQuery().Last(time.Hour).Actions(TrafficFlowAllowed).PageSize(2).Build()
+	require.NoError(t, err)
+
+	flows, err := CollectTrafficFlows(cs.client().ListTrafficFlowsSeq(context.Background(), "default", req))
+	require.NoError(t, err)
+	assert.Len(t, flows, 3)
+	require.Len(t, *requests, 2)
+	assert.Equal(t, []string{"allowed"}, (*requests)[0].Action)
+	assert.Equal(t, req.TimestampTo, (*requests)[1].TimestampTo)
+}
+
+func TestTrafficFlowIsBlocked(t *testing.T) {
+	t.Parallel()
+	assert.True(t, TrafficFlow{Action: "blocked"}.IsBlocked())
+	assert.True(t, TrafficFlow{Action: "BLOCKED"}.IsBlocked())
+	assert.False(t, TrafficFlow{Action: "allowed"}.IsBlocked())
+}
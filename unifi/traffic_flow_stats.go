@@ -0,0 +1,175 @@
+package unifi
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+)
+
+// TrafficFlowStat aggregates the flows sharing a key, e.g. a source IP or a
+// service.
+type TrafficFlowStat struct {
+	Key string
+	// Label names the key for display when it is an address: the client or
+	// host name of the talker. It is empty for other aggregations.
+	Label string
+	// Flows is the number of flow records, Connections the sum of their
+	// TrafficFlow.Count.
+	Flows       int
+	Connections int
+	BytesRx     int64
+	BytesTx     int64
+	PacketsRx   int64
+	PacketsTx   int64
+}
+
+// Bytes returns the bytes received and sent.
+func (s TrafficFlowStat) Bytes() int64 {
+	return s.BytesRx + s.BytesTx
+}
+
+func (s *TrafficFlowStat) add(f TrafficFlow) {
+	s.Flows++
+	s.Connections += f.Count
+	s.BytesRx += f.TrafficData.BytesRx
+	s.BytesTx += f.TrafficData.BytesTx
+	s.PacketsRx += f.TrafficData.PacketsRx
+	s.PacketsTx += f.TrafficData.PacketsTx
+}
+
+// CollectTrafficFlows drains a flow sequence, such as TrafficFlows().ListSeq, into
+// a slice, stopping at the first error.
+func CollectTrafficFlows(flows iter.Seq2[TrafficFlow, error]) ([]TrafficFlow, error) {
+	var out []TrafficFlow
+	for f, err := range flows {
+		if err != nil {
+			return out, err
+		}
+		out = append(out, f)
+	}
+	return out, nil
+}
+
+// AggregateTrafficFlows groups flows by key, skipping flows whose key is
+// empty. The stats are sorted by bytes, largest first, then by key.
+func AggregateTrafficFlows(flows []TrafficFlow, key func(TrafficFlow) string) []TrafficFlowStat {
+	return aggregateTrafficFlows(flows, func(f TrafficFlow) []string { return []string{key(f)} }, nil)
+}
+
+// aggregateTrafficFlows groups flows under each of their keys and labels each
+// stat with the first non-empty label of its flows.
+func aggregateTrafficFlows(flows []TrafficFlow, keys func(TrafficFlow) []string, label func(TrafficFlow) string) []TrafficFlowStat {
+	byKey := map[string]*TrafficFlowStat{}
+	for _, f := range flows {
+		for _, k := range keys(f) {
+			if k == "" {
+				continue
+			}
+			s, ok := byKey[k]
+			if !ok {
+				s = &TrafficFlowStat{Key: k}
+				byKey[k] = s
+			}
+			if s.Label == "" && label != nil {
+				s.Label = label(f)
+			}
+			s.add(f)
+		}
+	}
+	stats := make([]TrafficFlowStat, 0, len(byKey))
+	for _, s := range byKey {
+		stats = append(stats, *s)
+	}
+	sortTrafficFlowStats(stats)
+	return stats
+}
+
+func sortTrafficFlowStats(stats []TrafficFlowStat) {
+	slices.SortFunc(stats, func(a, b TrafficFlowStat) int {
+		return cmp.Or(cmp.Compare(b.Bytes(), a.Bytes()), cmp.Compare(a.Key, b.Key))
+	})
+}
+
+// TrafficFlowSide selects the end of a flow to aggregate on.
+type TrafficFlowSide int
+
+const (
+	TrafficFlowSource TrafficFlowSide = iota
+	TrafficFlowDestination
+)
+
+func (side TrafficFlowSide) target(f TrafficFlow) TrafficFlowTarget {
+	if side == TrafficFlowDestination {
+		return f.Destination
+	}
+	return f.Source
+}
+
+// TopTalkers returns the n addresses that moved the most bytes on the given
+// side of the flows, or all of them when n <= 0. Each stat is keyed by IP and
+// labeled with the client or host name, or the first domain, of the address.
+func TopTalkers(flows []TrafficFlow, side TrafficFlowSide, n int) []TrafficFlowStat {
+	stats := aggregateTrafficFlows(flows,
+		func(f TrafficFlow) []string { return []string{side.target(f).IP} },
+		func(f TrafficFlow) string {
+			t := side.target(f)
+			if len(t.Domains) > 0 {
+				return cmp.Or(t.ClientName, t.HostName, t.Domains[0])
+			}
+			return cmp.Or(t.ClientName, t.HostName)
+		})
+	if n > 0 && len(stats) > n {
+		stats = stats[:n]
+	}
+	return stats
+}
+
+// TrafficBytesByService returns the traffic of each service (application) the
+// controller identified in the flows.
+func TrafficBytesByService(flows []TrafficFlow) []TrafficFlowStat {
+	return AggregateTrafficFlows(flows, func(f TrafficFlow) string { return f.Service })
+}
+
+// BlockedTrafficByPolicy summarizes the blocked flows by the policy that
+// matched them, keyed by policy name (or ID, for an unnamed policy). A flow
+// matched by several policies counts toward each.
+func BlockedTrafficByPolicy(flows []TrafficFlow) []TrafficFlowStat {
+	return aggregateTrafficFlows(flows, func(f TrafficFlow) []string {
+		if !f.IsBlocked() {
+			return nil
+		}
+		keys := make([]string, 0, len(f.Policies))
+		for _, p := range f.Policies {
+			keys = append(keys, cmp.Or(p.Name, p.ID))
+		}
+		return keys
+	}, nil)
+}
+
+// TrafficZoneMatrix is the traffic between firewall zones, indexed by source
+// then destination zone name.
+type TrafficZoneMatrix map[string]map[string]TrafficFlowStat
+
+// TrafficByZone returns the traffic between each pair of firewall zones, by
+// zone name (or ID, for an unnamed zone). Flows without zone information on
+// either side are skipped.
+func TrafficByZone(flows []TrafficFlow) TrafficZoneMatrix {
+	matrix := TrafficZoneMatrix{}
+	for _, f := range flows {
+		src := cmp.Or(f.Source.ZoneName, f.Source.ZoneID)
+		dst := cmp.Or(f.Destination.ZoneName, f.Destination.ZoneID)
+		if src == "" || dst == "" {
+			continue
+		}
+		row, ok := matrix[src]
+		if !ok {
+			row = map[string]TrafficFlowStat{}
+			matrix[src] = row
+		}
+		s := row[dst]
+		s.Key = dst
+		s.add(f)
+		row[dst] = s
+	}
+	return matrix
+}
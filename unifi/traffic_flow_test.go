@@ -0,0 +1,159 @@
+package unifi //nolint: testpackage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// trafficFlowsServer serves total flows in pages of the requested size,
+// recording the requests.
+func trafficFlowsServer(t *testing.T, total int) (*controllerServer, *[]TrafficFlowsRequest) {
+	t.Helper()
+	var requests []TrafficFlowsRequest
+	cs := newControllerServer(t, route{apiV2("site/default/traffic-flows"), func(w http.ResponseWriter, r *http.Request) {
+		var req TrafficFlowsRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		requests = append(requests, req)
+		resp := TrafficFlowsResponse{PageNumber: req.PageNumber}
+		for i := req.PageNumber * req.PageSize; i < total && i < (req.PageNumber+1)*req.PageSize; i++ {
+			resp.Data = append(resp.Data, TrafficFlow{ID: fmt.Sprintf("f%d", i)})
+		}
+		resp.HasNext = (req.PageNumber+1)*req.PageSize < total
+		_ = json.NewEncoder(w).Encode(resp)
+	}})
+	return cs, &requests
+}
+
+func TestListTrafficFlowsSeq(t *testing.T) {
+	t.Parallel()
+	cs, requests := trafficFlowsServer(t, 5)
+	c := cs.client()
+	from := time.UnixMilli(1700000000000)
+	req := NewTrafficFlowsRequest(from, time.Time{})
+	req.PageSize = 2
+	req.Action = []string{"blocked"}
+
+	flows, err := CollectTrafficFlows(c.ListTrafficFlowsSeq(context.Background(), "default", req))
+	require.NoError(t, err)
+	require.Len(t, flows, 5)
+	assert.Equal(t, "f4", flows[4].ID)
+
+	require.Len(t, *requests, 3)
+	for i, r := range *requests {
+		assert.Equal(t, i, r.PageNumber)
+		assert.Equal(t, []string{"blocked"}, r.Action)
+		assert.Equal(t, from.UnixMilli(), r.TimestampFrom)
+		assert.Equal(t, (*requests)[0].TimestampTo, r.TimestampTo, "the window end is pinned")
+	}
+	assert.NotZero(t, (*requests)[0].TimestampTo)
+	assert.Zero(t, req.PageNumber, "the request is not modified")
+	assert.Zero(t, req.TimestampTo)
+}
+
+func TestListTrafficFlowsSeqStopsEarly(t *testing.T) {
+	t.Parallel()
+	cs, requests := trafficFlowsServer(t, 500)
+	c := cs.client()
+
+	n := 0
+	for _, err := range c.ListTrafficFlowsSeq(context.Background(), "default", nil) {
+		require.NoError(t, err)
+		if n++; n == 150 {
+			break
+		}
+	}
+	require.Len(t, *requests, 2)
+	assert.Equal(t, defaultTrafficFlowsPageSize, (*requests)[0].PageSize)
+}
+
+func TestListTrafficFlowsSeqError(t *testing.T) {
+	t.Parallel()
+	cs := newControllerServer(t, route{apiV2("site/default/traffic-flows"), func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}})
+
+	_, err := CollectTrafficFlows(cs.client().ListTrafficFlowsSeq(context.Background(), "default", nil))
+	require.ErrorIs(t, err, ErrForbidden)
+}
+
+func TestTrafficFlowsAreReads(t *testing.T) {
+	t.Parallel()
+	cs, _ := trafficFlowsServer(t, 1)
+	c := cs.clientWith(func(cfg *ClientConfig) { cfg.ReadOnly = true })
+
+	_, err := c.GetTrafficFlows(context.Background(), "default", &TrafficFlowsRequest{PageSize: 10})
+	require.NoError(t, err, "a traffic-flows query is allowed on a read-only client")
+}
+
+func TestTrafficFlowsRequestWindow(t *testing.T) {
+	t.Parallel()
+	from, to := time.UnixMilli(1700000000000), time.UnixMilli(1700003600000)
+	req := NewTrafficFlowsRequest(from, to)
+	assert.Equal(t, int64(1700000000000), req.TimestampFrom)
+	gotFrom, gotTo := req.Window()
+	assert.True(t, gotFrom.Equal(from))
+	assert.True(t, gotTo.Equal(to))
+
+	req.SetWindow(time.Time{}, to)
+	gotFrom, _ = req.Window()
+	assert.Zero(t, req.TimestampFrom)
+	assert.True(t, gotFrom.IsZero())
+}
+
+func TestTrafficFlowAggregations(t *testing.T) {
+	t.Parallel()
+	flow := func(src, dst, service, action string, rx int64, policies ...string) TrafficFlow {
+		f := TrafficFlow{
+			Action:      action,
+			Count:       1,
+			Service:     service,
+			Source:      TrafficFlowTarget{IP: src, ClientName: "client-" + src, ZoneName: "Internal"},
+			Destination: TrafficFlowTarget{IP: dst, ZoneName: "External", Domains: []string{"example.com"}},
+			TrafficData: TrafficFlowTrafficData{BytesRx: rx, BytesTx: 1},
+		}
+		for _, p := range policies {
+			f.Policies = append(f.Policies, TrafficFlowPolicy{Name: p})
+		}
+		return f
+	}
+	flows := []TrafficFlow{
+		flow("10.0.0.1", "1.1.1.1", "DNS", "allowed", 100),
+		flow("10.0.0.1", "8.8.8.8", "HTTPS", "allowed", 1000),
+		flow("10.0.0.2", "1.1.1.1", "DNS", "blocked", 10, "Block ads"),
+		flow("10.0.0.3", "9.9.9.9", "", "BLOCKED", 5, "Block ads", "Block IoT"),
+	}
+
+	talkers := TopTalkers(flows, TrafficFlowSource, 2)
+	require.Len(t, talkers, 2)
+	assert.Equal(t, TrafficFlowStat{Key: "10.0.0.1", Label: "client-10.0.0.1", Flows: 2, Connections: 2, BytesRx: 1100, BytesTx: 2}, talkers[0])
+	assert.Equal(t, "10.0.0.2", talkers[1].Key)
+
+	destinations := TopTalkers(flows, TrafficFlowDestination, 0)
+	require.Len(t, destinations, 3)
+	assert.Equal(t, "8.8.8.8", destinations[0].Key)
+	assert.Equal(t, "example.com", destinations[0].Label)
+
+	services := TrafficBytesByService(flows)
+	require.Len(t, services, 2, "flows without a service are skipped")
+	assert.Equal(t, "HTTPS", services[0].Key)
+	assert.Equal(t, int64(112), services[1].Bytes())
+
+	blocked := BlockedTrafficByPolicy(flows)
+	require.Len(t, blocked, 2)
+	assert.Equal(t, "Block ads", blocked[0].Key)
+	assert.Equal(t, 2, blocked[0].Flows)
+	assert.Equal(t, "Block IoT", blocked[1].Key)
+	assert.False(t, TrafficFlow{Action: "block"}.IsBlocked(), "only the controller's own action value counts")
+
+	matrix := TrafficByZone(flows)
+	require.Contains(t, matrix, "Internal")
+	assert.Equal(t, 4, matrix["Internal"]["External"].Flows)
+	assert.Equal(t, int64(1119), matrix["Internal"]["External"].Bytes())
+}
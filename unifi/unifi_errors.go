@@ -2,16 +2,48 @@ package unifi
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"reflect"
 	"strings"
+	"syscall"
 )
 
 var ErrNotFound = errors.New("not found")
 
+// Error classes a *ServerError is matched against with errors.Is. They are
+// derived from the HTTP status and from the controller's error code — the
+// api.err.* message of a v1 meta envelope (including HTTP 200 rc:error soft
+// failures) or the code of a v2 and Official API error body.
+var (
+	// ErrUnauthorized: missing or invalid credentials (HTTP 401,
+	// api.err.LoginRequired, api.authentication.*).
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden: the credentials lack permission (HTTP 403,
+	// api.err.NoPermission, api.authorization.*).
+	ErrForbidden = errors.New("forbidden")
+	// ErrRateLimited: the controller is throttling requests (HTTP 429).
+	ErrRateLimited = errors.New("rate limited")
+	// ErrConflict: the object conflicts with an existing one, e.g. a duplicate
+	// name (HTTP 409, api.err.*Duplicate*, api.err.*Exists).
+	ErrConflict = errors.New("conflict")
+	// ErrValidationFailed: the request body was rejected as invalid
+	// (api.err.Invalid*, api.err.*Validation*, field-level validation
+	// details). Client-side validation failures (*ValidationError) match it too.
+	ErrValidationFailed = errors.New("validation failed")
+	// ErrObjectInUse: the object cannot be changed or deleted because another
+	// object refers to it (api.err.ObjectReferredBy*, api.err.*InUse).
+	ErrObjectInUse = errors.New("object in use")
+	// ErrControllerBusy: the controller is temporarily unable to serve the
+	// request (HTTP 503, api.err.*Busy, api.err.*NotReady).
+	ErrControllerBusy = errors.New("controller busy")
+)
+
 // ErrOfficialAPIUnavailable is returned when the Official UniFi OpenAPI cannot be
 // used against this controller: an old-style (classic) controller, non-API-key
 // auth, or a controller version below 10.1.78.
@@ -131,11 +163,175 @@ func (s *ServerError) Error() string {
 // Is lets a *ServerError participate in errors.Is. A real HTTP 404 maps to the
 // ErrNotFound sentinel so that errors.Is(err, ErrNotFound) holds uniformly for
 // both a genuine 404 response and the existing empty-data 200 case.
+//
+// The error classes (ErrUnauthorized, ErrForbidden, ErrRateLimited,
+// ErrConflict, ErrValidationFailed, ErrObjectInUse, ErrControllerBusy) match
+// the class derived from the HTTP status and the API error code; a ServerError
+// matches at most one class (besides ErrNotFound for a 404).
 func (s *ServerError) Is(target error) bool {
 	if target == ErrNotFound {
-		return s.StatusCode == http.StatusNotFound
+		return s.StatusCode == http.StatusNotFound || s.class() == ErrNotFound
+	}
+	class := s.class()
+	return class != nil && target == class
+}
+
+// APIErrorCode returns the controller's error code: the code of a v2 or
+// Official API error body, the api.err.* message of a v1 meta envelope, or the
+// first api.err.* message of a v1 data entry. It returns "" when the response
+// carried none.
+func (s *ServerError) APIErrorCode() string {
+	if s.ErrorCode != "" && s.ErrorCode != "error" {
+		return s.ErrorCode
+	}
+	if strings.HasPrefix(s.Message, "api.") {
+		return s.Message
+	}
+	for _, d := range s.Details {
+		if strings.HasPrefix(d.Message, "api.") {
+			return d.Message
+		}
+	}
+	return ""
+}
+
+// FieldErrors returns the field-level validation failures the controller
+// reported, each resolved from its wire name to the Go field path within the
+// request's resource type (the registered ResourceKind or setting the request
+// URL addresses). A field that cannot be resolved — the path matches no known
+// type, or the Official API, whose types live in the official package — has
+// an empty Path; ResolveFieldPath resolves it against an explicit type.
+func (s *ServerError) FieldErrors() []FieldError {
+	var errs []FieldError
+	var t reflect.Type
+	for i, d := range s.Details {
+		if d.ValidationError.Field == "" {
+			continue
+		}
+		if errs == nil {
+			t = requestResourceType(s.RequestMethod, s.RequestURL)
+			errs = make([]FieldError, 0, len(s.Details)-i)
+		}
+		fe := FieldError{WirePath: d.ValidationError.Field, Message: d.Message, Pattern: d.ValidationError.Pattern}
+		fe.Path, _ = translateFieldPath(t, fe.WirePath, true)
+		errs = append(errs, fe)
+	}
+	return errs
+}
+
+// Retryable reports whether repeating the same request may succeed: the
+// controller was throttling or busy (ErrRateLimited, ErrControllerBusy), or a
+// gateway in front of it timed out or failed (HTTP 408, 502, 504).
+func (s *ServerError) Retryable() bool {
+	switch s.StatusCode {
+	case http.StatusRequestTimeout, http.StatusBadGateway, http.StatusGatewayTimeout:
+		return true
+	}
+	class := s.class()
+	return class == ErrRateLimited || class == ErrControllerBusy
+}
+
+// class returns the error class of s, or nil when it has none. The
+// authentication and throttling statuses are unambiguous and win over the
+// error code (a v1 login rejection is a 401 carrying api.err.Invalid).
+func (s *ServerError) class() error {
+	switch s.StatusCode {
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	}
+	if class := classifyErrorCode(s.APIErrorCode()); class != nil {
+		return class
+	}
+	switch s.StatusCode {
+	case http.StatusConflict:
+		return ErrConflict
+	case http.StatusUnprocessableEntity:
+		return ErrValidationFailed
+	case http.StatusServiceUnavailable:
+		return ErrControllerBusy
+	}
+	for _, d := range s.Details {
+		if d.ValidationError.Field != "" {
+			return ErrValidationFailed
+		}
+	}
+	return nil
+}
+
+// errorCodeClasses maps a fragment of an API error code to its class. They are
+// tried in order against the code lower-cased with "-" and "_" removed (so
+// api.err.ObjectReferredBy and api.request.object-referred-by read alike);
+// the more specific fragments (in use, duplicate, not found) win over the
+// generic "invalid".
+var errorCodeClasses = []struct {
+	fragment string
+	class    error
+}{
+	{"api.authentication.", ErrUnauthorized},
+	{"loginrequired", ErrUnauthorized},
+	{"unauthorized", ErrUnauthorized},
+	{"invalidapikey", ErrUnauthorized},
+	{"api.authorization.", ErrForbidden},
+	{"nopermission", ErrForbidden},
+	{"forbidden", ErrForbidden},
+	{"accessdenied", ErrForbidden},
+	{"toomanyrequests", ErrRateLimited},
+	{"ratelimit", ErrRateLimited},
+	{"referredby", ErrObjectInUse},
+	{"referenced", ErrObjectInUse},
+	{"inuse", ErrObjectInUse},
+	{"notfound", ErrNotFound},
+	{"notexist", ErrNotFound},
+	{"duplicate", ErrConflict},
+	{"alreadyexists", ErrConflict},
+	{"exists", ErrConflict},
+	{"conflict", ErrConflict},
+	{"busy", ErrControllerBusy},
+	{"notready", ErrControllerBusy},
+	{"invalid", ErrValidationFailed},
+	{"validation", ErrValidationFailed},
+	{"required", ErrValidationFailed},
+}
+
+var errorCodeNormalizer = strings.NewReplacer("-", "", "_", "")
+
+// classifyErrorCode returns the class of an API error code, or nil.
+func classifyErrorCode(code string) error {
+	if code == "" {
+		return nil
+	}
+	code = errorCodeNormalizer.Replace(strings.ToLower(code))
+	for _, c := range errorCodeClasses {
+		if strings.Contains(code, c.fragment) {
+			return c.class
+		}
+	}
+	return nil
+}
+
+// Retryable reports whether err is worth retrying unchanged: a *ServerError
+// whose Retryable method says so, or a transient transport failure (a network
+// timeout, a refused or reset connection, a connection closed mid-response).
+// Cancellation and deadline errors of the caller's context, TLS and DNS
+// failures are not retryable.
+func Retryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var serverErr *ServerError
+	if errors.As(err, &serverErr) {
+		return serverErr.Retryable()
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
 	}
-	return false
+	return errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
 }
 
 func parseApiV2Error(err apiV2ResponseError, serverError *ServerError) {
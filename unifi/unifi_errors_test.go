@@ -3,8 +3,14 @@ package unifi //nolint: testpackage
 import (
 	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"syscall"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -256,3 +262,163 @@ func TestHandleError_NotFound(t *testing.T) {
 	other := &ServerError{StatusCode: http.StatusInternalServerError}
 	a.NotErrorIs(other, ErrNotFound, "a 500 ServerError must not satisfy errors.Is(err, ErrNotFound)")
 }
+
+func TestServerErrorClasses(t *testing.T) {
+	t.Parallel()
+	classes := []error{
+		ErrUnauthorized, ErrForbidden, ErrRateLimited, ErrConflict,
+		ErrValidationFailed, ErrObjectInUse, ErrControllerBusy, ErrNotFound,
+	}
+	tests := map[string]struct {
+		statusCode    int
+		body          string
+		want          error
+		wantCode      string
+		wantRetryable bool
+	}{
+		"v1 login required": {
+			statusCode: http.StatusUnauthorized,
+			body:       `{"meta":{"rc":"error","msg":"api.err.LoginRequired"},"data":[]}`,
+			want:       ErrUnauthorized,
+			wantCode:   "api.err.LoginRequired",
+		},
+		"v1 invalid credentials on 401": {
+			statusCode: http.StatusUnauthorized,
+			body:       `{"meta":{"rc":"error","msg":"api.err.Invalid"},"data":[]}`,
+			want:       ErrUnauthorized,
+			wantCode:   "api.err.Invalid",
+		},
+		"v1 no permission": {
+			statusCode: http.StatusBadRequest,
+			body:       `{"meta":{"rc":"error","msg":"api.err.NoPermission"},"data":[]}`,
+			want:       ErrForbidden,
+			wantCode:   "api.err.NoPermission",
+		},
+		"v1 invalid payload": {
+			statusCode: http.StatusBadRequest,
+			body:       `{"meta":{"rc":"error","msg":"api.err.InvalidPayload"},"data":[]}`,
+			want:       ErrValidationFailed,
+			wantCode:   "api.err.InvalidPayload",
+		},
+		"v1 duplicate name": {
+			statusCode: http.StatusBadRequest,
+			body:       `{"meta":{"rc":"error","msg":"api.err.DuplicateName"},"data":[]}`,
+			want:       ErrConflict,
+			wantCode:   "api.err.DuplicateName",
+		},
+		"v1 object referred by": {
+			statusCode: http.StatusBadRequest,
+			body:       `{"meta":{"rc":"error","msg":"api.err.ObjectReferredByFirewallRule"},"data":[]}`,
+			want:       ErrObjectInUse,
+			wantCode:   "api.err.ObjectReferredByFirewallRule",
+		},
+		"v1 code in data entry": {
+			statusCode:    http.StatusBadRequest,
+			body:          `{"meta":{"rc":"error"},"data":[{"rc":"error","msg":"api.err.DeviceBusy"}]}`,
+			want:          ErrControllerBusy,
+			wantCode:      "api.err.DeviceBusy",
+			wantRetryable: true,
+		},
+		"v1 field validation details": {
+			statusCode: http.StatusBadRequest,
+			body:       `{"meta":{"rc":"error","msg":"something odd"},"data":[{"rc":"error","validationError":{"field":"x_passphrase","pattern":"^.{8,}$"}}]}`,
+			want:       ErrValidationFailed,
+		},
+		"v2 duplicate": {
+			statusCode: http.StatusBadRequest,
+			body:       `{"code":"api.err.FirewallZoneNameAlreadyExists","errorCode":400,"message":"exists"}`,
+			want:       ErrConflict,
+			wantCode:   "api.err.FirewallZoneNameAlreadyExists",
+		},
+		"official validation": {
+			statusCode: http.StatusBadRequest,
+			body:       `{"statusCode":400,"statusName":"BAD_REQUEST","code":"api.request.argument-validation-error","message":"bad"}`,
+			want:       ErrValidationFailed,
+			wantCode:   "api.request.argument-validation-error",
+		},
+		"official missing credentials": {
+			statusCode: http.StatusUnauthorized,
+			body:       `{"statusCode":401,"code":"api.authentication.missing-credentials","message":"no key"}`,
+			want:       ErrUnauthorized,
+			wantCode:   "api.authentication.missing-credentials",
+		},
+		"official not found": {
+			statusCode: http.StatusNotFound,
+			body:       `{"statusCode":404,"code":"api.request.not-found","message":"gone"}`,
+			want:       ErrNotFound,
+			wantCode:   "api.request.not-found",
+		},
+		"429 without body": {
+			statusCode:    http.StatusTooManyRequests,
+			want:          ErrRateLimited,
+			wantRetryable: true,
+		},
+		"409 without body": {
+			statusCode: http.StatusConflict,
+			want:       ErrConflict,
+		},
+		"503 gateway page": {
+			statusCode:    http.StatusServiceUnavailable,
+			body:          "<html>Service Unavailable</html>",
+			want:          ErrControllerBusy,
+			wantRetryable: true,
+		},
+		"502 is retryable without a class": {
+			statusCode:    http.StatusBadGateway,
+			wantRetryable: true,
+		},
+		"500 unknown code": {
+			statusCode: http.StatusInternalServerError,
+			body:       `{"meta":{"rc":"error","msg":"api.err.Mystery"},"data":[]}`,
+			wantCode:   "api.err.Mystery",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			recorder := httptest.NewRecorder()
+			recorder.WriteHeader(tt.statusCode)
+			recorder.Body = bytes.NewBufferString(tt.body)
+			resp := recorder.Result()
+			resp.Request = httptest.NewRequestWithContext(context.Background(), http.MethodGet, "http://example.com", nil)
+
+			err := (&DefaultResponseErrorHandler{}).HandleError(resp)
+			var serverErr *ServerError
+			require.ErrorAs(t, err, &serverErr)
+			for _, class := range classes {
+				assert.Equal(t, class == tt.want, errors.Is(err, class), "errors.Is(err, %v)", class)
+			}
+			assert.Equal(t, tt.wantCode, serverErr.APIErrorCode())
+			assert.Equal(t, tt.wantRetryable, serverErr.Retryable())
+			assert.Equal(t, tt.wantRetryable, Retryable(fmt.Errorf("wrapped: %w", err)))
+		})
+	}
+}
+
+func TestSoftErrorClass(t *testing.T) {
+	t.Parallel()
+	resp := &http.Response{StatusCode: http.StatusOK}
+	err := metaEnvelopeError(resp, []byte(`{"meta":{"rc":"error","msg":"api.err.ObjectReferredByWlan"}}`))
+	require.ErrorIs(t, err, ErrObjectInUse)
+	require.NotErrorIs(t, err, ErrNotFound)
+}
+
+func TestRetryableTransportErrors(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		err  error
+		want bool
+	}{
+		"nil":               {nil, false},
+		"canceled":          {fmt.Errorf("unable to perform request: %w", context.Canceled), false},
+		"deadline":          {fmt.Errorf("unable to perform request: %w", context.DeadlineExceeded), false},
+		"connection reset":  {&url.Error{Op: "Get", URL: "https://x", Err: syscall.ECONNRESET}, true},
+		"connection closed": {&url.Error{Op: "Get", URL: "https://x", Err: io.EOF}, true},
+		"dns failure":       {&url.Error{Op: "Get", URL: "https://x", Err: &net.DNSError{Err: "no such host", Name: "x"}}, false},
+		"client validation": {&ValidationError{}, false},
+	}
+	for name, tt := range tests {
+		assert.Equal(t, tt.want, Retryable(tt.err), name)
+	}
+	require.ErrorIs(t, &ValidationError{}, ErrValidationFailed)
+}
@@ -0,0 +1,389 @@
+package unifitest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/filipowm/go-unifi/v2/unifi"
+)
+
+// Redacted replaces scrubbed secrets in a cassette.
+const Redacted = "REDACTED"
+
+// secretHeaders are scrubbed from every recorded request and response.
+var secretHeaders = []string{
+	"X-Api-Key",
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-Csrf-Token",
+	"X-Updated-Csrf-Token",
+}
+
+var (
+	macPattern = regexp.MustCompile(`(?i)\b[0-9a-f]{2}(?:[:-][0-9a-f]{2}){5}\b`)
+	ipPattern  = regexp.MustCompile(`\b(?:(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)\.){3}(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)\b`)
+)
+
+// Cassette is a recorded sequence of controller interactions, stored as JSON.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is one recorded request and the response it received.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request as stored in a cassette. The URL is reduced to
+// its path and query, so a cassette replays against any controller address.
+type RecordedRequest struct {
+	Method string       `json:"method"`
+	Path   string       `json:"path"`
+	Query  string       `json:"query,omitempty"`
+	Header http.Header  `json:"header,omitempty"`
+	Body   RecordedBody `json:"body"`
+}
+
+// RecordedResponse is a response as stored in a cassette.
+type RecordedResponse struct {
+	StatusCode int          `json:"status_code"`
+	Header     http.Header  `json:"header,omitempty"`
+	Body       RecordedBody `json:"body"`
+}
+
+// RecordedBody holds a JSON body verbatim (keeping cassettes readable and
+// diffable) and any other body as text.
+type RecordedBody struct {
+	JSON json.RawMessage `json:"json,omitempty"`
+	Text string          `json:"text,omitempty"`
+}
+
+func newRecordedBody(raw []byte) RecordedBody {
+	if len(bytes.TrimSpace(raw)) == 0 {
+		return RecordedBody{}
+	}
+	if json.Valid(raw) {
+		var buf bytes.Buffer
+		if json.Compact(&buf, raw) == nil {
+			return RecordedBody{JSON: buf.Bytes()}
+		}
+	}
+	return RecordedBody{Text: string(raw)}
+}
+
+// Bytes returns the body as sent on the wire.
+func (b RecordedBody) Bytes() []byte {
+	if len(b.JSON) > 0 {
+		return b.JSON
+	}
+	return []byte(b.Text)
+}
+
+// LoadCassette reads a cassette written by Recorder.Save or Cassette.Save.
+func LoadCassette(path string) (*Cassette, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading cassette: %w", err)
+	}
+	var c Cassette
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return nil, fmt.Errorf("failed decoding cassette %s: %w", path, err)
+	}
+	return &c, nil
+}
+
+// Save writes the cassette to path as indented JSON.
+func (c *Cassette) Save(path string) error {
+	raw, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed encoding cassette: %w", err)
+	}
+	if err := os.WriteFile(path, append(raw, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed writing cassette: %w", err)
+	}
+	return nil
+}
+
+// RecorderConfig configures a Recorder. Secrets (API keys, cookies, CSRF
+// tokens, and the secret JSON fields of unifi.IsSecretField) are always
+// scrubbed; MAC and IPv4 redaction is opt-in.
+//
+// Redaction applies to the cassette only: a Replayer matches requests as sent,
+// so a test replaying a cassette recorded with RedactMACs or RedactIPs must use
+// the placeholders (e.g. 02:00:00:00:00:01 or 192.0.2.1) in place of the real
+// addresses.
+type RecorderConfig struct {
+	// RedactMACs replaces every MAC address with a stable placeholder
+	// (02:00:00:00:00:01, 02:00:00:00:00:02, …). The same MAC always maps to
+	// the same placeholder, in paths as well as bodies, so recorded lookups
+	// by MAC still replay.
+	RedactMACs bool
+	// RedactIPs replaces every IPv4 address with a stable placeholder from
+	// the documentation ranges (192.0.2.0/24, then 198.18.0.0/15).
+	RedactIPs bool
+}
+
+// Recorder is an http.RoundTripper that forwards requests to a real controller
+// and records each interaction, scrubbed, into a Cassette. Plug it into a
+// client with ClientConfig.HttpRoundTripperProvider = rec.Provider.
+type Recorder struct {
+	next http.RoundTripper
+	cfg  RecorderConfig
+
+	mu       sync.Mutex
+	cassette Cassette
+	macs     map[string]string
+	ips      map[string]string
+}
+
+// NewRecorder returns a Recorder forwarding to next (http.DefaultTransport when
+// nil), configured by cfg (nil for defaults).
+func NewRecorder(next http.RoundTripper, cfg *RecorderConfig) *Recorder {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if cfg == nil {
+		cfg = &RecorderConfig{}
+	}
+	return &Recorder{next: next, cfg: *cfg, macs: map[string]string{}, ips: map[string]string{}}
+}
+
+// Provider returns the Recorder; it has the signature of
+// ClientConfig.HttpRoundTripperProvider.
+func (r *Recorder) Provider() http.RoundTripper {
+	return r
+}
+
+// RoundTrip forwards req and records the scrubbed interaction. The response
+// returned to the caller is unmodified.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			Path:   r.redact(req.URL.Path),
+			Query:  r.redact(req.URL.RawQuery),
+			Header: scrubHeader(req.Header),
+			Body:   newRecordedBody([]byte(r.redact(string(scrubBody(reqBody))))),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     scrubHeader(resp.Header),
+			Body:       newRecordedBody([]byte(r.redact(string(scrubBody(respBody))))),
+		},
+	})
+	return resp, nil
+}
+
+// Cassette returns a copy of the interactions recorded so far.
+func (r *Recorder) Cassette() *Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return &Cassette{Interactions: append([]Interaction(nil), r.cassette.Interactions...)}
+}
+
+// Save writes the interactions recorded so far to path.
+func (r *Recorder) Save(path string) error {
+	return r.Cassette().Save(path)
+}
+
+// redact applies the configured MAC and IP redaction to s. Callers hold r.mu
+// or are otherwise serialized with it.
+func (r *Recorder) redact(s string) string {
+	if r.cfg.RedactMACs {
+		s = macPattern.ReplaceAllStringFunc(s, func(mac string) string {
+			key := strings.ToLower(strings.ReplaceAll(mac, "-", ":"))
+			if p, ok := r.macs[key]; ok {
+				return p
+			}
+			n := len(r.macs) + 1
+			p := fmt.Sprintf("02:00:00:%02x:%02x:%02x", n>>16&0xff, n>>8&0xff, n&0xff)
+			r.macs[key] = p
+			return p
+		})
+	}
+	if r.cfg.RedactIPs {
+		s = ipPattern.ReplaceAllStringFunc(s, func(ip string) string {
+			if p, ok := r.ips[ip]; ok {
+				return p
+			}
+			n := len(r.ips) + 1
+			p := fmt.Sprintf("192.0.2.%d", n)
+			if n > 254 {
+				n -= 254
+				p = fmt.Sprintf("198.%d.%d.%d", 18+n>>16&1, n>>8&0xff, n&0xff)
+			}
+			r.ips[ip] = p
+			return p
+		})
+	}
+	return s
+}
+
+// Replayer is an http.RoundTripper that serves responses from a Cassette
+// without any network access. Requests match an interaction on method, path,
+// query and body, with JSON bodies compared after normalization (key order and
+// whitespace do not matter; secret fields are scrubbed first). Each
+// interaction is served once, in recorded order, so repeated identical
+// requests replay the responses they originally got.
+type Replayer struct {
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// NewReplayer returns a Replayer serving c.
+func NewReplayer(c *Cassette) *Replayer {
+	return &Replayer{interactions: c.Interactions, used: make([]bool, len(c.Interactions))}
+}
+
+// Provider returns the Replayer; it has the signature of
+// ClientConfig.HttpRoundTripperProvider.
+func (p *Replayer) Provider() http.RoundTripper {
+	return p
+}
+
+// RoundTrip serves the first unused interaction matching req, or fails with
+// an error naming the unmatched request.
+func (p *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+	want := normalizeBody(scrubBody(body))
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i, in := range p.interactions {
+		rec := in.Request
+		if p.used[i] || rec.Method != req.Method || rec.Path != req.URL.Path || rec.Query != req.URL.RawQuery {
+			continue
+		}
+		if normalizeBody(rec.Body.Bytes()) != want {
+			continue
+		}
+		p.used[i] = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+			StatusCode:    in.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        in.Response.Header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(in.Response.Body.Bytes())),
+			ContentLength: int64(len(in.Response.Body.Bytes())),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("unifitest: no recorded interaction for %s %s", req.Method, req.URL.RequestURI())
+}
+
+// Unused returns the interactions that have not been served, for asserting a
+// test exercised the whole cassette.
+func (p *Replayer) Unused() []Interaction {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var out []Interaction
+	for i, in := range p.interactions {
+		if !p.used[i] {
+			out = append(out, in)
+		}
+	}
+	return out
+}
+
+// readBody drains *body and replaces it with a re-readable copy.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	raw, err := io.ReadAll(*body)
+	_ = (*body).Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed reading body: %w", err)
+	}
+	*body = io.NopCloser(bytes.NewReader(raw))
+	return raw, nil
+}
+
+func scrubHeader(h http.Header) http.Header {
+	out := h.Clone()
+	for _, name := range secretHeaders {
+		if out.Get(name) != "" {
+			out.Set(name, Redacted)
+		}
+	}
+	return out
+}
+
+// scrubBody replaces the value of every secret field (see unifi.IsSecretField:
+// any x_* field, passwords, secrets, tokens and API keys) anywhere in a JSON
+// body, on record and on replay matching, so a login body recorded with a real
+// password still matches. Non-JSON bodies are returned unchanged.
+func scrubBody(raw []byte) []byte {
+	var v any
+	if len(raw) == 0 || json.Unmarshal(raw, &v) != nil {
+		return raw
+	}
+	scrubValue(v)
+	out, err := json.Marshal(v)
+	if err != nil {
+		return raw
+	}
+	return out
+}
+
+func scrubValue(v any) {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, field := range v {
+			if unifi.IsSecretField(k) {
+				v[k] = Redacted
+				continue
+			}
+			scrubValue(field)
+		}
+	case []any:
+		for _, item := range v {
+			scrubValue(item)
+		}
+	}
+}
+
+// normalizeBody returns a canonical form of a body for matching: JSON is
+// re-encoded (sorting object keys), anything else is compared verbatim.
+func normalizeBody(raw []byte) string {
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		if errors.Is(err, io.EOF) || len(bytes.TrimSpace(raw)) == 0 {
+			return ""
+		}
+		return string(raw)
+	}
+	out, _ := json.Marshal(v)
+	return string(out)
+}
@@ -0,0 +1,160 @@
+package unifitest //nolint: testpackage
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filipowm/go-unifi/v2/unifi"
+)
+
+// record runs fn against a fresh fake controller through a Recorder and
+// returns the saved cassette path.
+func record(t *testing.T, cfg *RecorderConfig, seed []any, fn func(c unifi.Client)) string {
+	t.Helper()
+	srv := NewServer(nil)
+	t.Cleanup(srv.Close)
+	require.NoError(t, srv.Seed(DefaultSite, seed...))
+
+	rec := NewRecorder(srv.Client().Transport, cfg)
+	clientCfg := srv.ClientConfig()
+	clientCfg.HttpRoundTripperProvider = rec.Provider
+	clientCfg.ValidationMode = unifi.DisableValidation
+	c, err := unifi.NewClient(clientCfg)
+	require.NoError(t, err)
+	fn(c)
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	require.NoError(t, rec.Save(path))
+	return path
+}
+
+func replay(t *testing.T, path string) (*Replayer, unifi.Client) {
+	t.Helper()
+	cassette, err := LoadCassette(path)
+	require.NoError(t, err)
+	rp := NewReplayer(cassette)
+	c, err := unifi.NewClient(&unifi.ClientConfig{
+		URL:                      "https://unifi.invalid",
+		APIKey:                   "any-key",
+		HttpRoundTripperProvider: rp.Provider,
+		ValidationMode:           unifi.DisableValidation,
+	})
+	require.NoError(t, err)
+	return rp, c
+}
+
+func TestCassetteRoundTrip(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var createdID string
+	path := record(t, nil, nil, func(c unifi.Client) {
+		created, err := c.Networks().Create(ctx, DefaultSite, &unifi.Network{Name: "IoT", Purpose: "corporate"})
+		require.NoError(t, err)
+		createdID = created.ID
+		_, err = c.Networks().List(ctx, DefaultSite)
+		require.NoError(t, err)
+	})
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw), DefaultAPIKey, "the API key is scrubbed")
+	assert.Contains(t, string(raw), Redacted)
+
+	rp, c := replay(t, path)
+	// Field order differs from the recorded body; normalized JSON still matches.
+	created, err := c.Networks().Create(ctx, DefaultSite, &unifi.Network{Purpose: "corporate", Name: "IoT"})
+	require.NoError(t, err)
+	assert.Equal(t, createdID, created.ID)
+	networks, err := c.Networks().List(ctx, DefaultSite)
+	require.NoError(t, err)
+	require.Len(t, networks, 1)
+	assert.Empty(t, rp.Unused())
+
+	_, err = c.Networks().List(ctx, DefaultSite)
+	require.ErrorContains(t, err, "no recorded interaction for GET /proxy/network/api/s/default/rest/networkconf")
+}
+
+func TestCassetteBodyMismatch(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	path := record(t, nil, nil, func(c unifi.Client) {
+		_, err := c.Networks().Create(ctx, DefaultSite, &unifi.Network{Name: "IoT"})
+		require.NoError(t, err)
+	})
+
+	_, c := replay(t, path)
+	_, err := c.Networks().Create(ctx, DefaultSite, &unifi.Network{Name: "Guest"})
+	require.ErrorContains(t, err, "no recorded interaction for POST")
+}
+
+func TestCassetteRedaction(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	user := &unifi.User{MAC: "f0:9f:c2:aa:bb:cc", Name: "laptop", IP: "10.0.10.23"}
+	path := record(t, &RecorderConfig{RedactMACs: true, RedactIPs: true}, []any{user}, func(c unifi.Client) {
+		_, err := c.Users().GetByMAC(ctx, DefaultSite, "f0:9f:c2:aa:bb:cc")
+		require.NoError(t, err)
+		_, err = c.Users().List(ctx, DefaultSite)
+		require.NoError(t, err)
+	})
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, strings.ToLower(string(raw)), "f0:9f:c2:aa:bb:cc")
+	assert.NotContains(t, string(raw), "10.0.10.23")
+	assert.Contains(t, string(raw), "192.0.2.", "IPs map into documentation ranges")
+
+	// The same MAC maps to the same placeholder in the path and the bodies, so
+	// the lookup replays under its redacted address.
+	_, c := replay(t, path)
+	got, err := c.Users().GetByMAC(ctx, DefaultSite, "02:00:00:00:00:01")
+	require.NoError(t, err)
+	assert.Equal(t, "02:00:00:00:00:01", got.MAC)
+	users, err := c.Users().List(ctx, DefaultSite)
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, got.MAC, users[0].MAC)
+	assert.Equal(t, got.IP, users[0].IP)
+}
+
+func TestScrubSecrets(t *testing.T) {
+	t.Parallel()
+
+	h := http.Header{}
+	h.Set("X-Api-Key", "secret")
+	h.Set("Cookie", "TOKEN=abc")
+	h.Set("X-Csrf-Token", "csrf")
+	h.Set("Content-Type", "application/json")
+	scrubbed := scrubHeader(h)
+	assert.Equal(t, Redacted, scrubbed.Get("X-Api-Key"))
+	assert.Equal(t, Redacted, scrubbed.Get("Cookie"))
+	assert.Equal(t, Redacted, scrubbed.Get("X-Csrf-Token"))
+	assert.Equal(t, "application/json", scrubbed.Get("Content-Type"))
+	assert.Equal(t, "secret", h.Get("X-Api-Key"), "the live request is untouched")
+
+	body := scrubBody([]byte(`{"username":"admin","password":"hunter2","nested":[{"x_passphrase":"wifi"}]}`))
+	assert.JSONEq(t, `{"username":"admin","password":"REDACTED","nested":[{"x_passphrase":"REDACTED"}]}`, string(body))
+	assert.Equal(t, "not json", string(scrubBody([]byte("not json"))))
+
+	body = scrubBody([]byte(`{"name":"radius","x_secret":"s3cr3t","X_SSH_PASSWORD":"pw","api_key":"k","x_iapp_key":["a","b"],"x_auth":{"k":1}}`))
+	assert.JSONEq(t, `{"name":"radius","x_secret":"REDACTED","X_SSH_PASSWORD":"REDACTED","api_key":"REDACTED","x_iapp_key":"REDACTED","x_auth":"REDACTED"}`, string(body),
+		"every x_* field and API key is scrubbed, whatever its value")
+}
+
+func TestNormalizeBody(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, normalizeBody([]byte(`{"b":1,"a":[1,2]}`)), normalizeBody([]byte("{ \"a\": [1, 2],\n \"b\": 1 }")))
+	assert.NotEqual(t, normalizeBody([]byte(`{"a":[1,2]}`)), normalizeBody([]byte(`{"a":[2,1]}`)))
+	assert.Empty(t, normalizeBody(nil))
+	assert.Equal(t, "plain", normalizeBody([]byte("plain")))
+}
@@ -0,0 +1,123 @@
+package unifitest
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// serveCmd handles the cmd/<manager> endpoints. Every request is recorded as a
+// Command; the managers the client relies on for state changes (stamgr and
+// sitemgr) are applied to the site state, the rest are acknowledged.
+func (s *Server) serveCmd(w http.ResponseWriter, r *http.Request, st *siteState, manager string) {
+	body, ok := decodeObject(r)
+	if r.Method != http.MethodPost || !ok {
+		writeV1Error(w, http.StatusBadRequest, "api.err.InvalidPayload", nil)
+		return
+	}
+	cmd, _ := body["cmd"].(string)
+	s.commands = append(s.commands, Command{Site: st.name, Manager: manager, Cmd: cmd, Body: body})
+
+	switch manager {
+	case "stamgr":
+		s.serveStamgr(w, st, cmd, body)
+	case "sitemgr":
+		s.serveSitemgr(w, st, cmd, body)
+	default:
+		writeV1(w, nil)
+	}
+}
+
+// serveStamgr applies client (station) commands to the site's users. Commands
+// for an unknown MAC succeed with empty data, which the client reports as
+// ErrNotFound.
+func (s *Server) serveStamgr(w http.ResponseWriter, st *siteState, cmd string, body map[string]any) {
+	users := st.collection("User")
+	switch cmd {
+	case "block-sta", "unblock-sta", "kick-sta":
+		mac, _ := body["mac"].(string)
+		user, ok := users.findBy("mac", strings.ToLower(mac))
+		if !ok {
+			writeV1(w, nil)
+			return
+		}
+		if cmd != "kick-sta" {
+			user["blocked"] = cmd == "block-sta"
+		}
+		writeV1(w, []any{clone(user)})
+	case "forget-sta":
+		var forgotten []any
+		for _, mac := range stringSlice(body["macs"]) {
+			if user, ok := users.findBy("mac", strings.ToLower(mac)); ok {
+				id, _ := user["_id"].(string)
+				users.remove(id)
+				forgotten = append(forgotten, user)
+			}
+		}
+		writeV1(w, forgotten)
+	default:
+		writeV1(w, nil)
+	}
+}
+
+// serveSitemgr applies site commands: add-site, update-site and delete-site
+// change the site list, delete-device forgets devices of the current site.
+func (s *Server) serveSitemgr(w http.ResponseWriter, st *siteState, cmd string, body map[string]any) {
+	switch cmd {
+	case "add-site":
+		desc, _ := body["desc"].(string)
+		id := s.newID()
+		created := s.addSiteLocked(id[len(id)-8:], desc)
+		writeV1(w, []any{created.siteObject()})
+	case "update-site":
+		if desc, ok := body["desc"].(string); ok {
+			st.desc = desc
+		}
+		writeV1(w, []any{st.siteObject()})
+	case "delete-site":
+		id, _ := body["site"].(string)
+		for name, site := range s.sites {
+			if site.id == id {
+				delete(s.sites, name)
+				if s.official != nil {
+					s.official.RemoveSite(name)
+				}
+				writeV1(w, nil)
+				return
+			}
+		}
+		writeV1Error(w, http.StatusBadRequest, "api.err.InvalidObject", nil)
+	case "delete-device":
+		devices := st.collection("Device")
+		for _, mac := range stringSlice(body["macs"]) {
+			if device, ok := devices.findBy("mac", strings.ToLower(mac)); ok {
+				id, _ := device["_id"].(string)
+				devices.remove(id)
+			}
+		}
+		writeV1(w, nil)
+	default:
+		writeV1(w, nil)
+	}
+}
+
+// stringSlice converts a decoded JSON array to its string elements.
+func stringSlice(v any) []string {
+	items, _ := v.([]any)
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// sortByField orders decoded objects by a string field.
+func sortByField(objs []any, field string) {
+	slices.SortFunc(objs, func(a, b any) int {
+		av, _ := a.(map[string]any)[field].(string)
+		bv, _ := b.(map[string]any)[field].(string)
+		return strings.Compare(av, bv)
+	})
+}
@@ -0,0 +1,91 @@
+package unifitest //nolint: testpackage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filipowm/go-unifi/v2/unifi"
+	"github.com/filipowm/go-unifi/v2/unifi/official"
+)
+
+func TestOfficialNetworksCRUD(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	_, c := newTestClient(t, nil)
+
+	siteID, err := c.Official().Sites().ResolveID(ctx, DefaultSite)
+	require.NoError(t, err)
+
+	body := official.NetworkCreateOrUpdate{Management: "UNMANAGED", Name: "IoT", Enabled: true, VlanId: 30}
+	created, err := c.Official().Networks().Create(ctx, siteID, body)
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, created.Id)
+	assert.Equal(t, "IoT", created.Name)
+	assert.Equal(t, int32(30), created.VlanId)
+
+	got, err := c.Official().Networks().Get(ctx, siteID, created.Id)
+	require.NoError(t, err)
+	assert.Equal(t, created.Id, got.Id)
+
+	page, err := c.Official().Networks().ListPage(ctx, siteID, &official.ListOptions{Filter: "name.eq('IoT')"})
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+	assert.Equal(t, 1, page.TotalCount)
+
+	require.NoError(t, c.Official().Networks().Delete(ctx, siteID, created.Id, nil))
+	_, err = c.Official().Networks().Get(ctx, siteID, created.Id)
+	require.ErrorIs(t, err, unifi.ErrNotFound)
+}
+
+func TestOfficialRejectsInvalidBody(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	_, c := newTestClient(t, nil)
+
+	siteID, err := c.Official().Sites().ResolveID(ctx, DefaultSite)
+	require.NoError(t, err)
+	// Client-side validation is off, so the out-of-range VLAN reaches the fake.
+	body := official.NetworkCreateOrUpdate{Management: "UNMANAGED", Name: "IoT", VlanId: 5000}
+	_, err = c.Official().Networks().Create(ctx, siteID, body)
+	var serverErr *unifi.ServerError
+	require.True(t, errors.As(err, &serverErr), "got %v", err)
+	assert.Equal(t, 400, serverErr.StatusCode)
+	assert.Contains(t, serverErr.Message, "vlanId")
+}
+
+func TestOfficialSeedAndSites(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	srv, c := newTestClient(t, nil)
+	srv.AddSite("branch", "Branch office")
+
+	branchID, ok := srv.Official().SiteID("branch")
+	require.True(t, ok)
+	device := &official.AdoptedDeviceDetails{Name: "ap-1", MacAddress: "aa:bb:cc:dd:ee:ff"}
+	require.NoError(t, srv.Official().Seed("/sites/"+branchID.String()+"/devices", device))
+	assert.NotEqual(t, uuid.Nil, device.Id, "the seeded id is written back")
+
+	resolved, err := c.Official().Sites().ResolveID(ctx, "branch")
+	require.NoError(t, err)
+	assert.Equal(t, branchID, resolved)
+	got, err := c.Official().Devices().GetAdopted(ctx, branchID, device.Id)
+	require.NoError(t, err)
+	assert.Equal(t, "ap-1", got.Name)
+
+	_, err = c.Official().Devices().GetAdopted(ctx, uuid.New(), device.Id)
+	require.ErrorIs(t, err, unifi.ErrNotFound, "unknown sites are 404")
+}
+
+func TestOfficialUnavailableOnOldController(t *testing.T) {
+	t.Parallel()
+	srv, c := newTestClient(t, &Config{Version: "9.5.21"})
+	assert.Nil(t, srv.Official())
+
+	_, err := c.Official().Sites().ListPage(context.Background(), nil)
+	require.ErrorIs(t, err, unifi.ErrOfficialAPIUnavailable)
+}
@@ -0,0 +1,237 @@
+package unifitest //nolint: testpackage
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filipowm/go-unifi/v2/unifi"
+)
+
+func newTestClient(t *testing.T, cfg *Config) (*Server, unifi.Client) {
+	t.Helper()
+	srv := NewServer(cfg)
+	t.Cleanup(srv.Close)
+	clientCfg := srv.ClientConfig()
+	// The fake validates payloads itself; client-side validation would also
+	// trip over Network's wan_type oneof tag, which the validator cannot parse.
+	clientCfg.ValidationMode = unifi.DisableValidation
+	c, err := unifi.NewClient(clientCfg)
+	require.NoError(t, err)
+	return srv, c
+}
+
+func TestServerConnects(t *testing.T) {
+	t.Parallel()
+	_, c := newTestClient(t, &Config{Version: "9.5.21"})
+
+	assert.Equal(t, "9.5.21", c.Version())
+	sites, err := c.Sites().List(context.Background())
+	require.NoError(t, err)
+	require.Len(t, sites, 1)
+	assert.Equal(t, DefaultSite, sites[0].Name)
+}
+
+func TestServerRejectsWrongAPIKey(t *testing.T) {
+	t.Parallel()
+	srv := NewServer(nil)
+	t.Cleanup(srv.Close)
+
+	cfg := srv.ClientConfig()
+	cfg.APIKey = "wrong"
+	// NewClient still succeeds: sysinfo falls back to the unauthenticated
+	// status endpoint.
+	c, err := unifi.NewClient(cfg)
+	require.NoError(t, err)
+
+	_, err = c.Networks().List(context.Background(), DefaultSite)
+	var serverErr *unifi.ServerError
+	require.ErrorAs(t, err, &serverErr)
+	assert.Equal(t, http.StatusUnauthorized, serverErr.StatusCode)
+}
+
+func TestServerV1CRUD(t *testing.T) {
+	t.Parallel()
+	srv, c := newTestClient(t, nil)
+	ctx := context.Background()
+
+	created, err := c.Networks().Create(ctx, DefaultSite, &unifi.Network{Name: "IoT", Purpose: "corporate"})
+	require.NoError(t, err)
+	assert.True(t, isObjectID(created.ID), "generated id %q", created.ID)
+	assert.NotEmpty(t, created.SiteID)
+
+	created.Name = "IoT devices"
+	updated, err := c.Networks().Update(ctx, DefaultSite, created)
+	require.NoError(t, err)
+	assert.Equal(t, "IoT devices", updated.Name)
+	assert.Equal(t, "corporate", updated.Purpose, "update keeps stored fields")
+
+	got, err := c.Networks().Get(ctx, DefaultSite, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "IoT devices", got.Name)
+	require.Len(t, srv.Objects(DefaultSite, "Network"), 1)
+
+	require.NoError(t, c.Networks().Delete(ctx, DefaultSite, created.ID))
+	_, err = c.Networks().Get(ctx, DefaultSite, created.ID)
+	require.ErrorIs(t, err, unifi.ErrNotFound)
+	assert.Empty(t, srv.Objects(DefaultSite, "Network"))
+}
+
+func TestServerV2CRUD(t *testing.T) {
+	t.Parallel()
+	_, c := newTestClient(t, nil)
+	ctx := context.Background()
+
+	created, err := c.Firewall().CreateZonePolicy(ctx, DefaultSite, &unifi.FirewallZonePolicy{Name: "block iot"})
+	require.NoError(t, err)
+	require.NotEmpty(t, created.ID)
+
+	policies, err := c.Firewall().ListZonePolicy(ctx, DefaultSite)
+	require.NoError(t, err)
+	require.Len(t, policies, 1)
+	assert.Equal(t, "block iot", policies[0].Name)
+
+	require.NoError(t, c.Firewall().DeleteZonePolicy(ctx, DefaultSite, created.ID))
+	_, err = c.Firewall().GetZonePolicy(ctx, DefaultSite, created.ID)
+	require.ErrorIs(t, err, unifi.ErrNotFound)
+}
+
+func TestServerErrors(t *testing.T) {
+	t.Parallel()
+	_, c := newTestClient(t, &Config{Validators: map[string]Validator{
+		"Network": func(obj map[string]any) error {
+			if obj["name"] == "" || obj["name"] == nil {
+				return &FieldError{Field: "name", Pattern: ".+"}
+			}
+			return nil
+		},
+	}})
+	ctx := context.Background()
+
+	_, err := c.Networks().Get(ctx, DefaultSite, "not-an-id")
+	var serverErr *unifi.ServerError
+	require.ErrorAs(t, err, &serverErr)
+	assert.Equal(t, http.StatusBadRequest, serverErr.StatusCode)
+	assert.Equal(t, "api.err.IdInvalid", serverErr.Message)
+
+	_, err = c.Networks().Create(ctx, DefaultSite, &unifi.Network{Purpose: "corporate"})
+	require.ErrorAs(t, err, &serverErr)
+	assert.Equal(t, "api.err.Invalid", serverErr.Message)
+	require.NotEmpty(t, serverErr.Details)
+	assert.Equal(t, "name", serverErr.Details[0].ValidationError.Field)
+
+	_, err = c.Networks().List(ctx, "nosuchsite")
+	require.ErrorAs(t, err, &serverErr)
+	assert.Equal(t, "api.err.NoSiteContext", serverErr.Message)
+}
+
+func TestServerSeedAndLookupByMAC(t *testing.T) {
+	t.Parallel()
+	srv, c := newTestClient(t, nil)
+	ctx := context.Background()
+
+	device := &unifi.Device{MAC: "aa:bb:cc:dd:ee:ff", Name: "ap-1"}
+	user := &unifi.User{MAC: "11:22:33:44:55:66", Name: "laptop"}
+	require.NoError(t, srv.Seed(DefaultSite, device, user))
+	assert.True(t, isObjectID(device.ID), "seeded id is written back")
+
+	got, err := c.Devices().GetByMAC(ctx, DefaultSite, "aa:bb:cc:dd:ee:ff")
+	require.NoError(t, err)
+	assert.Equal(t, "ap-1", got.Name)
+
+	_, err = c.Devices().GetByMAC(ctx, DefaultSite, "00:00:00:00:00:00")
+	require.ErrorIs(t, err, unifi.ErrNotFound)
+
+	require.NoError(t, c.Users().BlockByMAC(ctx, DefaultSite, user.MAC))
+	assert.Equal(t, true, srv.Objects(DefaultSite, "User")[0]["blocked"])
+	require.ErrorIs(t, c.Users().KickByMAC(ctx, DefaultSite, "00:00:00:00:00:00"), unifi.ErrNotFound)
+
+	cmds := srv.Commands()
+	require.Len(t, cmds, 2)
+	assert.Equal(t, Command{Site: DefaultSite, Manager: "stamgr", Cmd: "block-sta", Body: map[string]any{"cmd": "block-sta", "mac": user.MAC}}, cmds[0])
+
+	require.Error(t, srv.Seed("nosuchsite", device))
+	require.Error(t, srv.Seed(DefaultSite, &unifi.Site{}), "sites are not site-scoped")
+}
+
+func TestServerUsers(t *testing.T) {
+	t.Parallel()
+	srv, c := newTestClient(t, nil)
+	ctx := context.Background()
+
+	created, err := c.Users().Create(ctx, DefaultSite, &unifi.User{MAC: "11:22:33:44:55:66", Name: "laptop"})
+	require.NoError(t, err)
+	require.NotEmpty(t, created.ID)
+
+	got, err := c.Users().GetByMAC(ctx, DefaultSite, "11:22:33:44:55:66")
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, got.ID)
+
+	require.NoError(t, c.Users().DeleteByMAC(ctx, DefaultSite, "11:22:33:44:55:66"))
+	assert.Empty(t, srv.Objects(DefaultSite, "User"))
+}
+
+func TestServerSettings(t *testing.T) {
+	t.Parallel()
+	srv, c := newTestClient(t, nil)
+	ctx := context.Background()
+
+	mgmt, err := c.Settings().GetMgmt(ctx, DefaultSite)
+	require.NoError(t, err)
+	assert.False(t, mgmt.AutoUpgrade)
+
+	mgmt.AutoUpgrade = true
+	_, err = c.Settings().UpdateMgmt(ctx, DefaultSite, mgmt)
+	require.NoError(t, err)
+
+	stored, ok := srv.Setting(DefaultSite, unifi.SettingMgmtKey)
+	require.True(t, ok)
+	assert.Equal(t, true, stored["auto_upgrade"])
+
+	mgmt, err = c.Settings().GetMgmt(ctx, DefaultSite)
+	require.NoError(t, err)
+	assert.True(t, mgmt.AutoUpgrade)
+}
+
+func TestServerSites(t *testing.T) {
+	t.Parallel()
+	srv, c := newTestClient(t, nil)
+	ctx := context.Background()
+
+	created, err := c.Sites().Create(ctx, "Branch office")
+	require.NoError(t, err)
+	require.Len(t, created, 1)
+	assert.Equal(t, "Branch office", created[0].Description)
+
+	// The new site is usable by its short name right away.
+	_, err = c.Networks().Create(ctx, created[0].Name, &unifi.Network{Name: "LAN"})
+	require.NoError(t, err)
+	assert.Len(t, srv.Objects(created[0].Name, "Network"), 1)
+	assert.Empty(t, srv.Objects(DefaultSite, "Network"), "sites do not share state")
+
+	_, err = c.Sites().Delete(ctx, created[0].ID)
+	require.NoError(t, err)
+	sites, err := c.Sites().List(ctx)
+	require.NoError(t, err)
+	assert.Len(t, sites, 1)
+
+	srv.AddSite("lab", "Lab")
+	sites, err = c.Sites().List(ctx)
+	require.NoError(t, err)
+	assert.Len(t, sites, 2)
+}
+
+func TestServerRejectsUnknownEndpoint(t *testing.T) {
+	t.Parallel()
+	_, c := newTestClient(t, nil)
+
+	err := c.Get(context.Background(), "s/default/rest/nosuchthing", nil, nil)
+	var serverErr *unifi.ServerError
+	require.ErrorAs(t, err, &serverErr)
+	assert.Equal(t, http.StatusNotFound, serverErr.StatusCode)
+	assert.True(t, errors.Is(err, unifi.ErrNotFound))
+}
@@ -0,0 +1,312 @@
+package unifitest
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/filipowm/go-unifi/v2/unifi"
+)
+
+// errInvalidPayload reports a body the kind's Go type cannot hold.
+var errInvalidPayload = errors.New("invalid payload")
+
+// kindIndex resolves controller endpoints to resource kinds, per API version.
+type kindIndex struct {
+	byName     map[string]unifi.ResourceKind
+	byEndpoint map[unifi.APIVersion][]unifi.ResourceKind
+}
+
+// newKindIndex indexes every site-scoped kind registered in the unifi package.
+// Endpoints are kept longest first so "firewall/zone" never shadows a longer
+// endpoint sharing its prefix.
+func newKindIndex() kindIndex {
+	idx := kindIndex{byName: map[string]unifi.ResourceKind{}, byEndpoint: map[unifi.APIVersion][]unifi.ResourceKind{}}
+	for _, k := range unifi.ResourceKinds() {
+		if !k.SiteScoped {
+			continue
+		}
+		idx.byName[k.Name] = k
+		idx.byEndpoint[k.APIVersion] = append(idx.byEndpoint[k.APIVersion], k)
+	}
+	for _, kinds := range idx.byEndpoint {
+		sort.SliceStable(kinds, func(i, j int) bool { return len(kinds[i].Endpoint) > len(kinds[j].Endpoint) })
+	}
+	return idx
+}
+
+// match resolves rest (a path relative to the site scope) to a kind and, for
+// item paths, the trailing id segment.
+func (idx kindIndex) match(version unifi.APIVersion, rest string) (unifi.ResourceKind, string, bool) {
+	for _, k := range idx.byEndpoint[version] {
+		if rest == k.Endpoint {
+			return k, "", true
+		}
+		if id, ok := strings.CutPrefix(rest, k.Endpoint+"/"); ok && id != "" && !strings.Contains(id, "/") {
+			return k, id, true
+		}
+	}
+	return unifi.ResourceKind{}, "", false
+}
+
+// siteState is the in-memory state of one site.
+type siteState struct {
+	id          string
+	name        string
+	desc        string
+	collections map[string]*collection
+	settings    map[string]map[string]any
+}
+
+// collection holds the objects of one kind in insertion order.
+type collection struct {
+	ids  []string
+	objs map[string]map[string]any
+}
+
+func (c *collection) list() []any {
+	out := make([]any, 0, len(c.ids))
+	for _, id := range c.ids {
+		out = append(out, clone(c.objs[id]))
+	}
+	return out
+}
+
+func (c *collection) put(id string, obj map[string]any) {
+	if _, ok := c.objs[id]; !ok {
+		c.ids = append(c.ids, id)
+	}
+	c.objs[id] = obj
+}
+
+func (c *collection) remove(id string) bool {
+	if _, ok := c.objs[id]; !ok {
+		return false
+	}
+	delete(c.objs, id)
+	c.ids = slices.DeleteFunc(c.ids, func(v string) bool { return v == id })
+	return true
+}
+
+// findBy returns the first object whose field equals value.
+func (c *collection) findBy(field, value string) (map[string]any, bool) {
+	for _, id := range c.ids {
+		if v, _ := c.objs[id][field].(string); v == value {
+			return c.objs[id], true
+		}
+	}
+	return nil, false
+}
+
+// newID returns the next ObjectId-style identifier: a 4-byte epoch followed by
+// an 8-byte sequence, hex encoded. Callers hold s.mu.
+func (s *Server) newID() string {
+	s.seq++
+	return fmt.Sprintf("%08x%016x", s.epoch, s.seq)
+}
+
+// isObjectID reports whether id has the shape of a controller ObjectId.
+func isObjectID(id string) bool {
+	if len(id) != objectIDSize {
+		return false
+	}
+	_, err := hex.DecodeString(id)
+	return err == nil
+}
+
+// AddSite adds a site named name (the short name used in API paths) and
+// returns its _id. Adding an existing name returns the existing site's _id.
+func (s *Server) AddSite(name, desc string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addSiteLocked(name, desc).id
+}
+
+func (s *Server) addSiteLocked(name, desc string) *siteState {
+	if st, ok := s.sites[name]; ok {
+		return st
+	}
+	st := &siteState{
+		id:          s.newID(),
+		name:        name,
+		desc:        desc,
+		collections: map[string]*collection{},
+		settings:    map[string]map[string]any{},
+	}
+	for _, key := range unifi.SettingKeys() {
+		st.settings[key] = map[string]any{"_id": s.newID(), "site_id": st.id, "key": key}
+	}
+	s.sites[name] = st
+	if s.official != nil {
+		s.official.AddSite(name, desc)
+	}
+	return st
+}
+
+func (st *siteState) collection(kind string) *collection {
+	c, ok := st.collections[kind]
+	if !ok {
+		c = &collection{objs: map[string]map[string]any{}}
+		st.collections[kind] = c
+	}
+	return c
+}
+
+func (st *siteState) siteObject() map[string]any {
+	return map[string]any{"_id": st.id, "name": st.name, "desc": st.desc}
+}
+
+// Seed stores objs in site as if they had been created through the API. Each
+// obj is a resource value or pointer (e.g. *unifi.Network) or a setting
+// (e.g. *unifi.SettingMgmt); objects without an _id get a generated one, which
+// is written back through pointers.
+func (s *Server) Seed(site string, objs ...any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st, ok := s.sites[site]
+	if !ok {
+		return fmt.Errorf("unknown site %q", site)
+	}
+	for _, obj := range objs {
+		if err := s.seedLocked(st, obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) seedLocked(st *siteState, obj any) error {
+	m, err := toMap(obj)
+	if err != nil {
+		return err
+	}
+	name := reflect.Indirect(reflect.ValueOf(obj)).Type().Name()
+	if key, ok := m["key"].(string); ok && strings.HasPrefix(name, "Setting") {
+		setting, known := st.settings[key]
+		if !known {
+			return fmt.Errorf("unknown setting key %q", key)
+		}
+		merge(setting, m)
+		return nil
+	}
+	kind, ok := s.kinds.byName[name]
+	if !ok {
+		return fmt.Errorf("%s is not a site-scoped resource kind", name)
+	}
+	id, _ := m[kind.IDField].(string)
+	if id == "" {
+		id = s.newID()
+		m[kind.IDField] = id
+		setID(obj, kind.IDField, id)
+	}
+	if kind.APIVersion == unifi.APIVersionV1 {
+		m["site_id"] = st.id
+	}
+	st.collection(kind.Name).put(id, m)
+	return nil
+}
+
+// Objects returns the stored objects of kind (the Go type name, e.g.
+// "Network") in site, in insertion order, as decoded JSON.
+func (s *Server) Objects(site, kind string) []map[string]any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st, ok := s.sites[site]
+	if !ok {
+		return nil
+	}
+	c, ok := st.collections[kind]
+	if !ok {
+		return nil
+	}
+	out := make([]map[string]any, 0, len(c.ids))
+	for _, id := range c.ids {
+		out = append(out, clone(c.objs[id]))
+	}
+	return out
+}
+
+// Setting returns the stored setting key of site as decoded JSON.
+func (s *Server) Setting(site, key string) (map[string]any, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st, ok := s.sites[site]
+	if !ok {
+		return nil, false
+	}
+	setting, ok := st.settings[key]
+	return clone(setting), ok
+}
+
+// validate runs the built-in type check (obj must decode into the kind's Go
+// type) followed by the configured Validator of the kind.
+func (s *Server) validate(kind unifi.ResourceKind, obj map[string]any) error {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return errInvalidPayload
+	}
+	if err := json.Unmarshal(raw, kind.New()); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) && typeErr.Field != "" {
+			return &FieldError{Field: typeErr.Field}
+		}
+		return errInvalidPayload
+	}
+	if v, ok := s.validators[kind.Name]; ok {
+		return v(obj)
+	}
+	return nil
+}
+
+// toMap converts a Go value to its JSON object form.
+func toMap(obj any) (map[string]any, error) {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]any
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, fmt.Errorf("%T does not encode to a JSON object", obj)
+	}
+	return m, nil
+}
+
+// setID writes id into the string field tagged jsonName of the struct obj
+// points to. Non-pointers are left alone.
+func setID(obj any, jsonName, id string) {
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return
+	}
+	v = v.Elem()
+	for i := range v.NumField() {
+		name, _, _ := strings.Cut(v.Type().Field(i).Tag.Get("json"), ",")
+		if name == jsonName && v.Field(i).Kind() == reflect.String {
+			v.Field(i).SetString(id)
+			return
+		}
+	}
+}
+
+// merge copies the top-level fields of src into dst, the controller's PUT
+// semantics.
+func merge(dst, src map[string]any) {
+	maps.Copy(dst, src)
+}
+
+// clone deep-copies obj through JSON so callers never alias stored state.
+func clone(obj map[string]any) map[string]any {
+	if obj == nil {
+		return nil
+	}
+	raw, _ := json.Marshal(obj)
+	var out map[string]any
+	_ = json.Unmarshal(raw, &out)
+	return out
+}
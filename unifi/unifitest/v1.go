@@ -0,0 +1,292 @@
+package unifitest
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/filipowm/go-unifi/v2/unifi"
+)
+
+// serveV1 handles a request below /proxy/network/api/. path is relative to it,
+// e.g. "s/default/rest/networkconf/<id>" or "self/sites".
+func (s *Server) serveV1(w http.ResponseWriter, r *http.Request, path string) {
+	if path == "self/sites" {
+		s.serveSites(w, r)
+		return
+	}
+	rest, ok := strings.CutPrefix(path, "s/")
+	if !ok {
+		writeV1Error(w, http.StatusNotFound, "api.err.NotFound", nil)
+		return
+	}
+	siteName, rest, _ := strings.Cut(rest, "/")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st, ok := s.sites[siteName]
+	if !ok {
+		writeV1Error(w, http.StatusBadRequest, "api.err.NoSiteContext", nil)
+		return
+	}
+
+	switch {
+	case rest == "stat/sysinfo":
+		s.serveSysinfo(w, r)
+	case rest == "get/setting":
+		s.serveGetSettings(w, r, st)
+	case strings.HasPrefix(rest, "set/setting/"):
+		s.serveSetSetting(w, r, st, strings.TrimPrefix(rest, "set/setting/"))
+	case strings.HasPrefix(rest, "cmd/"):
+		s.serveCmd(w, r, st, strings.TrimPrefix(rest, "cmd/"))
+	case rest == "group/user":
+		s.serveGroupUser(w, r, st)
+	case strings.HasPrefix(rest, "stat/"):
+		s.serveStat(w, r, st, strings.TrimPrefix(rest, "stat/"))
+	default:
+		// rest/<endpoint>[/<id>]; APGroup lists on the bare endpoint.
+		kind, id, ok := s.kinds.match(unifi.APIVersionV1, strings.TrimPrefix(rest, "rest/"))
+		if !ok {
+			writeV1Error(w, http.StatusNotFound, "api.err.NotFound", nil)
+			return
+		}
+		s.serveRest(w, r, st, kind, id)
+	}
+}
+
+// serveRest implements the v1 rest/ CRUD semantics for one kind.
+func (s *Server) serveRest(w http.ResponseWriter, r *http.Request, st *siteState, kind unifi.ResourceKind, id string) {
+	c := st.collection(kind.Name)
+	if id == "" {
+		switch r.Method {
+		case http.MethodGet:
+			writeV1(w, c.list())
+		case http.MethodPost:
+			obj, ok := decodeObject(r)
+			if !ok {
+				writeV1Error(w, http.StatusBadRequest, "api.err.InvalidPayload", nil)
+				return
+			}
+			created, ok := s.createV1(w, st, kind, obj)
+			if ok {
+				writeV1(w, []any{created})
+			}
+		default:
+			writeV1Error(w, http.StatusMethodNotAllowed, "api.err.MethodNotAllowed", nil)
+		}
+		return
+	}
+
+	if !isObjectID(id) {
+		writeV1Error(w, http.StatusBadRequest, "api.err.IdInvalid", nil)
+		return
+	}
+	existing, ok := c.objs[id]
+	if !ok {
+		writeV1Error(w, http.StatusNotFound, "api.err.NotFound", nil)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeV1(w, []any{clone(existing)})
+	case http.MethodPut:
+		obj, ok := decodeObject(r)
+		if !ok {
+			writeV1Error(w, http.StatusBadRequest, "api.err.InvalidPayload", nil)
+			return
+		}
+		if bodyID, _ := obj[kind.IDField].(string); bodyID != "" && bodyID != id {
+			writeV1Error(w, http.StatusBadRequest, "api.err.IdInvalid", nil)
+			return
+		}
+		updated := clone(existing)
+		merge(updated, obj)
+		updated[kind.IDField] = id
+		updated["site_id"] = st.id
+		if !s.validateV1(w, kind, updated) {
+			return
+		}
+		c.put(id, updated)
+		writeV1(w, []any{clone(updated)})
+	case http.MethodDelete:
+		c.remove(id)
+		writeV1(w, nil)
+	default:
+		writeV1Error(w, http.StatusMethodNotAllowed, "api.err.MethodNotAllowed", nil)
+	}
+}
+
+// createV1 validates and stores a new v1 object, writing the error response
+// itself when it fails. The controller assigns _id and site_id; a
+// client-supplied _id is ignored.
+func (s *Server) createV1(w http.ResponseWriter, st *siteState, kind unifi.ResourceKind, obj map[string]any) (map[string]any, bool) {
+	obj[kind.IDField] = s.newID()
+	obj["site_id"] = st.id
+	if !s.validateV1(w, kind, obj) {
+		return nil, false
+	}
+	st.collection(kind.Name).put(obj[kind.IDField].(string), obj)
+	return clone(obj), true
+}
+
+// validateV1 runs validate and writes the v1 error response on failure.
+func (s *Server) validateV1(w http.ResponseWriter, kind unifi.ResourceKind, obj map[string]any) bool {
+	err := s.validate(kind, obj)
+	if err == nil {
+		return true
+	}
+	var fe *FieldError
+	switch {
+	case errors.As(err, &fe):
+		writeV1Error(w, http.StatusBadRequest, "api.err.Invalid", fe)
+	case errors.Is(err, errInvalidPayload):
+		writeV1Error(w, http.StatusBadRequest, "api.err.InvalidPayload", nil)
+	default:
+		writeV1Error(w, http.StatusBadRequest, "api.err.Invalid", &FieldError{Pattern: err.Error()})
+	}
+	return false
+}
+
+// serveStat answers the read-only stat/<endpoint>[/<id-or-mac>] views. A
+// trailing segment matches an object by _id or by MAC address, like
+// stat/device/{mac} and stat/user/{mac} on the controller.
+func (s *Server) serveStat(w http.ResponseWriter, r *http.Request, st *siteState, rest string) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		writeV1Error(w, http.StatusMethodNotAllowed, "api.err.MethodNotAllowed", nil)
+		return
+	}
+	kind, key, ok := s.kinds.match(unifi.APIVersionV1, rest)
+	if !ok {
+		writeV1Error(w, http.StatusNotFound, "api.err.NotFound", nil)
+		return
+	}
+	c := st.collection(kind.Name)
+	if key == "" {
+		writeV1(w, c.list())
+		return
+	}
+	if obj, ok := c.objs[key]; ok {
+		writeV1(w, []any{clone(obj)})
+		return
+	}
+	if obj, ok := c.findBy("mac", strings.ToLower(key)); ok {
+		writeV1(w, []any{clone(obj)})
+		return
+	}
+	writeV1(w, nil)
+}
+
+// serveGroupUser implements the group/user bulk create used by CreateUser:
+// every objects[].data is created and echoed in its own nested envelope.
+func (s *Server) serveGroupUser(w http.ResponseWriter, r *http.Request, st *siteState) {
+	kind, ok := s.kinds.byName["User"]
+	body, decoded := decodeObject(r)
+	objects, _ := body["objects"].([]any)
+	if !ok || !decoded || r.Method != http.MethodPost || len(objects) == 0 {
+		writeV1Error(w, http.StatusBadRequest, "api.err.InvalidPayload", nil)
+		return
+	}
+	results := make([]any, 0, len(objects))
+	for _, o := range objects {
+		entry, _ := o.(map[string]any)
+		data, _ := entry["data"].(map[string]any)
+		if data == nil {
+			writeV1Error(w, http.StatusBadRequest, "api.err.InvalidPayload", nil)
+			return
+		}
+		if mac, _ := data["mac"].(string); mac != "" {
+			if _, exists := st.collection(kind.Name).findBy("mac", strings.ToLower(mac)); exists {
+				results = append(results, map[string]any{"meta": map[string]any{"rc": "error", "msg": "api.err.MacUsed"}, "data": []any{}})
+				continue
+			}
+			data["mac"] = strings.ToLower(mac)
+		}
+		created, ok := s.createV1(w, st, kind, data)
+		if !ok {
+			return
+		}
+		results = append(results, map[string]any{"meta": map[string]any{"rc": "ok"}, "data": []any{created}})
+	}
+	writeV1(w, results)
+}
+
+// serveSites answers self/sites with every site.
+func (s *Server) serveSites(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeV1Error(w, http.StatusMethodNotAllowed, "api.err.MethodNotAllowed", nil)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	writeV1(w, s.siteObjectsLocked())
+}
+
+func (s *Server) siteObjectsLocked() []any {
+	out := make([]any, 0, len(s.sites))
+	for _, st := range s.sites {
+		out = append(out, st.siteObject())
+	}
+	sortByField(out, "name")
+	return out
+}
+
+// serveSysinfo answers stat/sysinfo with the configured version.
+func (s *Server) serveSysinfo(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeV1Error(w, http.StatusMethodNotAllowed, "api.err.MethodNotAllowed", nil)
+		return
+	}
+	writeV1(w, []any{map[string]any{
+		"version":  s.version,
+		"build":    "atag_" + s.version,
+		"name":     "unifitest",
+		"hostname": "unifitest.local",
+		"timezone": "UTC",
+	}})
+}
+
+// serveGetSettings answers get/setting with every setting of the site.
+func (s *Server) serveGetSettings(w http.ResponseWriter, r *http.Request, st *siteState) {
+	if r.Method != http.MethodGet {
+		writeV1Error(w, http.StatusMethodNotAllowed, "api.err.MethodNotAllowed", nil)
+		return
+	}
+	out := make([]any, 0, len(st.settings))
+	for _, setting := range st.settings {
+		out = append(out, clone(setting))
+	}
+	sortByField(out, "key")
+	writeV1(w, out)
+}
+
+// serveSetSetting merges the body into setting key and echoes it back.
+func (s *Server) serveSetSetting(w http.ResponseWriter, r *http.Request, st *siteState, key string) {
+	if r.Method != http.MethodPut && r.Method != http.MethodPost {
+		writeV1Error(w, http.StatusMethodNotAllowed, "api.err.MethodNotAllowed", nil)
+		return
+	}
+	setting, ok := st.settings[key]
+	if !ok {
+		writeV1Error(w, http.StatusBadRequest, "api.err.InvalidKey", nil)
+		return
+	}
+	obj, ok := decodeObject(r)
+	if !ok {
+		writeV1Error(w, http.StatusBadRequest, "api.err.InvalidPayload", nil)
+		return
+	}
+	if v, ok := s.validators["Setting"+key]; ok {
+		if err := v(obj); err != nil {
+			var fe *FieldError
+			if !errors.As(err, &fe) {
+				fe = &FieldError{Pattern: err.Error()}
+			}
+			writeV1Error(w, http.StatusBadRequest, "api.err.Invalid", fe)
+			return
+		}
+	}
+	id, siteID := setting["_id"], setting["site_id"]
+	merge(setting, obj)
+	setting["_id"], setting["site_id"], setting["key"] = id, siteID, key
+	writeV1(w, []any{clone(setting)})
+}
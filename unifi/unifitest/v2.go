@@ -0,0 +1,108 @@
+package unifitest
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/filipowm/go-unifi/v2/unifi"
+)
+
+// serveV2 handles a request below /proxy/network/v2/api/. path is relative to
+// it, e.g. "site/default/firewall-policies/<id>". v2 endpoints answer with bare
+// JSON rather than {meta,data} envelopes.
+func (s *Server) serveV2(w http.ResponseWriter, r *http.Request, path string) {
+	rest, ok := strings.CutPrefix(path, "site/")
+	if !ok {
+		writeV2Error(w, http.StatusNotFound, "api.err.NotFound", "not found", nil)
+		return
+	}
+	siteName, rest, _ := strings.Cut(rest, "/")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st, ok := s.sites[siteName]
+	if !ok {
+		writeV2Error(w, http.StatusBadRequest, "api.err.NoSiteContext", "unknown site "+siteName, nil)
+		return
+	}
+	kind, id, ok := s.kinds.match(unifi.APIVersionV2, rest)
+	if !ok {
+		writeV2Error(w, http.StatusNotFound, "api.err.NotFound", "not found", nil)
+		return
+	}
+
+	c := st.collection(kind.Name)
+	if id == "" {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, c.list())
+		case http.MethodPost:
+			obj, ok := decodeObject(r)
+			if !ok {
+				writeV2Error(w, http.StatusBadRequest, "api.err.InvalidPayload", "invalid payload", nil)
+				return
+			}
+			obj[kind.IDField] = s.newID()
+			if !s.validateV2(w, kind, obj) {
+				return
+			}
+			c.put(obj[kind.IDField].(string), obj)
+			writeJSON(w, http.StatusOK, clone(obj))
+		default:
+			writeV2Error(w, http.StatusMethodNotAllowed, "api.err.MethodNotAllowed", "method not allowed", nil)
+		}
+		return
+	}
+
+	existing, ok := c.objs[id]
+	if !ok {
+		writeV2Error(w, http.StatusNotFound, "api.err.NotFound", "object "+id+" not found", nil)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, clone(existing))
+	case http.MethodPut:
+		obj, ok := decodeObject(r)
+		if !ok {
+			writeV2Error(w, http.StatusBadRequest, "api.err.InvalidPayload", "invalid payload", nil)
+			return
+		}
+		if bodyID, _ := obj[kind.IDField].(string); bodyID != "" && bodyID != id {
+			writeV2Error(w, http.StatusBadRequest, "api.err.IdInvalid", "id does not match the path", nil)
+			return
+		}
+		updated := clone(existing)
+		merge(updated, obj)
+		updated[kind.IDField] = id
+		if !s.validateV2(w, kind, updated) {
+			return
+		}
+		c.put(id, updated)
+		writeJSON(w, http.StatusOK, clone(updated))
+	case http.MethodDelete:
+		c.remove(id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeV2Error(w, http.StatusMethodNotAllowed, "api.err.MethodNotAllowed", "method not allowed", nil)
+	}
+}
+
+// validateV2 runs validate and writes the v2 error response on failure.
+func (s *Server) validateV2(w http.ResponseWriter, kind unifi.ResourceKind, obj map[string]any) bool {
+	err := s.validate(kind, obj)
+	if err == nil {
+		return true
+	}
+	var fe *FieldError
+	switch {
+	case errors.As(err, &fe):
+		writeV2Error(w, http.StatusBadRequest, "api.err.Invalid", fe.Error(), fe)
+	case errors.Is(err, errInvalidPayload):
+		writeV2Error(w, http.StatusBadRequest, "api.err.InvalidPayload", "invalid payload", nil)
+	default:
+		writeV2Error(w, http.StatusBadRequest, "api.err.Invalid", err.Error(), nil)
+	}
+	return false
+}
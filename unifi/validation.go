@@ -3,6 +3,7 @@ package unifi
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"regexp"
 	"sort"
 	"strings"
@@ -18,6 +19,8 @@ import (
 type ValidationError struct {
 	Root     error
 	Messages map[string]string
+	// typ is the type of the validated struct, used to resolve wire paths.
+	typ reflect.Type
 }
 
 // Error returns the error message with combined all validation error messages.
@@ -48,6 +51,30 @@ func (v *ValidationError) Error() string {
 	return err
 }
 
+// Is makes a client-side validation failure match ErrValidationFailed, the
+// class of the controller rejecting an invalid body.
+func (v *ValidationError) Is(target error) bool {
+	return target == ErrValidationFailed
+}
+
+// FieldErrors returns one FieldError per failed field, with its Go field path
+// within the validated struct, its JSON wire path and its translated message.
+// It returns nil when Root is not a validator error.
+func (v *ValidationError) FieldErrors() []FieldError {
+	var errs vd.ValidationErrors
+	if !errors.As(v.Root, &errs) {
+		return nil
+	}
+	fieldErrs := make([]FieldError, 0, len(errs))
+	for _, fe := range errs {
+		// The namespace is rooted at the struct type name: "WLAN.XPassphrase".
+		_, path, _ := strings.Cut(fe.StructNamespace(), ".")
+		wire, _ := translateFieldPath(v.typ, path, false)
+		fieldErrs = append(fieldErrs, FieldError{Path: path, WirePath: wire, Message: v.Messages[fe.Namespace()]})
+	}
+	return fieldErrs
+}
+
 // Unwrap exposes the underlying validator error so callers can use
 // errors.Is/errors.As to reach the wrapped vd.ValidationErrors (or whatever
 // raw error Validate fell back to).
@@ -84,7 +111,7 @@ func (v *validator) Validate(i any) error {
 		}
 		messages := errs.Translate(v.trans)
 
-		return &ValidationError{Root: err, Messages: messages}
+		return &ValidationError{Root: err, Messages: messages, typ: reflect.TypeOf(i)}
 	}
 	return nil
 }
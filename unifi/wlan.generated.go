@@ -16,6 +16,26 @@ var (
 	_ json.Marshaler
 )
 
+// Self-register this resource's kind so the resourceKinds registry in
+// resource_registry.go mirrors the generated Client surface and can never
+// drift from it by hand.
+func init() { //nolint:gochecknoinits
+	registerResourceKind(ResourceKind{
+		Name:       "WLAN",
+		Endpoint:   "wlanconf",
+		APIVersion: APIVersionV1,
+		IDField:    "_id",
+		NameField:  "name",
+		SiteScoped: true,
+	}, resourceOps[WLAN]{
+		list:   InternalClient.ListWLAN,
+		get:    InternalClient.GetWLAN,
+		create: InternalClient.CreateWLAN,
+		update: InternalClient.UpdateWLAN,
+		delete: InternalClient.DeleteWLAN,
+	})
+}
+
 type WLAN struct {
 	ID     string `json:"_id,omitempty"`
 	SiteID string `json:"site_id,omitempty"`
@@ -16,6 +16,26 @@ var (
 	_ json.Marshaler
 )
 
+// Self-register this resource's kind so the resourceKinds registry in
+// resource_registry.go mirrors the generated Client surface and can never
+// drift from it by hand.
+func init() { //nolint:gochecknoinits
+	registerResourceKind(ResourceKind{
+		Name:       "WLANGroup",
+		Endpoint:   "wlangroup",
+		APIVersion: APIVersionV1,
+		IDField:    "_id",
+		NameField:  "name",
+		SiteScoped: true,
+	}, resourceOps[WLANGroup]{
+		list:   InternalClient.ListWLANGroup,
+		get:    InternalClient.GetWLANGroup,
+		create: InternalClient.CreateWLANGroup,
+		update: InternalClient.UpdateWLANGroup,
+		delete: InternalClient.DeleteWLANGroup,
+	})
+}
+
 type WLANGroup struct {
 	ID     string `json:"_id,omitempty"`
 	SiteID string `json:"site_id,omitempty"`